@@ -19,9 +19,20 @@ This command performs a complete OpenFrame setup by running:
 This is equivalent to running both commands sequentially but provides
 a streamlined experience for getting started with OpenFrame.
 
+Preflight checks of the Docker daemon's memory, CPUs, disk and limits run
+before the cluster is created; use --skip-preflight to continue despite
+failed checks.
+
+With --bundle the bootstrap runs without network access: the tools, images,
+ArgoCD chart and application manifests come from an archive created with
+'openframe bundle create', and ArgoCD syncs from a git server inside the
+cluster.
+
 Examples:
   openframe bootstrap                    # Bootstrap with default cluster name
-  openframe bootstrap my-cluster        # Bootstrap with custom cluster name`,
+  openframe bootstrap my-cluster        # Bootstrap with custom cluster name
+  openframe bootstrap --skip-preflight  # Bootstrap despite failed preflight checks
+  openframe bootstrap --bundle openframe-bundle-main.tar  # Bootstrap offline from a bundle`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Logo will be shown by cluster wrapper before prerequisites
@@ -29,5 +40,8 @@ Examples:
 		},
 	}
 
+	cmd.Flags().Bool("skip-preflight", false, "Create the cluster even when the Docker memory, CPU, disk or limit checks fail")
+	cmd.Flags().String("bundle", "", "Install from an offline bundle created with 'openframe bundle create'")
+
 	return cmd
 }
\ No newline at end of file
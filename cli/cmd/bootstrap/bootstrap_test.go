@@ -62,4 +62,14 @@ func TestBootstrapArgumentValidation(t *testing.T) {
 		err = cmd.Args(cmd, []string{"arg1", "arg2"})
 		assert.Error(t, err, "Should reject more than one argument")
 	}
-}
\ No newline at end of file
+}
+
+func TestBootstrapBundleFlag(t *testing.T) {
+	cmd := GetBootstrapCmd()
+
+	flag := cmd.Flags().Lookup("bundle")
+	if assert.NotNil(t, flag, "Bootstrap should define --bundle") {
+		assert.Equal(t, "", flag.DefValue)
+	}
+	assert.Contains(t, cmd.Long, "openframe bootstrap --bundle")
+}
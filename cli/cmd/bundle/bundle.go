@@ -0,0 +1,34 @@
+package bundle
+
+import (
+	"github.com/flamingo/openframe/internal/shared/ui"
+	"github.com/spf13/cobra"
+)
+
+// GetBundleCmd returns the bundle command and its subcommands
+func GetBundleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "Package OpenFrame for offline installation",
+		Long: `Offline Bundles - Install OpenFrame without network access
+
+This command group packages everything a bootstrap downloads into one archive:
+  • create - Collect the ArgoCD chart, the app-of-apps and application manifests,
+    the container images and the tool binaries
+
+Install from the archive on a machine without network access with
+'openframe bootstrap --bundle <file>'.
+
+Examples:
+  openframe bundle create
+  openframe bootstrap --bundle openframe-bundle-main.tar`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Show logo when no subcommand is provided
+			ui.ShowLogoWithContext(cmd.Context())
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(getCreateCmd())
+	return cmd
+}
@@ -0,0 +1,39 @@
+package bundle
+
+import (
+	"testing"
+
+	"github.com/flamingo/openframe/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	testutil.InitializeTestMode()
+}
+
+func TestBundleCommandStructure(t *testing.T) {
+	cmd := GetBundleCmd()
+
+	assert.Equal(t, "bundle", cmd.Name())
+	assert.NotEmpty(t, cmd.Short)
+	assert.Contains(t, cmd.Long, "openframe bootstrap --bundle")
+	assert.NotNil(t, cmd.RunE)
+
+	require.Len(t, cmd.Commands(), 1)
+	assert.Equal(t, "create", cmd.Commands()[0].Name())
+}
+
+func TestCreateCommandFlags(t *testing.T) {
+	cmd := getCreateCmd()
+
+	assert.NoError(t, cmd.Args(cmd, []string{}))
+	assert.Error(t, cmd.Args(cmd, []string{"extra"}))
+
+	for _, name := range []string{"file", "repo", "revision", "platform", "image", "skip-images", "skip-tools"} {
+		assert.NotNil(t, cmd.Flags().Lookup(name), "create should define --%s", name)
+	}
+	assert.Equal(t, "f", cmd.Flags().Lookup("file").Shorthand)
+	assert.Equal(t, "main", cmd.Flags().Lookup("revision").DefValue)
+	assert.Equal(t, "https://github.com/flamingo-stack/openframe-oss-tenant", cmd.Flags().Lookup("repo").DefValue)
+}
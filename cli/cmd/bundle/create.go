@@ -0,0 +1,83 @@
+package bundle
+
+import (
+	"fmt"
+
+	"github.com/flamingo/openframe/internal/bundle"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/ui"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// getCreateCmd returns the create subcommand
+func getCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an offline bundle",
+		Long: `Create an offline bundle for 'openframe bootstrap --bundle'
+
+The bundle is a single tar archive containing:
+  • The ArgoCD chart in the version 'chart install' installs
+  • The app-of-apps and application manifests at --revision, with the chart
+    dependencies ArgoCD would download vendored into the repository
+  • The k3s node image, the k3d helper images and every image found by
+    rendering the charts, saved with docker save
+  • Checksum-verified k3d, kubectl, helm and mkcert binaries for --platform
+
+Charts that only render with values from the app-of-apps may hide images;
+add them with --image. Creating a bundle needs helm, git, docker and network
+access. Docker itself is not bundled.
+
+Examples:
+  openframe bundle create
+  openframe bundle create --revision v1.2.0 --file openframe-v1.2.0.tar
+  openframe bundle create --platform linux/amd64 --image nginx:1.27`,
+		Args: cobra.NoArgs,
+		RunE: runCreateCommand,
+	}
+
+	cmd.Flags().StringP("file", "f", "", "Archive to write (default openframe-bundle-<revision>.tar)")
+	cmd.Flags().String("repo", bundle.DefaultRepository, "Repository with the app-of-apps and application manifests")
+	cmd.Flags().String("revision", bundle.DefaultRevision, "Branch or tag of the repository to bundle")
+	cmd.Flags().String("platform", "", "os/arch of the machine the bundle is installed on (default this machine)")
+	cmd.Flags().StringArray("image", nil, "Additional image to bundle (repeatable)")
+	cmd.Flags().Bool("skip-images", false, "Leave the container images out of the bundle")
+	cmd.Flags().Bool("skip-tools", false, "Leave the tool binaries out of the bundle")
+
+	return cmd
+}
+
+// runCreateCommand handles the create command execution
+func runCreateCommand(cmd *cobra.Command, args []string) error {
+	opts := bundle.CreateOptions{}
+	opts.Output, _ = cmd.Flags().GetString("file")
+	opts.Repository, _ = cmd.Flags().GetString("repo")
+	opts.Revision, _ = cmd.Flags().GetString("revision")
+	opts.Platform, _ = cmd.Flags().GetString("platform")
+	opts.ExtraImages, _ = cmd.Flags().GetStringArray("image")
+	opts.SkipImages, _ = cmd.Flags().GetBool("skip-images")
+	opts.SkipTools, _ = cmd.Flags().GetBool("skip-tools")
+
+	verbose, err := cmd.Root().PersistentFlags().GetBool("verbose")
+	if err != nil {
+		verbose = false
+	}
+
+	ui.ShowLogoWithContext(cmd.Context())
+
+	creator := bundle.NewCreator(executor.NewRealCommandExecutor(false, verbose))
+	output, manifest, err := creator.Create(cmd.Context(), opts)
+	if err != nil {
+		return err
+	}
+
+	pterm.Success.Printf("Bundle written to %s\n", output)
+	pterm.Printf("  Revision:  %s (%s)\n", manifest.Repository.Revision, manifest.Repository.Commit)
+	pterm.Printf("  ArgoCD:    %s\n", manifest.ArgoCD.Version)
+	pterm.Printf("  Images:    %d\n", len(manifest.Images.Host)+len(manifest.Images.Cluster))
+	pterm.Printf("  Tools:     %d (%s)\n", len(manifest.Tools), manifest.Platform)
+	fmt.Println()
+	pterm.Info.Printf("Install with: openframe bootstrap --bundle %s\n", output)
+	return nil
+}
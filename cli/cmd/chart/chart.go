@@ -2,6 +2,7 @@ package chart
 
 import (
 	"github.com/flamingo/openframe/internal/chart/prerequisites"
+	"github.com/flamingo/openframe/internal/shared/output"
 	"github.com/flamingo/openframe/internal/shared/ui"
 	"github.com/spf13/cobra"
 )
@@ -15,16 +16,26 @@ func GetChartCmd() *cobra.Command {
 		Long: `Chart Management - Install and manage ArgoCD
 
 This command group provides ArgoCD chart lifecycle management:
-  • install - Install ArgoCD on a cluster
+  • install   - Install ArgoCD on a cluster
+  • status    - Show the installed releases and ArgoCD applications
+  • upgrade   - Change the branch or values of an install in place
+  • uninstall - Remove OpenFrame and ArgoCD from a cluster
 
 Requires an existing cluster created with 'openframe cluster create'.
 
 Examples:
   openframe chart install
-  openframe chart install my-cluster`,
+  openframe chart install my-cluster
+  openframe chart status my-cluster
+  openframe chart upgrade my-cluster --branch develop
+  openframe chart uninstall my-cluster`,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-			// Show logo for subcommands, but not for the root chart command
-			if cmd.Use != "chart" {
+			format, err := output.FormatFromCommand(cmd)
+			if err != nil {
+				return err
+			}
+			// Show logo for subcommands, but not for the root chart command or machine-readable output
+			if cmd.Use != "chart" && !format.IsStructured() {
 				ui.ShowLogoWithContext(cmd.Context())
 			}
 			return prerequisites.NewInstaller().CheckAndInstall()
@@ -37,5 +48,8 @@ Examples:
 	}
 
 	cmd.AddCommand(getInstallCmd())
+	cmd.AddCommand(getStatusCmd())
+	cmd.AddCommand(getUpgradeCmd())
+	cmd.AddCommand(getUninstallCmd())
 	return cmd
 }
@@ -1,9 +1,13 @@
 package chart
 
 import (
+	"fmt"
+
 	"github.com/flamingo/openframe/internal/chart/services"
+	"github.com/flamingo/openframe/internal/chart/ui/templates"
 	"github.com/flamingo/openframe/internal/chart/utils/types"
 	sharedErrors "github.com/flamingo/openframe/internal/shared/errors"
+	"github.com/flamingo/openframe/internal/shared/output"
 	"github.com/spf13/cobra"
 )
 
@@ -21,11 +25,25 @@ This command installs:
 The cluster must exist before running this command.
 Certificates are automatically regenerated during installation.
 
+By default an interactive wizard asks for the configuration. Any of --values,
+--set, --ingress, --branch or --yes replaces the wizard, so the install can run
+from CI. With --yes the confirmation is skipped as well, and the only cluster is
+used when no cluster name is given. Structured output (-o json, -o yaml) implies --yes.
+
+With --diff nothing is installed. The generated values are compared with the
+base values file and with the deployed app-of-apps release, secrets redacted,
+and the app-of-apps chart is rendered with 'helm template' to list the ArgoCD
+applications the install would create, change or remove.
+
 Examples:
   openframe chart install                                    # Install with defaults
   openframe chart install my-cluster                        # Install on specific cluster
   openframe chart install --github-branch develop          # Use develop branch
-  openframe chart install --cert-dir /path/to/certs        # Custom cert directory`,
+  openframe chart install --cert-dir /path/to/certs        # Custom cert directory
+  openframe chart install my-cluster -o json               # Progress on stderr, report on stdout
+  openframe chart install my-cluster --values ci-values.yaml --ingress localhost --yes
+  openframe chart install --branch develop --set registry.docker.username=ci --yes
+  openframe chart install my-cluster --branch develop --diff`,
 		RunE:          runInstallCommand,
 		SilenceErrors: true, // Errors are handled by our custom error handler
 		SilenceUsage:  true, // Don't show usage on errors
@@ -59,6 +77,17 @@ func runInstallCommand(cmd *cobra.Command, args []string) error {
 		GitHubRepo:   flags.GitHubRepo,
 		GitHubBranch: flags.GitHubBranch,
 		CertDir:      flags.CertDir,
+		Scripted:     flags.Scripted,
+		AssumeYes:    flags.AssumeYes,
+		Diff:         flags.Diff,
+	}
+
+	format, err := output.FormatFromCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if format.IsStructured() {
+		return runStructuredInstall(req, format)
 	}
 
 	err = services.InstallChartsWithConfig(req)
@@ -69,6 +98,38 @@ func runInstallCommand(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// runStructuredInstall installs charts with progress on stderr and writes an installation report to stdout
+// Structured output implies --yes, since the wizard and the prompts cannot share stdout with the report.
+func runStructuredInstall(req types.InstallationRequest, format output.Format) error {
+	req.AssumeYes = true
+	if req.Scripted == nil {
+		req.Scripted = &types.ScriptedConfiguration{}
+	}
+
+	stdout, restore := output.RedirectHumanOutput()
+	defer restore()
+
+	req.Report = &types.InstallationReport{
+		APIVersion: output.DocumentAPIVersion,
+		Kind:       types.InstallationReportKind,
+		Repository: req.GitHubRepo,
+		Branch:     req.GitHubBranch,
+		DryRun:     req.DryRun,
+		Status:     types.InstallationStatusInstalled,
+	}
+
+	err := services.InstallChartsWithConfig(req)
+	if err != nil {
+		req.Report.Status = types.InstallationStatusFailed
+		req.Report.Error = err.Error()
+	}
+
+	if writeErr := output.Write(stdout, format, req.Report); writeErr != nil {
+		return writeErr
+	}
+	return output.PassthroughError(err)
+}
+
 // InstallFlags contains all flags needed for chart installation
 type InstallFlags struct {
 	Force        bool
@@ -76,6 +137,9 @@ type InstallFlags struct {
 	GitHubRepo   string
 	GitHubBranch string
 	CertDir      string
+	Scripted     *types.ScriptedConfiguration // nil when the configuration wizard should run
+	AssumeYes    bool
+	Diff         bool // Preview instead of installing
 }
 
 // extractInstallFlags extracts install flags from cobra command
@@ -103,9 +167,65 @@ func extractInstallFlags(cmd *cobra.Command) (*InstallFlags, error) {
 		return nil, err
 	}
 
+	if flags.AssumeYes, err = cmd.Flags().GetBool("yes"); err != nil {
+		return nil, err
+	}
+
+	if flags.Diff, err = cmd.Flags().GetBool("diff"); err != nil {
+		return nil, err
+	}
+
+	if flags.Scripted, err = extractScriptedConfiguration(cmd); err != nil {
+		return nil, err
+	}
+	if flags.Scripted == nil && flags.AssumeYes {
+		flags.Scripted = &types.ScriptedConfiguration{}
+	}
+
 	return flags, nil
 }
 
+// extractScriptedConfiguration reads the configuration flags that replace the wizard
+// It returns nil when none of them were given.
+func extractScriptedConfiguration(cmd *cobra.Command) (*types.ScriptedConfiguration, error) {
+	scripted := &types.ScriptedConfiguration{}
+	var err error
+
+	if scripted.ValuesFile, err = cmd.Flags().GetString("values"); err != nil {
+		return nil, err
+	}
+
+	if scripted.Overrides, err = cmd.Flags().GetStringArray("set"); err != nil {
+		return nil, err
+	}
+	modifier := templates.NewHelmValuesModifier()
+	for _, override := range scripted.Overrides {
+		if _, _, err := modifier.ParseValueOverride(override); err != nil {
+			return nil, err
+		}
+	}
+
+	ingress, err := cmd.Flags().GetString("ingress")
+	if err != nil {
+		return nil, err
+	}
+	switch types.IngressType(ingress) {
+	case "", types.IngressTypeLocalhost, types.IngressTypeNgrok:
+		scripted.Ingress = types.IngressType(ingress)
+	default:
+		return nil, fmt.Errorf("invalid --ingress %q, expected localhost or ngrok", ingress)
+	}
+
+	if scripted.Branch, err = cmd.Flags().GetString("branch"); err != nil {
+		return nil, err
+	}
+
+	if scripted.ValuesFile == "" && len(scripted.Overrides) == 0 && scripted.Ingress == "" && scripted.Branch == "" {
+		return nil, nil
+	}
+	return scripted, nil
+}
+
 // getVerboseFlag extracts verbose flag with fallback
 func getVerboseFlag(cmd *cobra.Command) bool {
 	// Try root command first
@@ -131,4 +251,10 @@ func addInstallFlags(cmd *cobra.Command) {
 	cmd.Flags().String("github-repo", "https://github.com/flamingo-stack/openframe-oss-tenant", "GitHub repository URL")
 	cmd.Flags().String("github-branch", "main", "GitHub repository branch")
 	cmd.Flags().String("cert-dir", "", "Certificate directory (auto-detected if not provided)")
+	cmd.Flags().String("values", "", "Base Helm values file instead of helm-values.yaml in the current directory")
+	cmd.Flags().StringArray("set", nil, "Set a Helm value (key=value, can be repeated)")
+	cmd.Flags().String("ingress", "", "Ingress type: localhost or ngrok (default: keep the values file setting)")
+	cmd.Flags().String("branch", "", "Manifests branch written to global.repoBranch")
+	cmd.Flags().BoolP("yes", "y", false, "Skip the configuration wizard and confirmation prompts")
+	cmd.Flags().Bool("diff", false, "Show the values diff and the ArgoCD applications that would change, without installing")
 }
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/flamingo/openframe/internal/chart/utils/types"
 	"github.com/flamingo/openframe/internal/shared/executor"
 	"github.com/flamingo/openframe/tests/testutil"
 	"github.com/stretchr/testify/assert"
@@ -192,3 +193,75 @@ func TestRunInstallCommand(t *testing.T) {
 	assert.True(t, flags.Force, "Should extract force flag correctly")
 	assert.Equal(t, "develop", flags.GitHubBranch, "Should extract github-branch flag correctly")
 }
+
+func TestInstallCommandScriptedFlags(t *testing.T) {
+	t.Run("no scripted flags keeps the wizard", func(t *testing.T) {
+		flags, err := extractInstallFlags(getInstallCmd())
+		require.NoError(t, err)
+		assert.Nil(t, flags.Scripted)
+		assert.False(t, flags.AssumeYes)
+	})
+
+	t.Run("yes alone uses the default configuration", func(t *testing.T) {
+		cmd := getInstallCmd()
+		require.NoError(t, cmd.Flags().Set("yes", "true"))
+
+		flags, err := extractInstallFlags(cmd)
+		require.NoError(t, err)
+		assert.True(t, flags.AssumeYes)
+		assert.Equal(t, &types.ScriptedConfiguration{}, flags.Scripted)
+	})
+
+	t.Run("configuration flags", func(t *testing.T) {
+		cmd := getInstallCmd()
+		require.NoError(t, cmd.ParseFlags([]string{
+			"--values", "ci-values.yaml",
+			"--set", "global.repoURL=https://example.com/repo.git",
+			"--set", "registry.docker.username=ci",
+			"--ingress", "ngrok",
+			"--branch", "develop",
+		}))
+
+		flags, err := extractInstallFlags(cmd)
+		require.NoError(t, err)
+		assert.False(t, flags.AssumeYes)
+		assert.Equal(t, &types.ScriptedConfiguration{
+			ValuesFile: "ci-values.yaml",
+			Overrides:  []string{"global.repoURL=https://example.com/repo.git", "registry.docker.username=ci"},
+			Ingress:    types.IngressTypeNgrok,
+			Branch:     "develop",
+		}, flags.Scripted)
+	})
+
+	t.Run("invalid ingress", func(t *testing.T) {
+		cmd := getInstallCmd()
+		require.NoError(t, cmd.Flags().Set("ingress", "traefik"))
+
+		_, err := extractInstallFlags(cmd)
+		assert.ErrorContains(t, err, "invalid --ingress")
+	})
+
+	t.Run("invalid set", func(t *testing.T) {
+		cmd := getInstallCmd()
+		require.NoError(t, cmd.Flags().Set("set", "novalue"))
+
+		_, err := extractInstallFlags(cmd)
+		assert.ErrorContains(t, err, "expected key=value")
+	})
+}
+
+func TestInstallCommandDiffFlag(t *testing.T) {
+	cmd := getInstallCmd()
+	assert.Contains(t, cmd.Long, "--diff")
+
+	flags, err := extractInstallFlags(cmd)
+	require.NoError(t, err)
+	assert.False(t, flags.Diff)
+
+	require.NoError(t, cmd.Flags().Set("diff", "true"))
+	require.NoError(t, cmd.Flags().Set("branch", "develop"))
+	flags, err = extractInstallFlags(cmd)
+	require.NoError(t, err)
+	assert.True(t, flags.Diff)
+	assert.Equal(t, "develop", flags.Scripted.Branch, "--diff previews the scripted configuration")
+}
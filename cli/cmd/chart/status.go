@@ -0,0 +1,109 @@
+package chart
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/flamingo/openframe/internal/chart/models"
+	"github.com/flamingo/openframe/internal/chart/services"
+	chartUI "github.com/flamingo/openframe/internal/chart/ui"
+	"github.com/flamingo/openframe/internal/cluster"
+	sharedErrors "github.com/flamingo/openframe/internal/shared/errors"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/output"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// getStatusCmd returns the status subcommand
+func getStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [cluster-name]",
+		Short: "Show the ArgoCD and app-of-apps releases and their applications",
+		Long: `Show the state of the charts installed by 'openframe chart install'
+
+Reports the ArgoCD and app-of-apps Helm releases with their status, revision,
+chart version, app version and last deployment, followed by the sync and health
+of every ArgoCD application.
+
+Without a cluster name the current kubectl context is used.
+The command exits non-zero when a release is not deployed or an application is degraded.
+
+Examples:
+  openframe chart status                      # Current kubectl context
+  openframe chart status my-cluster           # Cluster created with openframe cluster create
+  openframe chart status my-cluster --notes   # Include the chart release notes
+  openframe chart status my-cluster -o json   # Machine-readable output`,
+		Args:          cobra.MaximumNArgs(1),
+		RunE:          runStatusCommand,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+
+	cmd.Flags().Bool("notes", false, "Show the release notes of each chart")
+	return cmd
+}
+
+// runStatusCommand handles the status command execution
+func runStatusCommand(cmd *cobra.Command, args []string) error {
+	showNotes, err := cmd.Flags().GetBool("notes")
+	if err != nil {
+		return err
+	}
+	verbose := getVerboseFlag(cmd)
+
+	format, err := output.FormatFromCommand(cmd)
+	if err != nil {
+		return err
+	}
+
+	exec := executor.NewRealCommandExecutor(false, verbose)
+	kubeContext := ""
+	if len(args) > 0 {
+		// The context name depends on the provider that created the cluster
+		if kubeContext, err = cluster.NewClusterService(exec).KubeContext(args[0]); err != nil {
+			return sharedErrors.HandleGlobalError(err, verbose)
+		}
+	}
+
+	statusService := services.NewStatusService(exec)
+
+	if format.IsStructured() {
+		stdout, restore := output.RedirectHumanOutput()
+		defer restore()
+
+		report, err := statusService.GetStatus(context.Background(), kubeContext)
+		if err != nil {
+			return output.PassthroughError(err)
+		}
+		if err := output.Write(stdout, format, report); err != nil {
+			return err
+		}
+		return output.PassthroughError(statusError(report))
+	}
+
+	report, err := statusService.GetStatus(context.Background(), kubeContext)
+	if err != nil {
+		return sharedErrors.HandleGlobalError(err, verbose)
+	}
+
+	chartUI.NewDisplayService().ShowChartStatus(report, showNotes)
+
+	if err := statusError(report); err != nil {
+		fmt.Println()
+		pterm.Error.Println(err.Error())
+		return &sharedErrors.AlreadyHandledError{OriginalError: err}
+	}
+	return nil
+}
+
+// statusError summarizes the problems of a status report, or returns nil when everything is healthy
+func statusError(report *models.ChartStatusReport) error {
+	problems := report.Problems()
+	if len(problems) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(problems, "; "))
+}
@@ -0,0 +1,38 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/flamingo/openframe/internal/chart/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusCommand(t *testing.T) {
+	cmd := getStatusCmd()
+
+	assert.Equal(t, "status", cmd.Name())
+	assert.NotEmpty(t, cmd.Short)
+	assert.Contains(t, cmd.Long, "openframe chart status my-cluster")
+	assert.NotNil(t, cmd.RunE)
+	assert.NotNil(t, cmd.Flags().Lookup("notes"))
+	assert.Error(t, cmd.Args(cmd, []string{"one", "two"}), "accepts at most one cluster name")
+
+	found := false
+	for _, sub := range GetChartCmd().Commands() {
+		if sub.Name() == "status" {
+			found = true
+		}
+	}
+	assert.True(t, found, "status is registered under chart")
+}
+
+func TestStatusError(t *testing.T) {
+	healthy := &models.ChartStatusReport{Releases: []models.ChartInfo{{Name: "argo-cd", Status: "deployed"}}}
+	assert.NoError(t, statusError(healthy))
+
+	broken := &models.ChartStatusReport{
+		Releases:             []models.ChartInfo{{Name: "argo-cd", Status: "deployed"}, {Name: "app-of-apps", Status: models.ReleaseStatusNotInstalled}},
+		DegradedApplications: []string{"kafka"},
+	}
+	assert.EqualError(t, statusError(broken), "release app-of-apps is not-installed; application kafka is degraded")
+}
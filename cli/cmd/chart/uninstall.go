@@ -0,0 +1,112 @@
+package chart
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flamingo/openframe/internal/chart/services"
+	"github.com/flamingo/openframe/internal/cluster"
+	sharedErrors "github.com/flamingo/openframe/internal/shared/errors"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	sharedUI "github.com/flamingo/openframe/internal/shared/ui"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// getUninstallCmd returns the uninstall subcommand
+func getUninstallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uninstall [cluster-name]",
+		Short: "Remove app-of-apps, the OpenFrame applications and ArgoCD",
+		Long: `Remove everything 'openframe chart install' deployed, in reverse order
+
+1. app-of-apps is removed first, so nothing recreates the applications
+2. ArgoCD prunes the applications from the last sync wave to the first
+3. ArgoCD and its CRDs are uninstalled
+4. The platform, datasources, microservices, integrated-tools, client-tools
+   and argocd namespaces are deleted
+
+With --keep-data the persistent volume claims and the application namespaces
+that hold them are kept, so a later install finds the existing data.
+
+Without a cluster name the current kubectl context is used.
+An interrupted uninstall can be run again.
+
+Examples:
+  openframe chart uninstall my-cluster
+  openframe chart uninstall my-cluster --keep-data
+  openframe chart uninstall my-cluster --yes --timeout 20m`,
+		Args:          cobra.MaximumNArgs(1),
+		RunE:          runUninstallCommand,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+
+	cmd.Flags().Bool("keep-data", false, "Keep persistent volume claims and the application namespaces")
+	cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	cmd.Flags().Duration("timeout", 10*time.Minute, "Time ArgoCD gets to prune each sync wave")
+	return cmd
+}
+
+// runUninstallCommand handles the uninstall command execution
+func runUninstallCommand(cmd *cobra.Command, args []string) error {
+	keepData, err := cmd.Flags().GetBool("keep-data")
+	if err != nil {
+		return err
+	}
+	assumeYes, err := cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	if err != nil {
+		return err
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("--timeout must be positive, got %s", timeout)
+	}
+	verbose := getVerboseFlag(cmd)
+
+	exec := executor.NewRealCommandExecutor(false, verbose)
+	clusterName := ""
+	opts := services.UninstallOptions{KeepData: keepData, Timeout: timeout}
+	target := "the current kubectl context"
+	if len(args) > 0 {
+		clusterName = args[0]
+		// The context name depends on the provider that created the cluster
+		if opts.KubeContext, err = cluster.NewClusterService(exec).KubeContext(clusterName); err != nil {
+			return sharedErrors.HandleGlobalError(err, verbose)
+		}
+		target = fmt.Sprintf("cluster '%s'", clusterName)
+	}
+
+	if !assumeYes {
+		message := fmt.Sprintf("Remove OpenFrame and ArgoCD from %s?", target)
+		if !keepData {
+			message = fmt.Sprintf("Remove OpenFrame, ArgoCD and all application data from %s?", target)
+		}
+		confirmed, err := sharedUI.ConfirmActionInteractive(message, false)
+		if err != nil {
+			if sharedErrors.HandleConfirmationError(err) {
+				return nil
+			}
+			return err
+		}
+		if !confirmed {
+			pterm.Info.Println("Uninstall cancelled.")
+			return nil
+		}
+	}
+
+	uninstaller := services.NewUninstaller(exec)
+	if err := uninstaller.Uninstall(context.Background(), opts); err != nil {
+		return sharedErrors.HandleGlobalError(err, verbose)
+	}
+
+	if clusterName != "" {
+		services.ClearInstallationInDefaultStore(clusterName)
+	}
+	pterm.Success.Printf("OpenFrame was removed from %s\n", target)
+	return nil
+}
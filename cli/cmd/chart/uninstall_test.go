@@ -0,0 +1,32 @@
+package chart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUninstallCommand(t *testing.T) {
+	cmd := getUninstallCmd()
+
+	assert.Equal(t, "uninstall", cmd.Name())
+	assert.NotEmpty(t, cmd.Short)
+	assert.Contains(t, cmd.Long, "openframe chart uninstall my-cluster")
+	assert.NotNil(t, cmd.RunE)
+	assert.Error(t, cmd.Args(cmd, []string{"one", "two"}), "accepts at most one cluster name")
+
+	assert.NotNil(t, cmd.Flags().Lookup("keep-data"))
+	assert.Equal(t, "y", cmd.Flags().Lookup("yes").Shorthand)
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	assert.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, timeout)
+
+	found := false
+	for _, sub := range GetChartCmd().Commands() {
+		if sub.Name() == "uninstall" {
+			found = true
+		}
+	}
+	assert.True(t, found, "uninstall is registered under chart")
+}
@@ -0,0 +1,128 @@
+package chart
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flamingo/openframe/internal/chart/services"
+	chartUI "github.com/flamingo/openframe/internal/chart/ui"
+	"github.com/flamingo/openframe/internal/cluster"
+	sharedErrors "github.com/flamingo/openframe/internal/shared/errors"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	sharedUI "github.com/flamingo/openframe/internal/shared/ui"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// getUpgradeCmd returns the upgrade subcommand
+func getUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade [cluster-name]",
+		Short: "Change the branch or Helm values of a live install",
+		Long: `Change the manifests branch or the Helm values of an existing install in place
+
+Unlike 'openframe chart install' there is no wizard, the certificates are kept
+and ArgoCD is not reinstalled:
+
+1. The new values are computed from --values, or from the deployed values,
+   with --branch written to global.repoBranch
+2. The difference to 'helm get values app-of-apps' is shown, secrets redacted
+3. app-of-apps is upgraded from the chart on the new branch
+4. Only the applications whose target revision changed are waited for
+
+Without a cluster name the current kubectl context is used.
+
+Examples:
+  openframe chart upgrade my-cluster --branch develop
+  openframe chart upgrade my-cluster --values helm-values.yaml
+  openframe chart upgrade --branch main --values ci-values.yaml --yes`,
+		Args:          cobra.MaximumNArgs(1),
+		RunE:          runUpgradeCommand,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+
+	cmd.Flags().String("branch", "", "Manifests branch to switch to (default: keep the current branch)")
+	cmd.Flags().String("values", "", "Helm values file replacing the deployed values")
+	cmd.Flags().String("github-repo", "https://github.com/flamingo-stack/openframe-oss-tenant", "GitHub repository the app-of-apps chart is cloned from")
+	cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	cmd.Flags().Duration("timeout", 30*time.Minute, "Time for the upgrade and for the changed applications to sync")
+	return cmd
+}
+
+// runUpgradeCommand handles the upgrade command execution
+func runUpgradeCommand(cmd *cobra.Command, args []string) error {
+	opts := services.UpgradeOptions{}
+	var err error
+	if opts.Branch, err = cmd.Flags().GetString("branch"); err != nil {
+		return err
+	}
+	if opts.ValuesFile, err = cmd.Flags().GetString("values"); err != nil {
+		return err
+	}
+	if opts.GitHubRepo, err = cmd.Flags().GetString("github-repo"); err != nil {
+		return err
+	}
+	if opts.Timeout, err = cmd.Flags().GetDuration("timeout"); err != nil {
+		return err
+	}
+	assumeYes, err := cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+	if opts.Branch == "" && opts.ValuesFile == "" {
+		return fmt.Errorf("nothing to upgrade, set --branch, --values or both")
+	}
+	if opts.Timeout <= 0 {
+		return fmt.Errorf("--timeout must be positive, got %s", opts.Timeout)
+	}
+	verbose := getVerboseFlag(cmd)
+
+	exec := executor.NewRealCommandExecutor(false, verbose)
+	target := "the current kubectl context"
+	if len(args) > 0 {
+		opts.ClusterName = args[0]
+		// The context name depends on the provider that created the cluster
+		if opts.KubeContext, err = cluster.NewClusterService(exec).KubeContext(opts.ClusterName); err != nil {
+			return sharedErrors.HandleGlobalError(err, verbose)
+		}
+		target = fmt.Sprintf("cluster '%s'", opts.ClusterName)
+	}
+
+	ctx := context.Background()
+	upgrader := services.NewUpgrader(exec)
+	plan, err := upgrader.Plan(ctx, opts)
+	if err != nil {
+		return sharedErrors.HandleGlobalError(err, verbose)
+	}
+
+	pterm.Info.Printf("Helm value changes for app-of-apps on %s:\n", target)
+	chartUI.NewDisplayService().ShowValuesDiff(plan.Changes)
+	if !plan.HasChanges() {
+		pterm.Success.Println("app-of-apps is up to date")
+		return nil
+	}
+
+	if !assumeYes {
+		confirmed, err := sharedUI.ConfirmActionInteractive(fmt.Sprintf("Upgrade app-of-apps on %s to branch '%s'?", target, plan.Branch), false)
+		if err != nil {
+			if sharedErrors.HandleConfirmationError(err) {
+				return nil
+			}
+			return err
+		}
+		if !confirmed {
+			pterm.Info.Println("Upgrade cancelled.")
+			return nil
+		}
+	}
+
+	changed, err := upgrader.Apply(ctx, opts, plan)
+	if err != nil {
+		return sharedErrors.HandleGlobalError(err, verbose)
+	}
+
+	pterm.Success.Printf("Upgraded %s to branch '%s', %d applications changed\n", target, plan.Branch, len(changed))
+	return nil
+}
@@ -0,0 +1,43 @@
+package chart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpgradeCommand(t *testing.T) {
+	cmd := getUpgradeCmd()
+
+	assert.Equal(t, "upgrade", cmd.Name())
+	assert.NotEmpty(t, cmd.Short)
+	assert.Contains(t, cmd.Long, "openframe chart upgrade my-cluster --branch develop")
+	assert.NotNil(t, cmd.RunE)
+	assert.Error(t, cmd.Args(cmd, []string{"one", "two"}), "accepts at most one cluster name")
+
+	for _, flag := range []string{"branch", "values", "github-repo", "yes"} {
+		assert.NotNil(t, cmd.Flags().Lookup(flag), flag)
+	}
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, timeout)
+
+	found := false
+	for _, sub := range GetChartCmd().Commands() {
+		if sub.Name() == "upgrade" {
+			found = true
+		}
+	}
+	assert.True(t, found, "upgrade is registered under chart")
+}
+
+func TestUpgradeCommandRequiresAChange(t *testing.T) {
+	cmd := getUpgradeCmd()
+	assert.EqualError(t, runUpgradeCommand(cmd, nil), "nothing to upgrade, set --branch, --values or both")
+
+	cmd = getUpgradeCmd()
+	assert.NoError(t, cmd.Flags().Set("branch", "develop"))
+	assert.NoError(t, cmd.Flags().Set("timeout", "0s"))
+	assert.EqualError(t, runUpgradeCommand(cmd, nil), "--timeout must be positive, got 0s")
+}
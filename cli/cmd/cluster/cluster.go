@@ -4,6 +4,7 @@ import (
 	"github.com/flamingo/openframe/internal/cluster/models"
 	"github.com/flamingo/openframe/internal/cluster/prerequisites"
 	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/flamingo/openframe/internal/shared/output"
 	"github.com/flamingo/openframe/internal/shared/ui"
 	"github.com/spf13/cobra"
 )
@@ -24,16 +25,26 @@ This command group provides cluster lifecycle management functionality:
   • delete - Remove a cluster and clean up resources  
   • list - Show all managed clusters
   • status - Display detailed cluster information
+  • ports - Show the host ports a cluster publishes
+  • kubeconfig - Print, save or merge the kubeconfig of a cluster
+  • start/stop/restart - Control running clusters without deleting them
+  • snapshot/restore - Save and reset persistent volume data
+  • upgrade - Move a cluster to a newer Kubernetes version in place
+  • scale - Add or remove agent nodes of a running cluster
   • cleanup - Remove unused images and resources
 
-Supports K3d clusters for local development.
+Supports K3d and kind clusters for local development.
 
 Examples:
   openframe cluster create
   openframe cluster delete`,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-			// Show logo for subcommands, but not for the root cluster command
-			if cmd.Use != "cluster" {
+			format, err := output.FormatFromCommand(cmd)
+			if err != nil {
+				return err
+			}
+			// Show logo for subcommands, but not for the root cluster command or machine-readable output
+			if cmd.Use != "cluster" && !format.IsStructured() && !printsKubeconfig(cmd) {
 				ui.ShowLogoWithContext(cmd.Context())
 			}
 			return prerequisites.CheckPrerequisites()
@@ -51,6 +62,15 @@ Examples:
 		getDeleteCmd(),
 		getListCmd(),
 		getStatusCmd(),
+		getPortsCmd(),
+		getKubeconfigCmd(),
+		getStartCmd(),
+		getStopCmd(),
+		getRestartCmd(),
+		getSnapshotCmd(),
+		getRestoreCmd(),
+		getUpgradeCmd(),
+		getScaleCmd(),
 		getCleanupCmd(),
 	)
 
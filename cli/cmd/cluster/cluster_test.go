@@ -1,6 +1,7 @@
 package cluster
 
 import (
+	"os"
 	"testing"
 
 	"github.com/flamingo/openframe/tests/testutil"
@@ -10,6 +11,19 @@ func init() {
 	testutil.InitializeTestMode()
 }
 
+// TestMain keeps cluster state written by create and delete out of the real home directory
+func TestMain(m *testing.M) {
+	home, err := os.MkdirTemp("", "openframe-cmd-cluster-test-")
+	if err != nil {
+		panic(err)
+	}
+	os.Setenv("HOME", home)
+
+	code := m.Run()
+	os.RemoveAll(home)
+	os.Exit(code)
+}
+
 func TestClusterRootCommand(t *testing.T) {
 	// Test the root cluster command (no setup needed for root command)
 	testutil.TestClusterCommand(t, "cluster", GetClusterCmd, nil, nil)
@@ -5,8 +5,11 @@ import (
 	"strings"
 
 	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/cluster/prerequisites"
 	"github.com/flamingo/openframe/internal/cluster/ui"
 	"github.com/flamingo/openframe/internal/cluster/utils"
+	sharedErrors "github.com/flamingo/openframe/internal/shared/errors"
+	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
 )
 
@@ -31,7 +34,14 @@ Examples:
   openframe cluster create                    # Show creation mode selection
   openframe cluster create my-cluster        # Show selection with custom name
   openframe cluster create --skip-wizard     # Direct creation with defaults
-  openframe cluster create --nodes 3 --type k3d --skip-wizard`,
+  openframe cluster create --nodes 3 --type k3d --skip-wizard
+  openframe cluster create --type kind --skip-wizard
+  openframe cluster create --config cluster.yaml --dry-run   # Review a spec file
+  openframe cluster create --config cluster.yaml --nodes 5   # Flags override the file
+
+Before the cluster is created, preflight checks verify the memory, CPUs and
+free disk of the Docker daemon as well as its inotify and open files limits.
+Failed checks stop the creation; use --skip-preflight to create anyway.`,
 		Args: cobra.MaximumNArgs(1),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			utils.SyncGlobalFlags()
@@ -62,8 +72,21 @@ func runCreateCluster(cmd *cobra.Command, args []string) error {
 
 	var config models.ClusterConfig
 
-	// Check if we should use interactive mode
-	if !globalFlags.Create.SkipWizard {
+	// An explicit --servers 0 is rejected here since zero otherwise means the default
+	if cmd.Flags().Changed("servers") {
+		if err := models.ValidateServerCount(globalFlags.Create.Servers); err != nil {
+			return err
+		}
+	}
+
+	// A spec file is declarative, so it never goes through the wizard
+	if globalFlags.Create.ConfigFile != "" {
+		var err error
+		config, err = buildConfigFromSpec(cmd, args, globalFlags.Create)
+		if err != nil {
+			return err
+		}
+	} else if !globalFlags.Create.SkipWizard {
 		// Use UI layer to handle cluster configuration
 		configHandler := ui.NewConfigurationHandler()
 		
@@ -82,6 +105,8 @@ func runCreateCluster(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
+		config.Servers = globalFlags.Create.Servers
+		config.LocalRegistry = globalFlags.Create.Registry
 	} else {
 		// Non-interactive mode - build config from flags and args
 		clusterName := ""
@@ -108,9 +133,11 @@ func runCreateCluster(cmd *cobra.Command, args []string) error {
 		config = models.ClusterConfig{
 			Name:       clusterName,
 			Type:       models.ClusterType(globalFlags.Create.ClusterType),
+			Servers:    globalFlags.Create.Servers,
 			K8sVersion: globalFlags.Create.K8sVersion,
 			NodeCount:  nodeCount,
 		}
+		config.LocalRegistry = globalFlags.Create.Registry
 
 		// Set defaults if needed
 		if config.Type == "" {
@@ -118,8 +145,21 @@ func runCreateCluster(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Unset ports are leased automatically when the cluster is created
+	config.Ports = models.ClusterPorts{
+		API:   globalFlags.Create.APIPort,
+		HTTP:  globalFlags.Create.HTTPPort,
+		HTTPS: globalFlags.Create.HTTPSPort,
+	}
+	config.NoSwitchContext = globalFlags.Create.NoSwitchContext
+
+	// The shared registries are k3d-managed containers
+	if config.LocalRegistry && config.Type != models.ClusterTypeK3d {
+		return fmt.Errorf("--registry is only supported for k3d clusters")
+	}
+
 	// Show configuration summary for dry-run or skip-wizard modes
-	if globalFlags.Create.DryRun || globalFlags.Create.SkipWizard || globalFlags.Global.Verbose {
+	if globalFlags.Create.DryRun || globalFlags.Create.SkipWizard || globalFlags.Create.ConfigFile != "" || globalFlags.Global.Verbose {
 		operationsUI := ui.NewOperationsUI()
 		operationsUI.ShowConfigurationSummary(config, globalFlags.Create.DryRun, globalFlags.Create.SkipWizard)
 
@@ -129,6 +169,67 @@ func runCreateCluster(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// kind is only required for kind clusters, so it is not part of the common prerequisites
+	if config.Type == models.ClusterTypeKind {
+		if err := prerequisites.CheckKindPrerequisites(); err != nil {
+			return err
+		}
+	}
+
+	// Check that Docker can actually run the cluster before creating anything
+	if !globalFlags.Create.SkipPreflight {
+		if err := service.RunPreflightChecks(); err != nil {
+			pterm.Error.Printf("%v\n", err)
+			pterm.Info.Println("Fix the problems above or rerun with --skip-preflight")
+			return &sharedErrors.AlreadyHandledError{OriginalError: err}
+		}
+		fmt.Println()
+	}
+
 	// Execute cluster creation through service layer
 	return service.CreateCluster(config)
 }
+
+// buildConfigFromSpec loads the cluster spec file and overrides it with explicitly set flags
+func buildConfigFromSpec(cmd *cobra.Command, args []string, flags *models.CreateFlags) (models.ClusterConfig, error) {
+	spec, err := models.LoadClusterSpec(flags.ConfigFile)
+	if err != nil {
+		return models.ClusterConfig{}, err
+	}
+
+	var overrides models.ClusterConfig
+	if len(args) > 0 {
+		overrides.Name = strings.TrimSpace(args[0])
+		if err := models.ValidateClusterName(overrides.Name); err != nil {
+			return models.ClusterConfig{}, err
+		}
+	}
+	if cmd.Flags().Changed("type") {
+		overrides.Type = models.ClusterType(flags.ClusterType)
+	}
+	if cmd.Flags().Changed("servers") {
+		overrides.Servers = flags.Servers
+	}
+	if cmd.Flags().Changed("nodes") {
+		if flags.NodeCount <= 0 {
+			return models.ClusterConfig{}, fmt.Errorf("node count must be at least 1: %d", flags.NodeCount)
+		}
+		overrides.NodeCount = flags.NodeCount
+	}
+	if cmd.Flags().Changed("version") {
+		overrides.K8sVersion = flags.K8sVersion
+	}
+	overrides.LocalRegistry = flags.Registry
+	spec.ApplyConfig(overrides)
+
+	if spec.Metadata.Name == "" {
+		spec.Metadata.Name = "openframe-dev" // default name
+	}
+
+	// Re-validate since flag overrides may have changed the spec
+	if err := spec.Validate(); err != nil {
+		return models.ClusterConfig{}, fmt.Errorf("invalid cluster spec %s: %w", flags.ConfigFile, err)
+	}
+
+	return spec.ToClusterConfig(), nil
+}
@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/flamingo/openframe/internal/shared/executor"
 	"github.com/flamingo/openframe/tests/testutil"
+	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -20,4 +22,22 @@ func TestCreateCommand(t *testing.T) {
 	}
 
 	testutil.TestClusterCommand(t, "create", getCreateCmd, setupFunc, teardownFunc)
-}
\ No newline at end of file
+}
+
+func TestCreateCommand_PreflightFailureStopsCreation(t *testing.T) {
+	mockExec := testutil.NewTestMockExecutor()
+	mockExec.SetResponse("docker info", &executor.CommandResult{ExitCode: 1, Stderr: "Cannot connect to the Docker daemon"})
+	utils.SetTestExecutor(mockExec)
+	defer utils.ResetGlobalFlags()
+
+	cmd := getCreateCmd()
+	cmd.SetArgs([]string{"dev", "--skip-wizard"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, "preflight checks failed: Docker daemon")
+	assert.True(t, mockExec.WasCommandExecuted("docker info"))
+	assert.False(t, mockExec.WasCommandExecuted("k3d cluster create"))
+}
@@ -0,0 +1,113 @@
+package cluster
+
+import (
+	"fmt"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/cluster/ui"
+	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/flamingo/openframe/internal/shared/output"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func getKubeconfigCmd() *cobra.Command {
+	// Ensure global flags are initialized
+	utils.InitGlobalFlags()
+
+	kubeconfigCmd := &cobra.Command{
+		Use:   "kubeconfig [NAME]",
+		Short: "Print, save or merge the kubeconfig of a cluster",
+		Long: `Get the kubeconfig of a cluster from its provider.
+
+By default the kubeconfig is printed to stdout. Use --file to write it to a
+file, --merge to merge it into $KUBECONFIG (or ~/.kube/config) without
+changing the current context, or --use to merge it and switch the current
+context to the cluster.
+
+Examples:
+  openframe cluster kubeconfig my-cluster > my-cluster.yaml
+  openframe cluster kubeconfig my-cluster --file ./my-cluster.yaml
+  openframe cluster kubeconfig my-cluster --merge
+  openframe cluster kubeconfig my-cluster --use`,
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			utils.SyncGlobalFlags()
+			if err := utils.ValidateGlobalFlags(); err != nil {
+				return err
+			}
+			return models.ValidateKubeconfigFlags(utils.GetGlobalFlags().Kubeconfig)
+		},
+		RunE: utils.WrapCommandWithCommonSetup(runClusterKubeconfig),
+	}
+
+	// Add kubeconfig-specific flags
+	models.AddKubeconfigFlags(kubeconfigCmd, utils.GetGlobalFlags().Kubeconfig)
+
+	return kubeconfigCmd
+}
+
+func runClusterKubeconfig(cmd *cobra.Command, args []string) error {
+	service := utils.GetCommandService()
+	kubeconfigFlags := utils.GetGlobalFlags().Kubeconfig
+
+	if kubeconfigFlags.PrintsKubeconfig() {
+		// Interactive selection would end up in the printed kubeconfig, so the name is required
+		if len(args) == 0 {
+			return output.PassthroughError(fmt.Errorf("cluster name is required to print a kubeconfig"))
+		}
+		stdout, restore := output.RedirectHumanOutput()
+		defer restore()
+
+		kubeconfig, err := service.GetKubeconfig(args[0])
+		if err != nil {
+			return output.PassthroughError(err)
+		}
+		_, err = fmt.Fprint(stdout, kubeconfig)
+		return output.PassthroughError(err)
+	}
+
+	clusters, err := service.ListClusters()
+	if err != nil {
+		return fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	clusterName, err := ui.NewOperationsUI().SelectClusterForOperation(clusters, args, "get the kubeconfig of")
+	if err != nil || clusterName == "" {
+		return err
+	}
+
+	if kubeconfigFlags.File != "" {
+		if err := service.WriteKubeconfig(clusterName, kubeconfigFlags.File); err != nil {
+			return output.PassthroughError(err)
+		}
+		pterm.Success.Printf("Kubeconfig of cluster '%s' written to %s\n", clusterName, kubeconfigFlags.File)
+		pterm.Printf("  Use it with: export KUBECONFIG=%s\n", kubeconfigFlags.File)
+		return nil
+	}
+
+	path, contextName, err := service.MergeKubeconfig(clusterName, kubeconfigFlags.Use)
+	if err != nil {
+		return output.PassthroughError(err)
+	}
+	if kubeconfigFlags.Use {
+		pterm.Success.Printf("Merged cluster '%s' into %s and switched to context %s\n", clusterName, path, contextName)
+	} else {
+		pterm.Success.Printf("Merged context %s into %s\n", contextName, path)
+		pterm.Printf("  Switch to it with: kubectl config use-context %s\n", contextName)
+	}
+	return nil
+}
+
+// printsKubeconfig reports whether a command writes a raw kubeconfig to stdout, which the logo would corrupt
+func printsKubeconfig(cmd *cobra.Command) bool {
+	if cmd.Name() != "kubeconfig" {
+		return false
+	}
+	for _, name := range []string{"file", "merge", "use"} {
+		if cmd.Flags().Changed(name) {
+			return false
+		}
+	}
+	return true
+}
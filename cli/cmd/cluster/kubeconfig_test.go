@@ -0,0 +1,68 @@
+package cluster
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/tests/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKubeconfigCommand(t *testing.T) {
+	setupFunc := func() {
+		utils.SetTestExecutor(testutil.NewTestMockExecutor())
+	}
+	teardownFunc := func() {
+		utils.ResetGlobalFlags()
+	}
+
+	testutil.TestClusterCommand(t, "kubeconfig", getKubeconfigCmd, setupFunc, teardownFunc)
+}
+
+func TestKubeconfigCommand_WritesFile(t *testing.T) {
+	mockExec := newLifecycleTestExecutor(runningK3dClusterList)
+	mockExec.SetResponse("k3d kubeconfig get dev", &executor.CommandResult{Stdout: "apiVersion: v1\nkind: Config\n"})
+	utils.SetTestExecutor(mockExec)
+	defer utils.ResetGlobalFlags()
+
+	path := filepath.Join(t.TempDir(), "dev.yaml")
+	cmd := getKubeconfigCmd()
+	cmd.SetArgs([]string{"dev", "--file", path})
+
+	require.NoError(t, cmd.Execute())
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "apiVersion: v1\nkind: Config\n", string(data))
+}
+
+func TestKubeconfigCommand_PrintRequiresName(t *testing.T) {
+	utils.SetTestExecutor(testutil.NewTestMockExecutor())
+	defer utils.ResetGlobalFlags()
+
+	cmd := getKubeconfigCmd()
+	cmd.SetArgs([]string{})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, "cluster name is required to print a kubeconfig")
+}
+
+func TestPrintsKubeconfig(t *testing.T) {
+	utils.SetTestExecutor(testutil.NewTestMockExecutor())
+	defer utils.ResetGlobalFlags()
+
+	cmd := getKubeconfigCmd()
+	assert.True(t, printsKubeconfig(cmd))
+
+	require.NoError(t, cmd.Flags().Set("merge", "true"))
+	assert.False(t, printsKubeconfig(cmd))
+
+	assert.False(t, printsKubeconfig(&cobra.Command{Use: "status"}))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/flamingo/openframe/internal/cluster/models"
 	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/flamingo/openframe/internal/shared/output"
 	"github.com/spf13/cobra"
 )
 
@@ -23,7 +24,8 @@ from all registered providers in a formatted table.
 Examples:
   openframe cluster list
   openframe cluster list --verbose
-  openframe cluster list --quiet`,
+  openframe cluster list --quiet
+  openframe cluster list --output json`,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			utils.SyncGlobalFlags()
 			if err := utils.ValidateGlobalFlags(); err != nil {
@@ -50,6 +52,20 @@ Examples:
 func runListClusters(cmd *cobra.Command, args []string) error {
 	service := utils.GetCommandService()
 
+	format, err := output.FormatFromCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if format.IsStructured() {
+		stdout, restore := output.RedirectHumanOutput()
+		defer restore()
+		clusters, err := service.ListClusters()
+		if err != nil {
+			return output.PassthroughError(fmt.Errorf("failed to list clusters: %w", err))
+		}
+		return output.PassthroughError(service.WriteClusterList(stdout, format, clusters))
+	}
+
 	// Get all clusters
 	clusters, err := service.ListClusters()
 	if err != nil {
@@ -1,10 +1,18 @@
 package cluster
 
 import (
+	"encoding/json"
+	"io"
+	"os"
 	"testing"
 
+	"github.com/flamingo/openframe/internal/cluster/models"
 	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/output"
 	"github.com/flamingo/openframe/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func init() {
@@ -20,4 +28,47 @@ func TestListCommand(t *testing.T) {
 	}
 
 	testutil.TestClusterCommand(t, "list", getListCmd, setupFunc, teardownFunc)
-}
\ No newline at end of file
+}
+func TestListCommand_JSONOutput(t *testing.T) {
+	mockExec := testutil.NewTestMockExecutor()
+	mockExec.SetResponse("k3d cluster list", &executor.CommandResult{
+		Stdout: `[{"name":"dev","serversCount":1,"serversRunning":1,"agentsCount":1,"agentsRunning":1,"nodes":[]}]`,
+	})
+	mockExec.SetResponse("kind get clusters", &executor.CommandResult{Stdout: ""})
+	utils.SetTestExecutor(mockExec)
+	defer utils.ResetGlobalFlags()
+
+	cmd := getListCmd()
+	output.AddOutputFlag(cmd)
+	cmd.SetArgs([]string{"--output", "json"})
+
+	stdout := captureStdout(t, func() {
+		require.NoError(t, cmd.Execute())
+	})
+
+	var document models.ClusterListOutput
+	require.NoError(t, json.Unmarshal([]byte(stdout), &document))
+	assert.Equal(t, models.ClusterListKind, document.Kind)
+	require.Len(t, document.Clusters, 1)
+	assert.Equal(t, "dev", document.Clusters[0].Name)
+	assert.Equal(t, 2, document.Clusters[0].NodeCount)
+}
+
+// captureStdout returns everything written to stdout while fn runs
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+
+	reader, writer, err := os.Pipe()
+	require.NoError(t, err)
+
+	original := os.Stdout
+	os.Stdout = writer
+	defer func() { os.Stdout = original }()
+
+	fn()
+
+	require.NoError(t, writer.Close())
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	return string(data)
+}
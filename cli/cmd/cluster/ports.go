@@ -0,0 +1,69 @@
+package cluster
+
+import (
+	"fmt"
+
+	"github.com/flamingo/openframe/internal/cluster/ui"
+	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/flamingo/openframe/internal/shared/output"
+	"github.com/spf13/cobra"
+)
+
+func getPortsCmd() *cobra.Command {
+	// Ensure global flags are initialized
+	utils.InitGlobalFlags()
+
+	portsCmd := &cobra.Command{
+		Use:   "ports [NAME]",
+		Short: "Show the host ports published by a cluster",
+		Long: `Show the host ports a cluster publishes for the Kubernetes API
+server and the HTTP/HTTPS ingress, and the container ports they map to.
+
+Ports are leased per cluster when it is created, so clusters created at
+the same time never collide. Use --api-port, --http-port and --https-port
+on create to choose them explicitly.
+
+Examples:
+  openframe cluster ports my-cluster
+  openframe cluster ports my-cluster -o json   # Machine-readable output
+  openframe cluster ports  # interactive selection`,
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			utils.SyncGlobalFlags()
+			return utils.ValidateGlobalFlags()
+		},
+		RunE: utils.WrapCommandWithCommonSetup(runClusterPorts),
+	}
+
+	return portsCmd
+}
+
+func runClusterPorts(cmd *cobra.Command, args []string) error {
+	service := utils.GetCommandService()
+
+	format, err := output.FormatFromCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if format.IsStructured() {
+		// Interactive selection would corrupt the document, so the name is required
+		if len(args) == 0 {
+			return output.PassthroughError(fmt.Errorf("cluster name is required with --output %s", format))
+		}
+		stdout, restore := output.RedirectHumanOutput()
+		defer restore()
+		return output.PassthroughError(service.WriteClusterPorts(stdout, format, args[0]))
+	}
+
+	clusters, err := service.ListClusters()
+	if err != nil {
+		return fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	clusterName, err := ui.NewOperationsUI().SelectClusterForOperation(clusters, args, "show ports")
+	if err != nil || clusterName == "" {
+		return err
+	}
+
+	return service.ShowClusterPorts(clusterName)
+}
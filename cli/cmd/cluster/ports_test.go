@@ -0,0 +1,49 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/flamingo/openframe/internal/shared/output"
+	"github.com/flamingo/openframe/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortsCommand(t *testing.T) {
+	setupFunc := func() {
+		utils.SetTestExecutor(testutil.NewTestMockExecutor())
+	}
+	teardownFunc := func() {
+		utils.ResetGlobalFlags()
+	}
+
+	testutil.TestClusterCommand(t, "ports", getPortsCmd, setupFunc, teardownFunc)
+}
+
+func TestPortsCommand_ShowsCluster(t *testing.T) {
+	mockExec := newLifecycleTestExecutor(runningK3dClusterList)
+	utils.SetTestExecutor(mockExec)
+	defer utils.ResetGlobalFlags()
+
+	cmd := getPortsCmd()
+	cmd.SetArgs([]string{"dev"})
+
+	require.NoError(t, cmd.Execute())
+	assert.True(t, mockExec.WasCommandExecuted("k3d cluster list"))
+}
+
+func TestPortsCommand_StructuredOutputRequiresName(t *testing.T) {
+	utils.SetTestExecutor(testutil.NewTestMockExecutor())
+	defer utils.ResetGlobalFlags()
+
+	cmd := getPortsCmd()
+	output.AddOutputFlag(cmd)
+	cmd.SetArgs([]string{"-o", "json"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, "cluster name is required with --output json")
+}
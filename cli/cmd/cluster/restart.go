@@ -0,0 +1,65 @@
+package cluster
+
+import (
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/cluster/ui"
+	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/spf13/cobra"
+)
+
+func getRestartCmd() *cobra.Command {
+	// Ensure global flags are initialized
+	utils.InitGlobalFlags()
+
+	restartCmd := &cobra.Command{
+		Use:   "restart [NAME]",
+		Short: "Restart a cluster",
+		Long: `Stop and start the nodes of a cluster.
+
+After the nodes are started again, waits until every node is Ready and all
+ArgoCD applications report Healthy. Use --no-wait to return immediately.
+
+Examples:
+  openframe cluster restart my-cluster
+  openframe cluster restart my-cluster --timeout 5m
+  openframe cluster restart  # interactive selection`,
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			utils.SyncGlobalFlags()
+			if err := utils.ValidateGlobalFlags(); err != nil {
+				return err
+			}
+			return models.ValidateStartFlags(utils.GetGlobalFlags().Restart)
+		},
+		RunE: utils.WrapCommandWithCommonSetup(runRestartCluster),
+	}
+
+	// Restart shares the start wait flags
+	models.AddStartFlags(restartCmd, utils.GetGlobalFlags().Restart)
+
+	return restartCmd
+}
+
+func runRestartCluster(cmd *cobra.Command, args []string) error {
+	service := utils.GetCommandService()
+	operationsUI := ui.NewOperationsUI()
+	restartFlags := utils.GetGlobalFlags().Restart
+
+	clusterInfo, err := selectLifecycleCluster(service, operationsUI, args, "restart")
+	if err != nil || clusterInfo == nil {
+		return err
+	}
+
+	operationsUI.ShowOperationStart("restart", clusterInfo.Name)
+
+	if err := service.RestartCluster(clusterInfo.Name, clusterInfo.Type); err != nil {
+		return lifecycleError(operationsUI, "restart", clusterInfo.Name, err)
+	}
+
+	if err := waitForLifecycleReady(service, *clusterInfo, restartFlags); err != nil {
+		return lifecycleError(operationsUI, "restart", clusterInfo.Name, err)
+	}
+
+	operationsUI.ShowOperationSuccess("restart", clusterInfo.Name)
+	return nil
+}
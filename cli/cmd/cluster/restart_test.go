@@ -0,0 +1,39 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/flamingo/openframe/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	testutil.InitializeTestMode()
+}
+
+func TestRestartCommand(t *testing.T) {
+	setupFunc := func() {
+		utils.SetTestExecutor(testutil.NewTestMockExecutor())
+	}
+	teardownFunc := func() {
+		utils.ResetGlobalFlags()
+	}
+
+	testutil.TestClusterCommand(t, "restart", getRestartCmd, setupFunc, teardownFunc)
+}
+
+func TestRestartCommand_StopsAndStartsCluster(t *testing.T) {
+	mockExec := newLifecycleTestExecutor(runningK3dClusterList)
+	utils.SetTestExecutor(mockExec)
+	defer utils.ResetGlobalFlags()
+
+	cmd := getRestartCmd()
+	cmd.SetArgs([]string{"dev"})
+
+	require.NoError(t, cmd.Execute())
+	assert.True(t, mockExec.WasCommandExecuted("k3d cluster stop dev"))
+	assert.True(t, mockExec.WasCommandExecuted("k3d cluster start dev"))
+	assert.True(t, mockExec.WasCommandExecuted("kubectl --context k3d-dev get nodes"))
+}
@@ -0,0 +1,77 @@
+package cluster
+
+import (
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/cluster/ui"
+	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func getRestoreCmd() *cobra.Command {
+	// Ensure global flags are initialized
+	utils.InitGlobalFlags()
+
+	restoreCmd := &cobra.Command{
+		Use:   "restore [NAME]",
+		Short: "Restore a cluster from a snapshot",
+		Long: `Reset a k3d cluster to the state captured by 'openframe cluster snapshot'.
+
+The cluster is stopped, the volume data and k3s datastore of every node are
+replaced with the snapshot contents, and the cluster is started again. The
+snapshot must have been taken from the same cluster with the same nodes.
+
+After the nodes are started, waits until every node is Ready and all
+ArgoCD applications report Healthy. Use --no-wait to return immediately.
+
+Examples:
+  openframe cluster restore my-cluster --from seeded
+  openframe cluster restore my-cluster --from ./snapshots/seeded.tar.gz
+  openframe cluster restore my-cluster --from seeded --no-wait`,
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			utils.SyncGlobalFlags()
+			if err := utils.ValidateGlobalFlags(); err != nil {
+				return err
+			}
+			return models.ValidateRestoreFlags(utils.GetGlobalFlags().Restore)
+		},
+		RunE: utils.WrapCommandWithCommonSetup(runRestoreCluster),
+	}
+
+	// Add restore-specific flags
+	models.AddRestoreFlags(restoreCmd, utils.GetGlobalFlags().Restore)
+
+	return restoreCmd
+}
+
+func runRestoreCluster(cmd *cobra.Command, args []string) error {
+	service := utils.GetCommandService()
+	operationsUI := ui.NewOperationsUI()
+	restoreFlags := utils.GetGlobalFlags().Restore
+
+	clusterInfo, err := selectLifecycleCluster(service, operationsUI, args, "restore")
+	if err != nil || clusterInfo == nil {
+		return err
+	}
+
+	operationsUI.ShowOperationStart("restore", clusterInfo.Name)
+
+	manifest, err := service.RestoreCluster(clusterInfo.Name, restoreFlags.From)
+	if err != nil {
+		return lifecycleError(operationsUI, "restore", clusterInfo.Name, err)
+	}
+
+	if !restoreFlags.NoWait {
+		if err := service.WaitForClusterReady(clusterInfo.Name, clusterInfo.Type, restoreFlags.Timeout); err != nil {
+			return lifecycleError(operationsUI, "restore", clusterInfo.Name, err)
+		}
+	}
+
+	pterm.Success.Printf("Cluster '%s' restored from snapshot '%s' (taken %s)\n",
+		pterm.Cyan(clusterInfo.Name), manifest.Name, manifest.CreatedAt.Local().Format("2006-01-02 15:04"))
+	if manifest.AppOfAppsRevision != "" {
+		pterm.Printf("  App-of-apps revision: %s\n", manifest.AppOfAppsRevision)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/flamingo/openframe/tests/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	testutil.InitializeTestMode()
+}
+
+func TestRestoreCommand(t *testing.T) {
+	setupFunc := func() {
+		utils.SetTestExecutor(testutil.NewTestMockExecutor())
+	}
+	teardownFunc := func() {
+		utils.ResetGlobalFlags()
+	}
+
+	testutil.TestClusterCommand(t, "restore", getRestoreCmd, setupFunc, teardownFunc)
+}
+
+func TestRestoreCommand_RequiresFrom(t *testing.T) {
+	utils.SetTestExecutor(newLifecycleTestExecutor(runningK3dClusterList))
+	defer utils.ResetGlobalFlags()
+
+	cmd := getRestoreCmd()
+	cmd.SetArgs([]string{"dev"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, "--from is required: pass a snapshot name or archive path")
+}
+
+func TestRestoreCommand_UnknownSnapshot(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	mockExec := newLifecycleTestExecutor(runningK3dClusterList)
+	utils.SetTestExecutor(mockExec)
+	defer utils.ResetGlobalFlags()
+
+	cmd := getRestoreCmd()
+	cmd.SetArgs([]string{"dev", "--from", "missing"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, "snapshot 'missing' not found")
+	assert.False(t, mockExec.WasCommandExecuted("k3d cluster stop"))
+}
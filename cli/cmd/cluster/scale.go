@@ -0,0 +1,83 @@
+package cluster
+
+import (
+	"fmt"
+
+	"github.com/flamingo/openframe/internal/cluster"
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/cluster/ui"
+	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func getScaleCmd() *cobra.Command {
+	// Ensure global flags are initialized
+	utils.InitGlobalFlags()
+
+	scaleCmd := &cobra.Command{
+		Use:   "scale [NAME]",
+		Short: "Add or remove agent nodes of a running cluster",
+		Long: `Change the number of agent (worker) nodes of a running k3d cluster.
+
+New agents are created with 'k3d node create' on the k3s version of the
+server nodes and the command waits until each one is Ready. When scaling
+down, the newest agents are cordoned and drained first, then their
+containers and Node objects are removed. Server nodes are never removed.
+
+Examples:
+  openframe cluster scale my-cluster --agents 5
+  openframe cluster scale my-cluster --agents 1 --timeout 10m
+  openframe cluster scale --agents 3  # interactive selection`,
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			utils.SyncGlobalFlags()
+			if err := utils.ValidateGlobalFlags(); err != nil {
+				return err
+			}
+			return models.ValidateScaleFlags(utils.GetGlobalFlags().Scale)
+		},
+		RunE: utils.WrapCommandWithCommonSetup(runScaleCluster),
+	}
+
+	// Add scale-specific flags
+	models.AddScaleFlags(scaleCmd, utils.GetGlobalFlags().Scale)
+
+	return scaleCmd
+}
+
+func runScaleCluster(cmd *cobra.Command, args []string) error {
+	service := utils.GetCommandService()
+	operationsUI := ui.NewOperationsUI()
+	scaleFlags := utils.GetGlobalFlags().Scale
+
+	clusterInfo, err := selectLifecycleCluster(service, operationsUI, args, "scale")
+	if err != nil || clusterInfo == nil {
+		return err
+	}
+
+	operationsUI.ShowOperationStart("scale", clusterInfo.Name)
+
+	result, err := service.ScaleCluster(clusterInfo.Name, cluster.ScaleOptions{
+		Agents:  scaleFlags.Agents,
+		Timeout: scaleFlags.Timeout,
+	})
+	if err != nil {
+		return lifecycleError(operationsUI, "scale", clusterInfo.Name, err)
+	}
+
+	if result.FromAgents == result.ToAgents {
+		pterm.Info.Printf("Cluster '%s' already has %d agent node(s)\n", pterm.Cyan(clusterInfo.Name), result.ToAgents)
+		return nil
+	}
+
+	fmt.Println()
+	pterm.Success.Printf("Cluster '%s' scaled from %d to %d agent node(s)\n", pterm.Cyan(clusterInfo.Name), result.FromAgents, result.ToAgents)
+	for _, node := range result.Added {
+		pterm.Printf("  + %s\n", node)
+	}
+	for _, node := range result.Removed {
+		pterm.Printf("  - %s\n", node)
+	}
+	return nil
+}
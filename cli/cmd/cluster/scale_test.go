@@ -0,0 +1,54 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/flamingo/openframe/tests/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	testutil.InitializeTestMode()
+}
+
+func TestScaleCommand(t *testing.T) {
+	setupFunc := func() {
+		utils.SetTestExecutor(testutil.NewTestMockExecutor())
+	}
+	teardownFunc := func() {
+		utils.ResetGlobalFlags()
+	}
+
+	testutil.TestClusterCommand(t, "scale", getScaleCmd, setupFunc, teardownFunc)
+}
+
+func TestScaleCommand_RequiresAgents(t *testing.T) {
+	utils.SetTestExecutor(newLifecycleTestExecutor(runningK3dClusterList))
+	defer utils.ResetGlobalFlags()
+
+	cmd := getScaleCmd()
+	cmd.SetArgs([]string{"dev"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, "--agents is required: pass the number of agent nodes the cluster should have")
+}
+
+func TestScaleCommand_StoppedCluster(t *testing.T) {
+	mockExec := newLifecycleTestExecutor(stoppedK3dClusterList)
+	utils.SetTestExecutor(mockExec)
+	defer utils.ResetGlobalFlags()
+
+	cmd := getScaleCmd()
+	cmd.SetArgs([]string{"dev", "--agents", "2"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, "cluster dev is stopped; start it before scaling")
+	assert.False(t, mockExec.WasCommandExecuted("k3d node create"))
+}
@@ -0,0 +1,82 @@
+package cluster
+
+import (
+	"fmt"
+
+	"github.com/flamingo/openframe/internal/cluster"
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/cluster/ui"
+	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func getSnapshotCmd() *cobra.Command {
+	// Ensure global flags are initialized
+	utils.InitGlobalFlags()
+
+	snapshotCmd := &cobra.Command{
+		Use:   "snapshot [NAME]",
+		Short: "Snapshot the persistent volumes of a cluster",
+		Long: `Archive the persistent state of a k3d cluster into a tarball.
+
+The snapshot contains the local-path volume data and the k3s datastore of
+every node, the values of all installed helm releases and the revision of
+each ArgoCD application. The cluster is stopped while its volumes are
+archived and started again afterwards.
+
+Snapshots are written to ~/.config/openframe/snapshots unless --dir is set
+and can be restored with 'openframe cluster restore'.
+
+Examples:
+  openframe cluster snapshot my-cluster
+  openframe cluster snapshot my-cluster --name seeded
+  openframe cluster snapshot my-cluster --dir ./snapshots`,
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			utils.SyncGlobalFlags()
+			if err := utils.ValidateGlobalFlags(); err != nil {
+				return err
+			}
+			return models.ValidateSnapshotFlags(utils.GetGlobalFlags().Snapshot)
+		},
+		RunE: utils.WrapCommandWithCommonSetup(runSnapshotCluster),
+	}
+
+	// Add snapshot-specific flags
+	models.AddSnapshotFlags(snapshotCmd, utils.GetGlobalFlags().Snapshot)
+
+	return snapshotCmd
+}
+
+func runSnapshotCluster(cmd *cobra.Command, args []string) error {
+	service := utils.GetCommandService()
+	operationsUI := ui.NewOperationsUI()
+	snapshotFlags := utils.GetGlobalFlags().Snapshot
+
+	clusterInfo, err := selectLifecycleCluster(service, operationsUI, args, "snapshot")
+	if err != nil || clusterInfo == nil {
+		return err
+	}
+
+	operationsUI.ShowOperationStart("snapshot", clusterInfo.Name)
+
+	archivePath, manifest, err := service.SnapshotCluster(clusterInfo.Name, cluster.SnapshotOptions{
+		Name:      snapshotFlags.Name,
+		Directory: snapshotFlags.Directory,
+	})
+	if err != nil {
+		return lifecycleError(operationsUI, "snapshot", clusterInfo.Name, err)
+	}
+
+	pterm.Success.Printf("Snapshot '%s' written to %s\n", pterm.Cyan(manifest.Name), archivePath)
+	pterm.Printf("  Nodes:          %d\n", len(manifest.Nodes))
+	pterm.Printf("  Helm releases:  %d\n", len(manifest.HelmReleases))
+	pterm.Printf("  Applications:   %d\n", len(manifest.Applications))
+	if manifest.AppOfAppsRevision != "" {
+		pterm.Printf("  App-of-apps:    %s\n", manifest.AppOfAppsRevision)
+	}
+	fmt.Println()
+	pterm.Info.Printf("Restore with: openframe cluster restore %s --from %s\n", clusterInfo.Name, manifest.Name)
+	return nil
+}
@@ -0,0 +1,38 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/flamingo/openframe/tests/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	testutil.InitializeTestMode()
+}
+
+func TestSnapshotCommand(t *testing.T) {
+	setupFunc := func() {
+		utils.SetTestExecutor(testutil.NewTestMockExecutor())
+	}
+	teardownFunc := func() {
+		utils.ResetGlobalFlags()
+	}
+
+	testutil.TestClusterCommand(t, "snapshot", getSnapshotCmd, setupFunc, teardownFunc)
+}
+
+func TestSnapshotCommand_RejectsPathInName(t *testing.T) {
+	utils.SetTestExecutor(newLifecycleTestExecutor(runningK3dClusterList))
+	defer utils.ResetGlobalFlags()
+
+	cmd := getSnapshotCmd()
+	cmd.SetArgs([]string{"dev", "--name", "../seeded"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, "snapshot name must not contain path separators: ../seeded")
+}
@@ -0,0 +1,112 @@
+package cluster
+
+import (
+	"fmt"
+
+	"github.com/flamingo/openframe/internal/cluster"
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/cluster/ui"
+	"github.com/flamingo/openframe/internal/cluster/utils"
+	sharedErrors "github.com/flamingo/openframe/internal/shared/errors"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func getStartCmd() *cobra.Command {
+	// Ensure global flags are initialized
+	utils.InitGlobalFlags()
+
+	startCmd := &cobra.Command{
+		Use:   "start [NAME]",
+		Short: "Start a stopped cluster",
+		Long: `Start the nodes of a stopped cluster.
+
+After the nodes are started, waits until every node is Ready and all
+ArgoCD applications report Healthy. Use --no-wait to return immediately.
+
+Examples:
+  openframe cluster start my-cluster
+  openframe cluster start my-cluster --timeout 5m
+  openframe cluster start my-cluster --no-wait
+  openframe cluster start  # interactive selection`,
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			utils.SyncGlobalFlags()
+			if err := utils.ValidateGlobalFlags(); err != nil {
+				return err
+			}
+			return models.ValidateStartFlags(utils.GetGlobalFlags().Start)
+		},
+		RunE: utils.WrapCommandWithCommonSetup(runStartCluster),
+	}
+
+	// Add start-specific flags
+	models.AddStartFlags(startCmd, utils.GetGlobalFlags().Start)
+
+	return startCmd
+}
+
+func runStartCluster(cmd *cobra.Command, args []string) error {
+	service := utils.GetCommandService()
+	operationsUI := ui.NewOperationsUI()
+	startFlags := utils.GetGlobalFlags().Start
+
+	clusterInfo, err := selectLifecycleCluster(service, operationsUI, args, "start")
+	if err != nil || clusterInfo == nil {
+		return err
+	}
+
+	if clusterInfo.State == models.ClusterStateRunning {
+		pterm.Info.Printf("Cluster '%s' is already running\n", pterm.Cyan(clusterInfo.Name))
+		return nil
+	}
+
+	operationsUI.ShowOperationStart("start", clusterInfo.Name)
+
+	if err := service.StartCluster(clusterInfo.Name, clusterInfo.Type); err != nil {
+		return lifecycleError(operationsUI, "start", clusterInfo.Name, err)
+	}
+
+	if err := waitForLifecycleReady(service, *clusterInfo, startFlags); err != nil {
+		return lifecycleError(operationsUI, "start", clusterInfo.Name, err)
+	}
+
+	operationsUI.ShowOperationSuccess("start", clusterInfo.Name)
+	return nil
+}
+
+// selectLifecycleCluster resolves the cluster named in args, or prompts for one, and returns its current info
+// A nil result without error means there was nothing to select
+func selectLifecycleCluster(service *cluster.ClusterService, operationsUI *ui.OperationsUI, args []string, operation string) (*models.ClusterInfo, error) {
+	clusters, err := service.ListClusters()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	clusterName, err := operationsUI.SelectClusterForOperation(clusters, args, operation)
+	if err != nil || clusterName == "" {
+		return nil, err
+	}
+
+	for i := range clusters {
+		if clusters[i].Name == clusterName {
+			return &clusters[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("cluster '%s' not found", clusterName)
+}
+
+// waitForLifecycleReady waits for nodes and ArgoCD applications unless --no-wait was given
+func waitForLifecycleReady(service *cluster.ClusterService, clusterInfo models.ClusterInfo, flags *models.StartFlags) error {
+	if flags.NoWait {
+		return nil
+	}
+	return service.WaitForClusterReady(clusterInfo.Name, clusterInfo.Type, flags.Timeout)
+}
+
+// lifecycleError shows a friendly error and marks it handled so the command still exits non-zero
+func lifecycleError(operationsUI *ui.OperationsUI, operation, clusterName string, err error) error {
+	operationsUI.ShowOperationError(operation, clusterName, err)
+	return &sharedErrors.AlreadyHandledError{OriginalError: err}
+}
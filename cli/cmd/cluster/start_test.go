@@ -0,0 +1,93 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	testutil.InitializeTestMode()
+}
+
+const (
+	runningK3dClusterList = `[{"name":"dev","serversCount":1,"serversRunning":1,"agentsCount":1,"agentsRunning":1,"nodes":[]}]`
+	stoppedK3dClusterList = `[{"name":"dev","serversCount":1,"serversRunning":0,"agentsCount":1,"agentsRunning":0,"nodes":[]}]`
+)
+
+// newLifecycleTestExecutor returns a mock executor that lists a single k3d cluster named dev
+func newLifecycleTestExecutor(clusterList string) *executor.MockCommandExecutor {
+	mockExec := testutil.NewTestMockExecutor()
+	mockExec.SetResponse("k3d cluster list", &executor.CommandResult{Stdout: clusterList})
+	mockExec.SetResponse("kind get clusters", &executor.CommandResult{Stdout: ""})
+	mockExec.SetResponse("get nodes", &executor.CommandResult{Stdout: "k3d-dev-server-0   Ready   control-plane,master   1d   v1.31.5+k3s1"})
+	mockExec.SetResponse("get applications", &executor.CommandResult{Stdout: `{"items":[]}`})
+	return mockExec
+}
+
+func TestStartCommand(t *testing.T) {
+	setupFunc := func() {
+		utils.SetTestExecutor(testutil.NewTestMockExecutor())
+	}
+	teardownFunc := func() {
+		utils.ResetGlobalFlags()
+	}
+
+	testutil.TestClusterCommand(t, "start", getStartCmd, setupFunc, teardownFunc)
+}
+
+func TestStartCommand_StartsStoppedCluster(t *testing.T) {
+	mockExec := newLifecycleTestExecutor(stoppedK3dClusterList)
+	utils.SetTestExecutor(mockExec)
+	defer utils.ResetGlobalFlags()
+
+	cmd := getStartCmd()
+	cmd.SetArgs([]string{"dev"})
+
+	require.NoError(t, cmd.Execute())
+	assert.True(t, mockExec.WasCommandExecuted("k3d cluster start dev"))
+	assert.True(t, mockExec.WasCommandExecuted("kubectl --context k3d-dev get nodes"))
+}
+
+func TestStartCommand_NoWait(t *testing.T) {
+	mockExec := newLifecycleTestExecutor(stoppedK3dClusterList)
+	utils.SetTestExecutor(mockExec)
+	defer utils.ResetGlobalFlags()
+
+	cmd := getStartCmd()
+	cmd.SetArgs([]string{"dev", "--no-wait"})
+
+	require.NoError(t, cmd.Execute())
+	assert.True(t, mockExec.WasCommandExecuted("k3d cluster start dev"))
+	assert.False(t, mockExec.WasCommandExecuted("get nodes"))
+}
+
+func TestStartCommand_AlreadyRunning(t *testing.T) {
+	mockExec := newLifecycleTestExecutor(runningK3dClusterList)
+	utils.SetTestExecutor(mockExec)
+	defer utils.ResetGlobalFlags()
+
+	cmd := getStartCmd()
+	cmd.SetArgs([]string{"dev"})
+
+	require.NoError(t, cmd.Execute())
+	assert.False(t, mockExec.WasCommandExecuted("k3d cluster start"))
+}
+
+func TestStartCommand_InvalidTimeout(t *testing.T) {
+	utils.SetTestExecutor(newLifecycleTestExecutor(stoppedK3dClusterList))
+	defer utils.ResetGlobalFlags()
+
+	cmd := getStartCmd()
+	cmd.SetArgs([]string{"dev", "--timeout", "0s"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, "timeout must be greater than zero: 0s")
+}
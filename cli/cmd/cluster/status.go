@@ -6,6 +6,7 @@ import (
 	"github.com/flamingo/openframe/internal/cluster/models"
 	"github.com/flamingo/openframe/internal/cluster/ui"
 	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/flamingo/openframe/internal/shared/output"
 	"github.com/spf13/cobra"
 )
 
@@ -21,10 +22,15 @@ func getStatusCmd() *cobra.Command {
 Displays cluster health, node status, installed applications,
 resource usage, and connectivity information.
 
+ArgoCD applications are listed grouped by sync wave. The command exits
+with a non-zero status when any application is degraded.
+
 Examples:
   openframe cluster status my-cluster
   openframe cluster status  # interactive selection
-  openframe cluster status my-cluster --detailed`,
+  openframe cluster status my-cluster --detailed
+  openframe cluster status my-cluster --no-apps   # Skip ArgoCD applications
+  openframe cluster status my-cluster -o json     # Machine-readable output`,
 		Args: cobra.MaximumNArgs(1),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			utils.SyncGlobalFlags()
@@ -45,6 +51,21 @@ Examples:
 func runClusterStatus(cmd *cobra.Command, args []string) error {
 	service := utils.GetCommandService()
 	operationsUI := ui.NewOperationsUI()
+	globalFlags := utils.GetGlobalFlags()
+
+	format, err := output.FormatFromCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if format.IsStructured() {
+		// Interactive selection would corrupt the document, so the name is required
+		if len(args) == 0 {
+			return output.PassthroughError(fmt.Errorf("cluster name is required with --output %s", format))
+		}
+		stdout, restore := output.RedirectHumanOutput()
+		defer restore()
+		return output.PassthroughError(service.WriteClusterStatus(stdout, format, args[0], globalFlags.Status.Detailed, globalFlags.Status.NoApps))
+	}
 	
 	// Get all available clusters
 	clusters, err := service.ListClusters()
@@ -64,7 +85,6 @@ func runClusterStatus(cmd *cobra.Command, args []string) error {
 	}
 	
 	// Execute cluster status through service layer
-	globalFlags := utils.GetGlobalFlags()
 	return service.ShowClusterStatus(clusterName, globalFlags.Status.Detailed, globalFlags.Status.NoApps, globalFlags.Global.Verbose)
 }
 
@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/flamingo/openframe/internal/shared/output"
 	"github.com/flamingo/openframe/tests/testutil"
+	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -20,4 +22,18 @@ func TestStatusCommand(t *testing.T) {
 	}
 
 	testutil.TestClusterCommand(t, "status", getStatusCmd, setupFunc, teardownFunc)
-}
\ No newline at end of file
+}
+func TestStatusCommand_StructuredOutputRequiresName(t *testing.T) {
+	utils.SetTestExecutor(testutil.NewTestMockExecutor())
+	defer utils.ResetGlobalFlags()
+
+	cmd := getStatusCmd()
+	output.AddOutputFlag(cmd)
+	cmd.SetArgs([]string{"-o", "yaml"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, "cluster name is required with --output yaml")
+}
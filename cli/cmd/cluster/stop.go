@@ -0,0 +1,62 @@
+package cluster
+
+import (
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/cluster/ui"
+	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func getStopCmd() *cobra.Command {
+	// Ensure global flags are initialized
+	utils.InitGlobalFlags()
+
+	stopCmd := &cobra.Command{
+		Use:   "stop [NAME]",
+		Short: "Stop a running cluster",
+		Long: `Stop the nodes of a running cluster without deleting it.
+
+Containers, volumes and installed charts are kept, so the cluster can be
+brought back with 'openframe cluster start'.
+
+Examples:
+  openframe cluster stop my-cluster
+  openframe cluster stop  # interactive selection`,
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			utils.SyncGlobalFlags()
+			if err := utils.ValidateGlobalFlags(); err != nil {
+				return err
+			}
+			return models.ValidateStopFlags(utils.GetGlobalFlags().Stop)
+		},
+		RunE: utils.WrapCommandWithCommonSetup(runStopCluster),
+	}
+
+	return stopCmd
+}
+
+func runStopCluster(cmd *cobra.Command, args []string) error {
+	service := utils.GetCommandService()
+	operationsUI := ui.NewOperationsUI()
+
+	clusterInfo, err := selectLifecycleCluster(service, operationsUI, args, "stop")
+	if err != nil || clusterInfo == nil {
+		return err
+	}
+
+	if clusterInfo.IsStopped() {
+		pterm.Info.Printf("Cluster '%s' is already stopped\n", pterm.Cyan(clusterInfo.Name))
+		return nil
+	}
+
+	operationsUI.ShowOperationStart("stop", clusterInfo.Name)
+
+	if err := service.StopCluster(clusterInfo.Name, clusterInfo.Type); err != nil {
+		return lifecycleError(operationsUI, "stop", clusterInfo.Name, err)
+	}
+
+	operationsUI.ShowOperationSuccess("stop", clusterInfo.Name)
+	return nil
+}
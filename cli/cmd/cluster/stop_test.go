@@ -0,0 +1,63 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/flamingo/openframe/tests/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	testutil.InitializeTestMode()
+}
+
+func TestStopCommand(t *testing.T) {
+	setupFunc := func() {
+		utils.SetTestExecutor(testutil.NewTestMockExecutor())
+	}
+	teardownFunc := func() {
+		utils.ResetGlobalFlags()
+	}
+
+	testutil.TestClusterCommand(t, "stop", getStopCmd, setupFunc, teardownFunc)
+}
+
+func TestStopCommand_StopsRunningCluster(t *testing.T) {
+	mockExec := newLifecycleTestExecutor(runningK3dClusterList)
+	utils.SetTestExecutor(mockExec)
+	defer utils.ResetGlobalFlags()
+
+	cmd := getStopCmd()
+	cmd.SetArgs([]string{"dev"})
+
+	require.NoError(t, cmd.Execute())
+	assert.True(t, mockExec.WasCommandExecuted("k3d cluster stop dev"))
+}
+
+func TestStopCommand_AlreadyStopped(t *testing.T) {
+	mockExec := newLifecycleTestExecutor(stoppedK3dClusterList)
+	utils.SetTestExecutor(mockExec)
+	defer utils.ResetGlobalFlags()
+
+	cmd := getStopCmd()
+	cmd.SetArgs([]string{"dev"})
+
+	require.NoError(t, cmd.Execute())
+	assert.False(t, mockExec.WasCommandExecuted("k3d cluster stop"))
+}
+
+func TestStopCommand_UnknownCluster(t *testing.T) {
+	utils.SetTestExecutor(newLifecycleTestExecutor(runningK3dClusterList))
+	defer utils.ResetGlobalFlags()
+
+	cmd := getStopCmd()
+	cmd.SetArgs([]string{"missing"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, "cluster 'missing' not found")
+}
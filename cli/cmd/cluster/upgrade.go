@@ -0,0 +1,100 @@
+package cluster
+
+import (
+	"fmt"
+
+	"github.com/flamingo/openframe/internal/cluster"
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/cluster/ui"
+	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func getUpgradeCmd() *cobra.Command {
+	// Ensure global flags are initialized
+	utils.InitGlobalFlags()
+
+	upgradeCmd := &cobra.Command{
+		Use:   "upgrade [NAME]",
+		Short: "Upgrade the Kubernetes version of a cluster in place",
+		Long: `Upgrade a running k3d cluster to another k3s release without recreating it.
+
+Nodes are upgraded one at a time, servers first: each node container is
+stopped, given the k3s binary of the target release and started again, so
+volumes and cluster data are kept. The next node is only upgraded once the
+previous one is Ready on the new version.
+
+Before any node is touched, the target version is checked against the
+current one (no downgrades, one minor version at a time) and against the
+kubeVersion of every deployed helm chart. Use --force to upgrade despite
+incompatible charts and --dry-run to only run the checks.
+
+Examples:
+  openframe cluster upgrade my-cluster --version v1.32.2-k3s1
+  openframe cluster upgrade my-cluster --version v1.32.2-k3s1 --dry-run
+  openframe cluster upgrade --version v1.32.2-k3s1  # interactive selection`,
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			utils.SyncGlobalFlags()
+			if err := utils.ValidateGlobalFlags(); err != nil {
+				return err
+			}
+			return models.ValidateUpgradeFlags(utils.GetGlobalFlags().Upgrade)
+		},
+		RunE: utils.WrapCommandWithCommonSetup(runUpgradeCluster),
+	}
+
+	// Add upgrade-specific flags
+	models.AddUpgradeFlags(upgradeCmd, utils.GetGlobalFlags().Upgrade)
+
+	return upgradeCmd
+}
+
+func runUpgradeCluster(cmd *cobra.Command, args []string) error {
+	service := utils.GetCommandService()
+	operationsUI := ui.NewOperationsUI()
+	upgradeFlags := utils.GetGlobalFlags().Upgrade
+
+	clusterInfo, err := selectLifecycleCluster(service, operationsUI, args, "upgrade")
+	if err != nil || clusterInfo == nil {
+		return err
+	}
+
+	operationsUI.ShowOperationStart("upgrade", clusterInfo.Name)
+
+	plan, err := service.UpgradeCluster(clusterInfo.Name, cluster.UpgradeOptions{
+		Version: upgradeFlags.Version,
+		Force:   upgradeFlags.Force,
+		DryRun:  upgradeFlags.DryRun,
+		Timeout: upgradeFlags.Timeout,
+	})
+	if plan != nil {
+		fmt.Println()
+		cluster.ShowUpgradePlan(plan)
+		fmt.Println()
+	}
+	if err != nil {
+		return lifecycleError(operationsUI, "upgrade", clusterInfo.Name, err)
+	}
+
+	switch {
+	case upgradeFlags.DryRun:
+		pterm.Info.Printf("Dry run: %d node(s) would be upgraded to %s\n", plan.PendingNodes(), upgradeFlags.Version)
+	case plan.PendingNodes() == 0 && !hasUpgradedNodes(plan):
+		pterm.Info.Printf("Cluster '%s' already runs %s\n", pterm.Cyan(clusterInfo.Name), upgradeFlags.Version)
+	default:
+		pterm.Success.Printf("Cluster '%s' upgraded to %s\n", pterm.Cyan(clusterInfo.Name), upgradeFlags.Version)
+	}
+	return nil
+}
+
+// hasUpgradedNodes reports whether any node was upgraded by this run
+func hasUpgradedNodes(plan *models.UpgradePlan) bool {
+	for _, node := range plan.Nodes {
+		if node.Status == models.NodeUpgradeUpgraded {
+			return true
+		}
+	}
+	return false
+}
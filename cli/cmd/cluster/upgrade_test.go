@@ -0,0 +1,54 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/flamingo/openframe/tests/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	testutil.InitializeTestMode()
+}
+
+func TestUpgradeCommand(t *testing.T) {
+	setupFunc := func() {
+		utils.SetTestExecutor(testutil.NewTestMockExecutor())
+	}
+	teardownFunc := func() {
+		utils.ResetGlobalFlags()
+	}
+
+	testutil.TestClusterCommand(t, "upgrade", getUpgradeCmd, setupFunc, teardownFunc)
+}
+
+func TestUpgradeCommand_RequiresVersion(t *testing.T) {
+	utils.SetTestExecutor(newLifecycleTestExecutor(runningK3dClusterList))
+	defer utils.ResetGlobalFlags()
+
+	cmd := getUpgradeCmd()
+	cmd.SetArgs([]string{"dev"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, "--version is required: pass a k3s release such as v1.32.2-k3s1")
+}
+
+func TestUpgradeCommand_StoppedCluster(t *testing.T) {
+	mockExec := newLifecycleTestExecutor(stoppedK3dClusterList)
+	utils.SetTestExecutor(mockExec)
+	defer utils.ResetGlobalFlags()
+
+	cmd := getUpgradeCmd()
+	cmd.SetArgs([]string{"dev", "--version", "v1.32.2-k3s1"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, "cluster dev is stopped; start it before upgrading")
+	assert.False(t, mockExec.WasCommandExecuted("docker stop"))
+}
@@ -0,0 +1,66 @@
+package doctor
+
+import (
+	"github.com/flamingo/openframe/internal/doctor"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/output"
+	"github.com/flamingo/openframe/internal/shared/ui"
+	"github.com/spf13/cobra"
+)
+
+// GetDoctorCmd returns the doctor command
+func GetDoctorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Diagnose problems with the local OpenFrame environment",
+		Long: `Diagnose Problems with the Local OpenFrame Environment
+
+Runs every check in one go and prints a single report:
+  • Prerequisites - the tools needed by the cluster, chart and dev commands, with versions
+  • Docker - daemon running, memory, CPUs, disk and kernel limits
+  • Certificates - mkcert CA trusted and the localhost certificate valid
+  • Cluster - current kube context reachable, ArgoCD applications healthy,
+    telepresence traffic-manager installed
+  • Ports - host ports that keep stopped clusters from starting
+
+Each problem comes with a remediation. The command exits non-zero when any
+check is critical, so it can gate scripts and CI jobs.
+
+Examples:
+  openframe doctor
+  openframe doctor -o json   # Machine-readable report`,
+		Args: cobra.NoArgs,
+		RunE: runDoctor,
+	}
+
+	return cmd
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	format, err := output.FormatFromCommand(cmd)
+	if err != nil {
+		return err
+	}
+
+	verbose, err := cmd.Root().PersistentFlags().GetBool("verbose")
+	if err != nil {
+		verbose = false
+	}
+	service := doctor.NewService(executor.NewRealCommandExecutor(false, verbose))
+
+	if format.IsStructured() {
+		stdout, restore := output.RedirectHumanOutput()
+		defer restore()
+
+		report := service.Run(cmd.Context())
+		if err := doctor.WriteReport(stdout, format, report); err != nil {
+			return output.PassthroughError(err)
+		}
+		return output.PassthroughError(report.Err())
+	}
+
+	ui.ShowLogo()
+	report := service.Run(cmd.Context())
+	doctor.ShowReport(report)
+	return report.Err()
+}
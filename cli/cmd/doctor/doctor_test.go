@@ -0,0 +1,29 @@
+package doctor
+
+import (
+	"testing"
+
+	"github.com/flamingo/openframe/tests/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	testutil.InitializeTestMode()
+}
+
+func TestDoctorCommandStructure(t *testing.T) {
+	cmd := GetDoctorCmd()
+
+	assert.Equal(t, "doctor", cmd.Use)
+	assert.NotEmpty(t, cmd.Short)
+	assert.Contains(t, cmd.Long, "openframe doctor -o json")
+	assert.Empty(t, cmd.Commands(), "Doctor command should have no subcommands")
+	assert.NotNil(t, cmd.RunE)
+}
+
+func TestDoctorCommandRejectsArguments(t *testing.T) {
+	cmd := GetDoctorCmd()
+
+	assert.NoError(t, cmd.Args(cmd, []string{}))
+	assert.Error(t, cmd.Args(cmd, []string{"extra"}))
+}
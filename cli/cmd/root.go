@@ -5,10 +5,14 @@ import (
 	"os"
 
 	"github.com/flamingo/openframe/cmd/bootstrap"
+	"github.com/flamingo/openframe/cmd/bundle"
 	"github.com/flamingo/openframe/cmd/chart"
 	"github.com/flamingo/openframe/cmd/cluster"
 	"github.com/flamingo/openframe/cmd/dev"
+	"github.com/flamingo/openframe/cmd/doctor"
 	"github.com/flamingo/openframe/internal/shared/config"
+	"github.com/flamingo/openframe/internal/shared/localbin"
+	"github.com/flamingo/openframe/internal/shared/output"
 	"github.com/flamingo/openframe/internal/shared/ui"
 	"github.com/spf13/cobra"
 )
@@ -49,6 +53,8 @@ Key Features:
   - Helm Integration - App-of-Apps pattern with ArgoCD
   - Developer Tools - Telepresence intercepts and scaffold deployments
   - Prerequisite Checking - Validates tools before running
+  - Diagnostics - One report of everything that can go wrong (openframe doctor)
+  - Offline Installs - Bundle everything bootstrap downloads (openframe bundle create)
 
 The CLI provides both interactive modes for new users and flag-based
 operation for automation and power users.`,
@@ -68,10 +74,14 @@ operation for automation and power users.`,
 	rootCmd.AddCommand(getChartCmd())
 	rootCmd.AddCommand(getBootstrapCmd())
 	rootCmd.AddCommand(getDevCmd())
+	rootCmd.AddCommand(getDoctorCmd())
+	rootCmd.AddCommand(getBundleCmd())
 
 	// Add global flags following cluster pattern
 	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "Enable verbose output")
 	rootCmd.PersistentFlags().Bool("silent", false, "Suppress all output except errors")
+	output.AddOutputFlag(rootCmd)
+	localbin.AddFlag(rootCmd)
 
 	// Version template
 	rootCmd.SetVersionTemplate(`{{printf "%s\n" .Version}}`)
@@ -119,6 +129,11 @@ func ExecuteWithVersion(versionInfo VersionInfo) error {
 	if err := service.Initialize(); err != nil {
 		fmt.Fprintf(os.Stderr, "Warning: initialization failed: %v\n", err)
 	}
+
+	// Tools installed with --local-tools take precedence over the ones on the host
+	if err := localbin.ActivatePath(); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to add the local tools directory to PATH: %v\n", err)
+	}
 	
 	return rootCmd.Execute()
 }
@@ -143,4 +158,12 @@ func getDevCmd() *cobra.Command {
 	return dev.GetDevCmd()
 }
 
+// getDoctorCmd returns the doctor command
+func getDoctorCmd() *cobra.Command {
+	return doctor.GetDoctorCmd()
+}
 
+// getBundleCmd returns the bundle command
+func getBundleCmd() *cobra.Command {
+	return bundle.GetBundleCmd()
+}
@@ -67,6 +67,14 @@ func TestGetRootCmd(t *testing.T) {
 	if cmd.Version != expectedVersion {
 		t.Errorf("expected version %q, got %q", expectedVersion, cmd.Version)
 	}
+
+	if cmd.PersistentFlags().Lookup("output") == nil {
+		t.Error("root command should define the persistent --output flag")
+	}
+
+	if cmd.PersistentFlags().Lookup("local-tools") == nil {
+		t.Error("root command should define the persistent --local-tools flag")
+	}
 }
 
 func TestSystemService(t *testing.T) {
@@ -1,13 +1,20 @@
 package bootstrap
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/flamingo/openframe/internal/bundle"
 	chartServices "github.com/flamingo/openframe/internal/chart/services"
+	chartTypes "github.com/flamingo/openframe/internal/chart/utils/types"
 	"github.com/flamingo/openframe/internal/cluster"
 	"github.com/flamingo/openframe/internal/cluster/models"
 	sharedErrors "github.com/flamingo/openframe/internal/shared/errors"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/state"
+	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
 )
 
@@ -27,13 +34,29 @@ func (s *Service) Execute(cmd *cobra.Command, args []string) error {
 		verbose = false
 	}
 
+	// Preflight failures stop the bootstrap unless explicitly skipped
+	skipPreflight, err := cmd.Flags().GetBool("skip-preflight")
+	if err != nil {
+		skipPreflight = false
+	}
+
+	// An offline bundle replaces every download of the bootstrap
+	bundlePath, err := cmd.Flags().GetString("bundle")
+	if err != nil {
+		bundlePath = ""
+	}
+
 	// Get cluster name from args if provided
 	var clusterName string
 	if len(args) > 0 {
 		clusterName = strings.TrimSpace(args[0])
 	}
 
-	err = s.bootstrap(clusterName, verbose)
+	if bundlePath != "" {
+		err = s.bootstrapFromBundle(clusterName, bundlePath, verbose, skipPreflight)
+	} else {
+		err = s.bootstrap(clusterName, verbose, skipPreflight)
+	}
 	if err != nil {
 		// Use shared error handler for consistent error display (same as chart install)
 		return sharedErrors.HandleGlobalError(err, verbose)
@@ -42,13 +65,13 @@ func (s *Service) Execute(cmd *cobra.Command, args []string) error {
 }
 
 // bootstrap executes cluster create followed by chart install
-func (s *Service) bootstrap(clusterName string, verbose bool) error {
+func (s *Service) bootstrap(clusterName string, verbose bool, skipPreflight bool) error {
 	// Normalize cluster name (use default if empty)
 	config := s.buildClusterConfig(clusterName)
 	actualClusterName := config.Name
 
 	// Step 1: Create cluster with suppressed UI
-	if err := s.createClusterSuppressed(actualClusterName, verbose); err != nil {
+	if err := s.createClusterSuppressed(actualClusterName, verbose, skipPreflight); err != nil {
 		return fmt.Errorf("failed to create cluster: %w", err)
 	}
 
@@ -61,13 +84,92 @@ func (s *Service) bootstrap(clusterName string, verbose bool) error {
 		return fmt.Errorf("failed to install charts: %w", err)
 	}
 
+	// Step 3: Remember that the cluster was fully bootstrapped
+	s.recordBootstrap(actualClusterName)
+
+	return nil
+}
+
+// bootstrapFromBundle creates the cluster and installs the charts from an offline bundle
+// The bundled tools are installed first, the node images are loaded before the cluster is created,
+// and ArgoCD syncs the applications from a git server inside the cluster.
+func (s *Service) bootstrapFromBundle(clusterName, bundlePath string, verbose bool, skipPreflight bool) error {
+	ctx := context.Background()
+	config := s.buildClusterConfig(clusterName)
+
+	unpacked, err := bundle.Unpack(bundlePath)
+	if err != nil {
+		return err
+	}
+	pterm.Info.Printf("Installing from bundle %s (%s at %s)\n",
+		bundlePath, unpacked.Manifest.Repository.Revision, unpacked.Manifest.Repository.Commit)
+
+	installer := bundle.NewInstaller(executor.NewRealCommandExecutor(false, verbose))
+	if err := installer.InstallTools(unpacked); err != nil {
+		return err
+	}
+	if err := installer.LoadHostImages(ctx, unpacked); err != nil {
+		return err
+	}
+
+	// Step 1: Create the cluster with the bundle mounted into its nodes
+	config.Spec = &models.ClusterSpec{
+		Spec: models.ClusterSpecBody{
+			Agents:  config.NodeCount,
+			Volumes: []models.VolumeSpec{unpacked.ClusterVolume()},
+		},
+	}
+	if err := cluster.CreateClusterFromConfigWithPrerequisites(config, verbose, skipPreflight); err != nil {
+		return fmt.Errorf("failed to create cluster: %w", err)
+	}
+
+	fmt.Println()
+	fmt.Println()
+
+	// Step 2: Make the images and the repository available inside the cluster
+	if err := installer.ImportClusterImages(ctx, unpacked, config.Name); err != nil {
+		return err
+	}
+	if err := installer.DeployGitServer(ctx, unpacked, config.Name); err != nil {
+		return err
+	}
+
+	// Step 3: Install charts from the bundle
+	source := unpacked.InstallationSource()
+	if err := chartServices.InstallChartsWithConfig(chartTypes.InstallationRequest{
+		Args:         []string{config.Name},
+		Verbose:      verbose,
+		GitHubRepo:   source.Repository,
+		GitHubBranch: source.Revision,
+		Offline:      source,
+	}); err != nil {
+		return fmt.Errorf("failed to install charts: %w", err)
+	}
+
+	// Step 4: Remember that the cluster was fully bootstrapped
+	s.recordBootstrap(config.Name)
+
 	return nil
 }
 
+// recordBootstrap marks the cluster as bootstrapped in the local state store
+func (s *Service) recordBootstrap(clusterName string) {
+	store, err := state.NewDefaultStore()
+	if err == nil {
+		err = store.Update(clusterName, func(record *state.ClusterRecord) {
+			now := time.Now().UTC()
+			record.BootstrappedAt = &now
+		})
+	}
+	if err != nil {
+		pterm.Warning.Printf("Failed to save bootstrap state of cluster %s: %v\n", clusterName, err)
+	}
+}
+
 // createClusterSuppressed creates a cluster with suppressed UI elements
-func (s *Service) createClusterSuppressed(clusterName string, verbose bool) error {
-	// Use the wrapper function that includes prerequisite checks
-	return cluster.CreateClusterWithPrerequisites(clusterName, verbose)
+func (s *Service) createClusterSuppressed(clusterName string, verbose bool, skipPreflight bool) error {
+	// Use the wrapper function that includes prerequisite and preflight checks
+	return cluster.CreateClusterWithPrerequisites(clusterName, verbose, skipPreflight)
 }
 
 // buildClusterConfig builds a cluster configuration from the cluster name
@@ -5,9 +5,11 @@ import (
 
 	chartCmd "github.com/flamingo/openframe/cmd/chart"
 	clusterCmd "github.com/flamingo/openframe/cmd/cluster"
+	"github.com/flamingo/openframe/internal/shared/state"
 	"github.com/flamingo/openframe/tests/testutil"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func init() {
@@ -140,6 +142,21 @@ func TestServiceVerboseFlagHandling(t *testing.T) {
 	}
 }
 
+func TestServiceRecordBootstrap(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	store, err := state.NewDefaultStore()
+	require.NoError(t, err)
+	require.NoError(t, store.Save(&state.ClusterRecord{Name: "dev", Type: "k3d"}))
+
+	NewService().recordBootstrap("dev")
+
+	record, err := store.Load("dev")
+	require.NoError(t, err)
+	assert.Equal(t, "k3d", record.Type)
+	require.NotNil(t, record.BootstrappedAt)
+	assert.False(t, record.BootstrappedAt.IsZero())
+}
+
 // Note: Full execution testing is intentionally avoided to prevent integration
 // testing. The service coordinates existing cluster and chart commands, so
 // testing focuses on structure and method availability rather than end-to-end
@@ -0,0 +1,451 @@
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/flamingo/openframe/internal/chart/prerequisites/certificates"
+	"github.com/flamingo/openframe/internal/chart/prerequisites/helm"
+	"github.com/flamingo/openframe/internal/chart/providers/argocd"
+	helmProvider "github.com/flamingo/openframe/internal/chart/providers/helm"
+	"github.com/flamingo/openframe/internal/cluster/prerequisites/k3d"
+	"github.com/flamingo/openframe/internal/cluster/prerequisites/kubectl"
+	k3dProvider "github.com/flamingo/openframe/internal/cluster/providers/k3d"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/localbin"
+	"github.com/pterm/pterm"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// DefaultRepository is the repository with the app-of-apps and application manifests
+	DefaultRepository = "https://github.com/flamingo-stack/openframe-oss-tenant"
+	// DefaultRevision is the revision of DefaultRepository that is bundled when none is given
+	DefaultRevision = "main"
+	// Branch is the branch of the bundled repository that ArgoCD syncs from
+	Branch = "openframe-offline"
+	// GitServerImage runs the in-cluster git server for the bundled repository
+	GitServerImage = "alpine/git:v2.45.2"
+
+	repositoryPath       = "repository.git"
+	chartsDir            = "charts"
+	binDir               = "bin"
+	hostImagesArchive    = "images/host.tar"
+	clusterImagesArchive = "images/cluster.tar"
+)
+
+// CreateOptions controls what goes into an offline bundle
+type CreateOptions struct {
+	Output      string   // Archive path, defaults to openframe-bundle-<revision>.tar
+	Repository  string   // Repository with the app-of-apps and application manifests
+	Revision    string   // Branch or tag of the repository
+	Platform    string   // os/arch of the machine the bundle is installed on, defaults to this machine
+	ExtraImages []string // Images to bundle that are not found in the rendered charts
+	SkipImages  bool     // Leave images out, for clusters that can still pull from a registry
+	SkipTools   bool     // Leave the tool binaries out
+}
+
+// Creator collects charts, manifests, images and tools into an offline bundle
+type Creator struct {
+	executor executor.CommandExecutor
+	// downloadTools installs the tool binaries into a directory; replaced in tests
+	downloadTools func(dir, goos, goarch string) ([]Tool, error)
+}
+
+// NewCreator creates a bundle creator
+func NewCreator(exec executor.CommandExecutor) *Creator {
+	return &Creator{
+		executor:      exec,
+		downloadTools: downloadTools,
+	}
+}
+
+// Create writes an offline bundle and returns its path and manifest
+// Everything is downloaded while creating the bundle, so installing from it needs no network.
+func (c *Creator) Create(ctx context.Context, opts CreateOptions) (string, *Manifest, error) {
+	if opts.Repository == "" {
+		opts.Repository = DefaultRepository
+	}
+	if opts.Revision == "" {
+		opts.Revision = DefaultRevision
+	}
+	if opts.Platform == "" {
+		opts.Platform = runtime.GOOS + "/" + runtime.GOARCH
+	}
+	if opts.Output == "" {
+		opts.Output = fmt.Sprintf("openframe-bundle-%s.tar", strings.ReplaceAll(opts.Revision, "/", "-"))
+	}
+	goos, goarch, err := parsePlatform(opts.Platform)
+	if err != nil {
+		return "", nil, err
+	}
+	if _, err := os.Stat(opts.Output); err == nil {
+		return "", nil, fmt.Errorf("bundle %s already exists", opts.Output)
+	}
+
+	staging, err := os.MkdirTemp("", "openframe-bundle-")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create staging directory: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	manifest := &Manifest{
+		APIVersion: APIVersion,
+		Kind:       Kind,
+		CreatedAt:  time.Now().UTC(),
+		Platform:   opts.Platform,
+	}
+
+	if err := c.pullArgoCDChart(ctx, staging, manifest); err != nil {
+		return "", nil, err
+	}
+
+	repositoryImages, err := c.bundleRepository(ctx, opts, staging, manifest)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if !opts.SkipImages {
+		argoCDImages, err := c.renderImages(ctx, filepath.Join(staging, filepath.FromSlash(manifest.ArgoCD.Archive)), true)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to render the ArgoCD chart: %w", err)
+		}
+		clusterImages := append(append(argoCDImages, repositoryImages...), opts.ExtraImages...)
+		clusterImages = append(clusterImages, GitServerImage)
+		if err := c.saveImages(ctx, staging, goarch, uniqueImages(clusterImages), manifest); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if !opts.SkipTools {
+		tools, err := c.downloadTools(filepath.Join(staging, binDir), goos, goarch)
+		if err != nil {
+			return "", nil, err
+		}
+		manifest.Tools = tools
+	}
+
+	manifestData, err := manifest.ToYAML()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encode bundle manifest: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(staging, ManifestFile), manifestData, 0644); err != nil {
+		return "", nil, fmt.Errorf("failed to write bundle manifest: %w", err)
+	}
+
+	if err := writeTar(staging, opts.Output); err != nil {
+		os.Remove(opts.Output)
+		return "", nil, fmt.Errorf("failed to write bundle archive: %w", err)
+	}
+	return opts.Output, manifest, nil
+}
+
+// parsePlatform splits an os/arch platform into the operating systems and architectures
+// openframe installs tools for
+func parsePlatform(platform string) (string, string, error) {
+	goos, goarch, ok := strings.Cut(platform, "/")
+	if !ok || (goos != "linux" && goos != "darwin") || (goarch != "amd64" && goarch != "arm64") {
+		return "", "", fmt.Errorf("unsupported platform %q, use linux/amd64, linux/arm64, darwin/amd64 or darwin/arm64", platform)
+	}
+	return goos, goarch, nil
+}
+
+// pullArgoCDChart downloads the pinned ArgoCD chart archive
+func (c *Creator) pullArgoCDChart(ctx context.Context, staging string, manifest *Manifest) error {
+	spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Downloading the ArgoCD chart %s...", helmProvider.ArgoCDChartVersion))
+
+	if _, err := c.executor.Execute(ctx, "helm", "repo", "add", "argo", helmProvider.ArgoCDRepositoryURL); err != nil && !strings.Contains(err.Error(), "already exists") {
+		spinner.Fail("Failed to download the ArgoCD chart")
+		return fmt.Errorf("failed to add ArgoCD repository: %w", err)
+	}
+	if _, err := c.executor.Execute(ctx, "helm", "repo", "update", "argo"); err != nil {
+		spinner.Fail("Failed to download the ArgoCD chart")
+		return fmt.Errorf("failed to update the ArgoCD repository: %w", err)
+	}
+
+	destination := filepath.Join(staging, chartsDir)
+	if err := os.MkdirAll(destination, 0755); err != nil {
+		spinner.Fail("Failed to download the ArgoCD chart")
+		return fmt.Errorf("failed to create staging directory: %w", err)
+	}
+	if _, err := c.executor.Execute(ctx, "helm", "pull", "argo/argo-cd",
+		"--version", helmProvider.ArgoCDChartVersion, "--destination", destination); err != nil {
+		spinner.Fail("Failed to download the ArgoCD chart")
+		return fmt.Errorf("failed to download the ArgoCD chart: %w", err)
+	}
+
+	manifest.ArgoCD = Chart{
+		Name:    "argo-cd",
+		Version: helmProvider.ArgoCDChartVersion,
+		Archive: chartsDir + "/argo-cd-" + helmProvider.ArgoCDChartVersion + ".tgz",
+	}
+	spinner.Success(fmt.Sprintf("Downloaded the ArgoCD chart %s", helmProvider.ArgoCDChartVersion))
+	return nil
+}
+
+// bundleRepository clones the manifests at the requested revision, vendors the chart dependencies
+// ArgoCD would otherwise download, and stores the result as a bare repository with a single branch
+// It returns the images referenced by the charts of the repository.
+func (c *Creator) bundleRepository(ctx context.Context, opts CreateOptions, staging string, manifest *Manifest) ([]string, error) {
+	work, err := os.MkdirTemp("", "openframe-bundle-repository-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(work)
+
+	spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Cloning %s (%s)...", opts.Repository, opts.Revision))
+	if _, err := c.executor.Execute(ctx, "git", "clone", "--depth", "1", "--single-branch", "--no-tags",
+		"--branch", opts.Revision, opts.Repository, work); err != nil {
+		spinner.Fail("Failed to clone the repository")
+		return nil, fmt.Errorf("failed to clone %s at %s: %w", opts.Repository, opts.Revision, err)
+	}
+	result, err := c.executor.Execute(ctx, "git", "-C", work, "rev-parse", "HEAD")
+	if err != nil {
+		spinner.Fail("Failed to clone the repository")
+		return nil, fmt.Errorf("failed to resolve %s: %w", opts.Revision, err)
+	}
+	manifest.Repository = Repository{
+		URL:      opts.Repository,
+		Revision: opts.Revision,
+		Commit:   strings.TrimSpace(result.Stdout),
+		Branch:   Branch,
+		Path:     repositoryPath,
+	}
+	spinner.Success(fmt.Sprintf("Cloned %s at %s", opts.Revision, shortCommit(manifest.Repository.Commit)))
+
+	charts, err := findCharts(work)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find charts in the repository: %w", err)
+	}
+
+	spinner, _ = pterm.DefaultSpinner.Start(fmt.Sprintf("Vendoring the dependencies of %d charts...", len(charts)))
+	var images []string
+	for _, chart := range charts {
+		if chart.remoteDependencies {
+			if _, err := c.executor.Execute(ctx, "helm", "dependency", "build", chart.dir); err != nil {
+				spinner.Fail(fmt.Sprintf("Failed to vendor the dependencies of %s", chart.relative))
+				return nil, fmt.Errorf("failed to download the dependencies of %s: %w", chart.relative, err)
+			}
+		}
+		if opts.SkipImages {
+			continue
+		}
+		chartImages, err := c.renderImages(ctx, chart.dir, false)
+		if err != nil {
+			// Charts that need values from the app-of-apps do not render on their own; --image covers their images
+			pterm.Debug.Printf("Could not render %s: %v\n", chart.relative, err)
+			continue
+		}
+		images = append(images, chartImages...)
+	}
+	spinner.Success(fmt.Sprintf("Vendored the dependencies of %d charts", len(charts)))
+
+	// The bundled branch holds the source revision plus the vendored chart archives
+	commitArgs := [][]string{
+		{"-C", work, "checkout", "-B", Branch},
+		{"-C", work, "add", "--force", "--all"},
+		{"-C", work, "-c", "user.name=openframe", "-c", "user.email=openframe@localhost",
+			"commit", "--quiet", "--allow-empty", "-m", "Vendor chart dependencies for offline installation"},
+		{"clone", "--bare", "--single-branch", "--branch", Branch, "file://" + work, filepath.Join(staging, repositoryPath)},
+	}
+	for _, args := range commitArgs {
+		if _, err := c.executor.Execute(ctx, "git", args...); err != nil {
+			return nil, fmt.Errorf("failed to create the bundled repository: %w", err)
+		}
+	}
+
+	return images, nil
+}
+
+// bundledChart is a Helm chart found in the bundled repository
+type bundledChart struct {
+	dir                string
+	relative           string
+	remoteDependencies bool // Dependencies that are downloaded from a chart repository
+}
+
+// findCharts returns the Helm charts of a repository, leaving out subcharts in charts/ directories
+func findCharts(root string) ([]bundledChart, error) {
+	var charts []bundledChart
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+		if entry.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		if entry.Name() == "charts" {
+			if _, err := os.Stat(filepath.Join(filepath.Dir(path), "Chart.yaml")); err == nil {
+				return filepath.SkipDir
+			}
+		}
+
+		data, err := os.ReadFile(filepath.Join(path, "Chart.yaml"))
+		if err != nil {
+			return nil
+		}
+		relative, _ := filepath.Rel(root, path)
+		charts = append(charts, bundledChart{
+			dir:                path,
+			relative:           filepath.ToSlash(relative),
+			remoteDependencies: hasRemoteDependencies(data),
+		})
+		return nil
+	})
+	return charts, err
+}
+
+// hasRemoteDependencies reports whether a Chart.yaml declares dependencies from chart repositories
+func hasRemoteDependencies(chartYAML []byte) bool {
+	var chart struct {
+		Dependencies []struct {
+			Repository string `yaml:"repository"`
+		} `yaml:"dependencies"`
+	}
+	if err := yaml.Unmarshal(chartYAML, &chart); err != nil {
+		return false
+	}
+	for _, dependency := range chart.Dependencies {
+		if dependency.Repository != "" && !strings.HasPrefix(dependency.Repository, "file://") {
+			return true
+		}
+	}
+	return false
+}
+
+// renderImages renders a chart with helm template and returns the images it references
+// The ArgoCD chart is rendered with the values openframe installs it with.
+func (c *Creator) renderImages(ctx context.Context, chart string, argoCDValues bool) ([]string, error) {
+	args := []string{"template", "openframe", chart}
+	if argoCDValues {
+		valuesFile, err := os.CreateTemp("", "argocd-values-*.yaml")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create temporary values file: %w", err)
+		}
+		defer os.Remove(valuesFile.Name())
+		if _, err := valuesFile.WriteString(argocd.GetArgoCDValues()); err != nil {
+			valuesFile.Close()
+			return nil, fmt.Errorf("failed to write temporary values file: %w", err)
+		}
+		valuesFile.Close()
+		args = append(args, "-f", valuesFile.Name())
+	}
+
+	result, err := c.executor.Execute(ctx, "helm", args...)
+	if err != nil {
+		return nil, err
+	}
+	return imagesInManifests(result.Stdout), nil
+}
+
+// hostImages returns the images k3d needs on the host to create a cluster
+func hostImages() []string {
+	k3dVersion := k3d.NewK3dInstaller().Tool().Pinned
+	return []string{
+		k3dProvider.DefaultK3sImage,
+		"ghcr.io/k3d-io/k3d-proxy:" + k3dVersion,
+		"ghcr.io/k3d-io/k3d-tools:" + k3dVersion,
+	}
+}
+
+// saveImages pulls the host and cluster images for the target architecture and saves them into two archives
+// Cluster images that cannot be pulled are skipped with a warning, since charts rendered with default
+// values may reference images that are never deployed.
+func (c *Creator) saveImages(ctx context.Context, staging, goarch string, clusterImages []string, manifest *Manifest) error {
+	if err := os.MkdirAll(filepath.Join(staging, "images"), 0755); err != nil {
+		return fmt.Errorf("failed to create staging directory: %w", err)
+	}
+	platform := "linux/" + goarch
+
+	host := hostImages()
+	spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Pulling %d cluster node images...", len(host)))
+	for _, image := range host {
+		if _, err := c.executor.Execute(ctx, "docker", "pull", "--platform", platform, image); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to pull %s", image))
+			return fmt.Errorf("failed to pull %s: %w", image, err)
+		}
+	}
+	spinner.Success(fmt.Sprintf("Pulled %d cluster node images", len(host)))
+
+	var pulled []string
+	spinner, _ = pterm.DefaultSpinner.Start(fmt.Sprintf("Pulling %d application images...", len(clusterImages)))
+	for _, image := range clusterImages {
+		if _, err := c.executor.Execute(ctx, "docker", "pull", "--platform", platform, image); err != nil {
+			pterm.Warning.Printf("Skipping image %s: %v\n", image, err)
+			continue
+		}
+		pulled = append(pulled, image)
+	}
+	spinner.Success(fmt.Sprintf("Pulled %d of %d application images", len(pulled), len(clusterImages)))
+
+	spinner, _ = pterm.DefaultSpinner.Start("Saving images...")
+	archives := []struct {
+		path   string
+		images []string
+	}{
+		{hostImagesArchive, host},
+		{clusterImagesArchive, pulled},
+	}
+	for _, archive := range archives {
+		if len(archive.images) == 0 {
+			continue
+		}
+		args := append([]string{"save", "--output", filepath.Join(staging, filepath.FromSlash(archive.path))}, archive.images...)
+		if _, err := c.executor.Execute(ctx, "docker", args...); err != nil {
+			spinner.Fail("Failed to save images")
+			return fmt.Errorf("failed to save images: %w", err)
+		}
+	}
+	spinner.Success(fmt.Sprintf("Saved %d images", len(host)+len(pulled)))
+
+	manifest.Images = Images{Host: host, HostArchive: hostImagesArchive}
+	if len(pulled) > 0 {
+		manifest.Images.Cluster = pulled
+		manifest.Images.ClusterArchive = clusterImagesArchive
+	}
+	return nil
+}
+
+// bundledBinaries are the tool binaries bootstrap needs from a bundle
+func bundledBinaries() []localbin.Binary {
+	return []localbin.Binary{
+		k3d.NewK3dInstaller().LocalBinary(),
+		kubectl.NewKubectlInstaller().LocalBinary(),
+		helm.NewHelmInstaller().LocalBinary(),
+		certificates.NewCertificateInstaller().LocalBinary(),
+	}
+}
+
+// downloadTools installs the checksum-verified tool binaries bootstrap needs into a directory
+func downloadTools(dir, goos, goarch string) ([]Tool, error) {
+	binaries := bundledBinaries()
+	installer := localbin.NewInstallerFor(dir, goos, goarch)
+	spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Downloading %d tools for %s/%s...", len(binaries), goos, goarch))
+	var tools []Tool
+	for _, binary := range binaries {
+		if _, err := installer.Install(binary); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to download %s", binary.Name))
+			return nil, err
+		}
+		tools = append(tools, Tool{Name: binary.Name, Version: binary.Version, Path: binDir + "/" + binary.Name})
+	}
+	spinner.Success(fmt.Sprintf("Downloaded %d tools for %s/%s", len(binaries), goos, goarch))
+	return tools, nil
+}
+
+// shortCommit abbreviates a commit hash for messages
+func shortCommit(commit string) string {
+	if len(commit) > 12 {
+		return commit[:12]
+	}
+	return commit
+}
@@ -0,0 +1,172 @@
+package bundle
+
+import (
+	"archive/tar"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestCreator returns a creator with a mock executor and tool downloads that write stub binaries
+func newTestCreator(t *testing.T) (*Creator, *executor.MockCommandExecutor) {
+	mockExec := executor.NewMockCommandExecutor()
+	mockExec.SetResponse("rev-parse HEAD", &executor.CommandResult{Stdout: "0123456789abcdef0123456789abcdef01234567\n"})
+	mockExec.SetResponse("argo-cd-8.1.4.tgz -f", &executor.CommandResult{Stdout: "        image: quay.io/argoproj/argocd:v3.0.6\n"})
+
+	creator := NewCreator(mockExec)
+	creator.downloadTools = func(dir, goos, goarch string) ([]Tool, error) {
+		require.NoError(t, os.MkdirAll(dir, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "k3d"), []byte("#!/bin/sh\n"), 0755))
+		return []Tool{{Name: "k3d", Version: "5.7.4", Path: "bin/k3d"}}, nil
+	}
+	return creator, mockExec
+}
+
+// tarEntries lists the names in a tar archive
+func tarEntries(t *testing.T, path string) []string {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var names []string
+	reader := tar.NewReader(file)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return names
+		}
+		require.NoError(t, err)
+		names = append(names, header.Name)
+	}
+}
+
+func TestCreator_Create(t *testing.T) {
+	creator, mockExec := newTestCreator(t)
+	mockExec.SetResponse("docker pull --platform linux/arm64 example.com/missing:1.0", &executor.CommandResult{ExitCode: 1})
+	output := filepath.Join(t.TempDir(), "bundle.tar")
+
+	path, manifest, err := creator.Create(context.Background(), CreateOptions{
+		Output:      output,
+		Revision:    "release/1.2",
+		Platform:    "linux/arm64",
+		ExtraImages: []string{"example.com/missing:1.0"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, output, path)
+
+	assert.Equal(t, "linux/arm64", manifest.Platform)
+	assert.Equal(t, Repository{
+		URL:      DefaultRepository,
+		Revision: "release/1.2",
+		Commit:   "0123456789abcdef0123456789abcdef01234567",
+		Branch:   Branch,
+		Path:     repositoryPath,
+	}, manifest.Repository)
+	assert.Equal(t, "charts/argo-cd-8.1.4.tgz", manifest.ArgoCD.Archive)
+	assert.Equal(t, hostImages(), manifest.Images.Host)
+	assert.Equal(t, []string{GitServerImage, "quay.io/argoproj/argocd:v3.0.6"}, manifest.Images.Cluster,
+		"images that cannot be pulled are left out")
+	assert.Len(t, manifest.Tools, 1)
+
+	assert.True(t, mockExec.WasCommandExecuted("helm pull argo/argo-cd --version 8.1.4 --destination"))
+	assert.True(t, mockExec.WasCommandExecuted("git clone --depth 1 --single-branch --no-tags --branch release/1.2 "+DefaultRepository))
+	assert.True(t, mockExec.WasCommandExecuted("checkout -B openframe-offline"))
+	assert.True(t, mockExec.WasCommandExecuted("git clone --bare --single-branch --branch openframe-offline file://"))
+	assert.True(t, mockExec.WasCommandExecuted("docker pull --platform linux/arm64 rancher/k3s:"))
+	assert.True(t, mockExec.WasCommandExecuted("docker save --output"))
+
+	entries := tarEntries(t, output)
+	assert.Contains(t, entries, ManifestFile)
+	assert.Contains(t, entries, "bin/k3d")
+}
+
+func TestCreator_CreateWithoutImagesAndTools(t *testing.T) {
+	creator, mockExec := newTestCreator(t)
+	output := filepath.Join(t.TempDir(), "bundle.tar")
+
+	_, manifest, err := creator.Create(context.Background(), CreateOptions{Output: output, SkipImages: true, SkipTools: true})
+	require.NoError(t, err)
+
+	assert.Empty(t, manifest.Images.Host)
+	assert.Empty(t, manifest.Tools)
+	assert.False(t, mockExec.WasCommandExecuted("docker"))
+	assert.False(t, mockExec.WasCommandExecuted("helm template"))
+}
+
+func TestCreator_CreateErrors(t *testing.T) {
+	t.Run("unsupported platform", func(t *testing.T) {
+		creator, _ := newTestCreator(t)
+		_, _, err := creator.Create(context.Background(), CreateOptions{Output: filepath.Join(t.TempDir(), "b.tar"), Platform: "windows/amd64"})
+		assert.ErrorContains(t, err, `unsupported platform "windows/amd64"`)
+	})
+
+	t.Run("existing archive", func(t *testing.T) {
+		creator, _ := newTestCreator(t)
+		output := filepath.Join(t.TempDir(), "b.tar")
+		require.NoError(t, os.WriteFile(output, nil, 0644))
+
+		_, _, err := creator.Create(context.Background(), CreateOptions{Output: output})
+		assert.ErrorContains(t, err, "already exists")
+	})
+
+	t.Run("clone failure", func(t *testing.T) {
+		creator, mockExec := newTestCreator(t)
+		mockExec.SetResponse("git clone --depth 1", &executor.CommandResult{ExitCode: 128})
+		output := filepath.Join(t.TempDir(), "b.tar")
+
+		_, _, err := creator.Create(context.Background(), CreateOptions{Output: output, Revision: "missing"})
+		assert.ErrorContains(t, err, "failed to clone "+DefaultRepository+" at missing")
+		_, statErr := os.Stat(output)
+		assert.True(t, os.IsNotExist(statErr))
+	})
+
+	t.Run("node image pull failure", func(t *testing.T) {
+		creator, mockExec := newTestCreator(t)
+		mockExec.SetResponse("docker pull --platform linux/amd64 rancher/k3s", &executor.CommandResult{ExitCode: 1})
+
+		_, _, err := creator.Create(context.Background(), CreateOptions{Output: filepath.Join(t.TempDir(), "b.tar"), Platform: "linux/amd64"})
+		assert.ErrorContains(t, err, "failed to pull rancher/k3s")
+	})
+}
+
+func TestBundledBinaries(t *testing.T) {
+	// A bundle can target any supported platform, so every binary needs a checksum for each of them
+	binaries := bundledBinaries()
+	require.NotEmpty(t, binaries)
+	for _, binary := range binaries {
+		assert.NoError(t, binary.Validate(), binary.Name)
+	}
+}
+
+func TestFindCharts(t *testing.T) {
+	root := t.TempDir()
+	write := func(path, content string) {
+		full := filepath.Join(root, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(full), 0755))
+		require.NoError(t, os.WriteFile(full, []byte(content), 0644))
+	}
+	write("manifests/app-of-apps/Chart.yaml", "name: app-of-apps\n")
+	write("manifests/platform/ingress-nginx/Chart.yaml", "name: ingress-nginx\ndependencies:\n  - name: ingress-nginx\n    repository: https://kubernetes.github.io/ingress-nginx\n")
+	write("manifests/platform/ingress-nginx/charts/ingress-nginx/Chart.yaml", "name: vendored\n")
+	write("manifests/microservices/api/Chart.yaml", "name: api\ndependencies:\n  - name: common\n    repository: file://../common\n")
+	write(".git/Chart.yaml", "name: ignored\n")
+
+	charts, err := findCharts(root)
+	require.NoError(t, err)
+
+	found := make(map[string]bool)
+	for _, chart := range charts {
+		found[chart.relative] = chart.remoteDependencies
+	}
+	assert.Equal(t, map[string]bool{
+		"manifests/app-of-apps":            false,
+		"manifests/microservices/api":      false,
+		"manifests/platform/ingress-nginx": true,
+	}, found)
+}
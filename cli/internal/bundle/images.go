@@ -0,0 +1,42 @@
+package bundle
+
+import (
+	"bufio"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// imagePattern matches the image of a container in rendered Kubernetes manifests
+var imagePattern = regexp.MustCompile(`^\s*(?:-\s+)?image:\s*["']?([^"'\s]+)["']?\s*$`)
+
+// imagesInManifests returns the container images referenced by rendered Kubernetes manifests
+func imagesInManifests(rendered string) []string {
+	var images []string
+	scanner := bufio.NewScanner(strings.NewReader(rendered))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		match := imagePattern.FindStringSubmatch(scanner.Text())
+		if match == nil || strings.Contains(match[1], "{{") {
+			continue
+		}
+		images = append(images, match[1])
+	}
+	return images
+}
+
+// uniqueImages sorts images and drops duplicates and empty names
+func uniqueImages(images []string) []string {
+	seen := make(map[string]bool)
+	var unique []string
+	for _, image := range images {
+		image = strings.TrimSpace(image)
+		if image == "" || seen[image] {
+			continue
+		}
+		seen[image] = true
+		unique = append(unique, image)
+	}
+	sort.Strings(unique)
+	return unique
+}
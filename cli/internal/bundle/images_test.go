@@ -0,0 +1,42 @@
+package bundle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImagesInManifests(t *testing.T) {
+	rendered := `---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      initContainers:
+        - image: "busybox:1.36"
+          name: init
+      containers:
+        - name: server
+          image: quay.io/argoproj/argocd:v3.0.6
+        - name: redis
+          image: 'ecr-public.aws.com/docker/library/redis:7.2.8-alpine'
+          imagePullPolicy: IfNotPresent
+---
+# image: commented/out:1.0
+kind: ConfigMap
+data:
+  image: "{{ .Values.image }}"
+`
+
+	assert.Equal(t, []string{
+		"busybox:1.36",
+		"quay.io/argoproj/argocd:v3.0.6",
+		"ecr-public.aws.com/docker/library/redis:7.2.8-alpine",
+	}, imagesInManifests(rendered))
+}
+
+func TestUniqueImages(t *testing.T) {
+	assert.Equal(t, []string{"a:1", "b:2"}, uniqueImages([]string{"b:2", "a:1", "", " b:2", "a:1"}))
+	assert.Nil(t, uniqueImages(nil))
+}
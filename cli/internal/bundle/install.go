@@ -0,0 +1,296 @@
+package bundle
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/flamingo/openframe/internal/chart/utils/types"
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/localbin"
+	"github.com/pterm/pterm"
+)
+
+const (
+	// nodeBundlePath is where the unpacked bundle is mounted into every cluster node
+	nodeBundlePath = "/var/lib/openframe/bundle"
+	// gitServerNamespace holds the in-cluster git server
+	gitServerNamespace = "openframe-git"
+	// gitServerName is the name of the git server deployment and service
+	gitServerName = "git-server"
+)
+
+// gitServerManifest deploys a git daemon that serves the bundled repository from the node mount
+// The repository belongs to the host user, so git is told to trust it.
+const gitServerManifest = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: %[1]s
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: %[2]s
+  namespace: %[1]s
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: %[2]s
+  template:
+    metadata:
+      labels:
+        app: %[2]s
+    spec:
+      containers:
+        - name: git-daemon
+          image: %[3]s
+          imagePullPolicy: IfNotPresent
+          command: ["sh", "-c"]
+          args:
+            - git config --global --add safe.directory '*' && exec git daemon --reuse-addr --export-all --base-path=/srv/git /srv/git
+          ports:
+            - containerPort: 9418
+              name: git
+          readinessProbe:
+            tcpSocket:
+              port: git
+          volumeMounts:
+            - name: bundle
+              mountPath: /srv/git
+              readOnly: true
+      volumes:
+        - name: bundle
+          hostPath:
+            path: %[4]s
+            type: Directory
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: %[2]s
+  namespace: %[1]s
+spec:
+  selector:
+    app: %[2]s
+  ports:
+    - port: 9418
+      targetPort: git
+      name: git
+`
+
+// Unpacked is an offline bundle extracted to disk
+type Unpacked struct {
+	Dir      string
+	Manifest *Manifest
+}
+
+// DefaultDirectory returns the directory bundles are unpacked into
+// Bundles stay there after bootstrap, since the cluster nodes mount the bundled repository.
+func DefaultDirectory() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".config", "openframe", "bundles"), nil
+}
+
+// Unpack extracts a bundle into the default bundle directory and checks that it fits this machine
+// A bundle that was unpacked before is reused, so clusters that mount it keep working.
+func Unpack(archivePath string) (*Unpacked, error) {
+	directory, err := DefaultDirectory()
+	if err != nil {
+		return nil, err
+	}
+	return unpackInto(archivePath, directory, runtime.GOOS+"/"+runtime.GOARCH)
+}
+
+// unpackInto extracts a bundle below a directory, named after the checksum of the archive
+func unpackInto(archivePath, directory, platform string) (*Unpacked, error) {
+	checksum, err := fileChecksum(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bundle %s: %w", archivePath, err)
+	}
+	target := filepath.Join(directory, checksum[:12])
+
+	if _, err := os.Stat(filepath.Join(target, ManifestFile)); err != nil {
+		if err := os.MkdirAll(directory, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create bundle directory: %w", err)
+		}
+		staging, err := os.MkdirTemp(directory, ".unpack-")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create bundle directory: %w", err)
+		}
+		if err := extractTar(archivePath, staging); err != nil {
+			os.RemoveAll(staging)
+			return nil, fmt.Errorf("failed to read bundle %s: %w", archivePath, err)
+		}
+		os.RemoveAll(target)
+		if err := os.Rename(staging, target); err != nil {
+			os.RemoveAll(staging)
+			return nil, fmt.Errorf("failed to unpack bundle: %w", err)
+		}
+	}
+
+	manifestData, err := os.ReadFile(filepath.Join(target, ManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("bundle %s has no manifest", archivePath)
+	}
+	manifest, err := ParseManifest(manifestData)
+	if err != nil {
+		return nil, err
+	}
+	if len(manifest.Tools) > 0 && manifest.Platform != platform {
+		return nil, fmt.Errorf("bundle %s was created for %s, this machine is %s; create it with --platform %s",
+			archivePath, manifest.Platform, platform, platform)
+	}
+
+	return &Unpacked{Dir: target, Manifest: manifest}, nil
+}
+
+// fileChecksum returns the hex SHA-256 of a file
+func fileChecksum(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// path returns the location of a bundle entry on disk
+func (u *Unpacked) path(entry string) string {
+	return filepath.Join(u.Dir, filepath.FromSlash(entry))
+}
+
+// ClusterVolume mounts the unpacked bundle into the server and agent nodes for the git server
+func (u *Unpacked) ClusterVolume() models.VolumeSpec {
+	return models.VolumeSpec{
+		Volume:      u.Dir + ":" + nodeBundlePath,
+		NodeFilters: []string{"server:*", "agent:*"},
+	}
+}
+
+// ClusterRepoURL returns the URL of the bundled repository on the in-cluster git server
+func (u *Unpacked) ClusterRepoURL() string {
+	return fmt.Sprintf("git://%s.%s.svc.cluster.local/%s", gitServerName, gitServerNamespace, u.Manifest.Repository.Path)
+}
+
+// InstallationSource points a chart installation at the bundled charts and repository
+func (u *Unpacked) InstallationSource() *types.OfflineSource {
+	return &types.OfflineSource{
+		ArgoCDChart:    u.path(u.Manifest.ArgoCD.Archive),
+		Repository:     u.path(u.Manifest.Repository.Path),
+		Revision:       u.Manifest.Repository.Branch,
+		ClusterRepoURL: u.ClusterRepoURL(),
+	}
+}
+
+// Installer installs the contents of an unpacked bundle onto this machine and into a cluster
+type Installer struct {
+	executor executor.CommandExecutor
+}
+
+// NewInstaller creates a bundle installer
+func NewInstaller(exec executor.CommandExecutor) *Installer {
+	return &Installer{
+		executor: exec,
+	}
+}
+
+// InstallTools copies the bundled tool binaries into the user-local bin directory,
+// which is on the PATH of every openframe command
+func (i *Installer) InstallTools(u *Unpacked) error {
+	if len(u.Manifest.Tools) == 0 {
+		return nil
+	}
+	dir, err := localbin.Dir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+
+	for _, tool := range u.Manifest.Tools {
+		data, err := os.ReadFile(u.path(tool.Path))
+		if err != nil {
+			return fmt.Errorf("bundle is missing %s: %w", tool.Path, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, tool.Name), data, 0755); err != nil {
+			return fmt.Errorf("failed to install %s: %w", tool.Name, err)
+		}
+	}
+	pterm.Success.Printf("Installed %d tools into %s\n", len(u.Manifest.Tools), dir)
+	return nil
+}
+
+// LoadHostImages loads the k3s node and k3d helper images into Docker before the cluster is created
+func (i *Installer) LoadHostImages(ctx context.Context, u *Unpacked) error {
+	if u.Manifest.Images.HostArchive == "" {
+		return nil
+	}
+	spinner, _ := pterm.DefaultSpinner.Start("Loading cluster node images into Docker...")
+	if _, err := i.executor.Execute(ctx, "docker", "load", "--input", u.path(u.Manifest.Images.HostArchive)); err != nil {
+		spinner.Fail("Failed to load cluster node images")
+		return fmt.Errorf("failed to load cluster node images: %w", err)
+	}
+	spinner.Success(fmt.Sprintf("Loaded %d cluster node images", len(u.Manifest.Images.Host)))
+	return nil
+}
+
+// ImportClusterImages imports the application images into the nodes of a k3d cluster
+// Pods with the IfNotPresent pull policy then start without a registry.
+func (i *Installer) ImportClusterImages(ctx context.Context, u *Unpacked, clusterName string) error {
+	if u.Manifest.Images.ClusterArchive == "" {
+		return nil
+	}
+	spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Importing %d images into cluster %s...", len(u.Manifest.Images.Cluster), clusterName))
+	if _, err := i.executor.Execute(ctx, "k3d", "image", "import", u.path(u.Manifest.Images.ClusterArchive), "--cluster", clusterName); err != nil {
+		spinner.Fail("Failed to import images")
+		return fmt.Errorf("failed to import images into cluster %s: %w", clusterName, err)
+	}
+	spinner.Success(fmt.Sprintf("Imported %d images into cluster %s", len(u.Manifest.Images.Cluster), clusterName))
+	return nil
+}
+
+// DeployGitServer runs the in-cluster git server that ArgoCD syncs the bundled repository from
+// The cluster must have been created with ClusterVolume.
+func (i *Installer) DeployGitServer(ctx context.Context, u *Unpacked, clusterName string) error {
+	kubeContext := "k3d-" + clusterName
+
+	manifestFile, err := os.CreateTemp("", "openframe-git-server-*.yaml")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary manifest: %w", err)
+	}
+	defer os.Remove(manifestFile.Name())
+	if _, err := manifestFile.WriteString(fmt.Sprintf(gitServerManifest, gitServerNamespace, gitServerName, GitServerImage, nodeBundlePath)); err != nil {
+		manifestFile.Close()
+		return fmt.Errorf("failed to write temporary manifest: %w", err)
+	}
+	manifestFile.Close()
+
+	spinner, _ := pterm.DefaultSpinner.Start("Starting the in-cluster git server...")
+	if _, err := i.executor.Execute(ctx, "kubectl", "--context", kubeContext, "apply", "-f", manifestFile.Name()); err != nil {
+		spinner.Fail("Failed to start the git server")
+		return fmt.Errorf("failed to deploy the git server: %w", err)
+	}
+	if _, err := i.executor.Execute(ctx, "kubectl", "--context", kubeContext, "rollout", "status",
+		"deployment/"+gitServerName, "--namespace", gitServerNamespace, "--timeout", "3m"); err != nil {
+		spinner.Fail("Git server did not become ready")
+		return fmt.Errorf("git server did not become ready: %w", err)
+	}
+	spinner.Success(fmt.Sprintf("Git server serving %s", u.ClusterRepoURL()))
+	return nil
+}
@@ -0,0 +1,157 @@
+package bundle
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// writeTestBundle packs a manifest and a tool binary into a bundle archive
+func writeTestBundle(t *testing.T, manifest *Manifest) string {
+	staging := t.TempDir()
+	data, err := manifest.ToYAML()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(staging, ManifestFile), data, 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(staging, "bin"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(staging, "bin", "k3d"), []byte("#!/bin/sh\necho k3d\n"), 0755))
+
+	archive := filepath.Join(t.TempDir(), "bundle.tar")
+	require.NoError(t, writeTar(staging, archive))
+	return archive
+}
+
+func TestUnpackInto(t *testing.T) {
+	archive := writeTestBundle(t, testManifest())
+	directory := t.TempDir()
+
+	unpacked, err := unpackInto(archive, directory, "linux/amd64")
+	require.NoError(t, err)
+
+	assert.Equal(t, directory, filepath.Dir(unpacked.Dir))
+	assert.Equal(t, "main", unpacked.Manifest.Repository.Revision)
+	info, err := os.Stat(filepath.Join(unpacked.Dir, "bin", "k3d"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0755), info.Mode().Perm(), "file modes are kept")
+
+	again, err := unpackInto(archive, directory, "linux/amd64")
+	require.NoError(t, err)
+	assert.Equal(t, unpacked.Dir, again.Dir, "an unpacked bundle is reused")
+	entries, err := os.ReadDir(directory)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestUnpackIntoRejectsOtherPlatforms(t *testing.T) {
+	archive := writeTestBundle(t, testManifest())
+
+	_, err := unpackInto(archive, t.TempDir(), "darwin/arm64")
+	assert.EqualError(t, err, "bundle "+archive+" was created for linux/amd64, this machine is darwin/arm64; create it with --platform darwin/arm64")
+
+	withoutTools := testManifest()
+	withoutTools.Tools = nil
+	_, err = unpackInto(writeTestBundle(t, withoutTools), t.TempDir(), "darwin/arm64")
+	assert.NoError(t, err, "bundles without tools install on any platform")
+}
+
+func TestExtractTarRejectsEscapingEntries(t *testing.T) {
+	archive := filepath.Join(t.TempDir(), "evil.tar")
+	file, err := os.Create(archive)
+	require.NoError(t, err)
+	writer := tar.NewWriter(file)
+	require.NoError(t, writer.WriteHeader(&tar.Header{Name: "../escape", Mode: 0644, Size: 1, Typeflag: tar.TypeReg}))
+	_, err = writer.Write([]byte("x"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	require.NoError(t, file.Close())
+
+	err = extractTar(archive, t.TempDir())
+	assert.EqualError(t, err, "invalid entry ../escape in bundle")
+}
+
+func TestUnpacked_InstallationSource(t *testing.T) {
+	unpacked := &Unpacked{Dir: "/home/me/.config/openframe/bundles/abc", Manifest: testManifest()}
+
+	source := unpacked.InstallationSource()
+	assert.Equal(t, "/home/me/.config/openframe/bundles/abc/charts/argo-cd-8.1.4.tgz", source.ArgoCDChart)
+	assert.Equal(t, "/home/me/.config/openframe/bundles/abc/repository.git", source.Repository)
+	assert.Equal(t, Branch, source.Revision)
+	assert.Equal(t, "git://git-server.openframe-git.svc.cluster.local/repository.git", source.ClusterRepoURL)
+
+	volume := unpacked.ClusterVolume()
+	assert.Equal(t, "/home/me/.config/openframe/bundles/abc:/var/lib/openframe/bundle", volume.Volume)
+	assert.Equal(t, []string{"server:*", "agent:*"}, volume.NodeFilters)
+}
+
+func TestInstaller_InstallTools(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	unpacked, err := unpackInto(writeTestBundle(t, testManifest()), t.TempDir(), "linux/amd64")
+	require.NoError(t, err)
+
+	require.NoError(t, NewInstaller(executor.NewMockCommandExecutor()).InstallTools(unpacked))
+
+	content, err := os.ReadFile(filepath.Join(home, ".config", "openframe", "bin", "k3d"))
+	require.NoError(t, err)
+	assert.Equal(t, "#!/bin/sh\necho k3d\n", string(content))
+}
+
+func TestInstaller_ClusterSetup(t *testing.T) {
+	unpacked := &Unpacked{Dir: "/bundles/abc", Manifest: testManifest()}
+	mockExec := executor.NewMockCommandExecutor()
+	installer := NewInstaller(mockExec)
+	ctx := context.Background()
+
+	require.NoError(t, installer.LoadHostImages(ctx, unpacked))
+	require.NoError(t, installer.ImportClusterImages(ctx, unpacked, "dev"))
+	require.NoError(t, installer.DeployGitServer(ctx, unpacked, "dev"))
+
+	commands := mockExec.GetExecutedCommands()
+	require.Len(t, commands, 4)
+	assert.Equal(t, "docker load --input /bundles/abc/images/host.tar", commands[0])
+	assert.Equal(t, "k3d image import /bundles/abc/images/cluster.tar --cluster dev", commands[1])
+	assert.Contains(t, commands[2], "kubectl --context k3d-dev apply -f ")
+	assert.Equal(t, "kubectl --context k3d-dev rollout status deployment/git-server --namespace openframe-git --timeout 3m", commands[3])
+
+	mockExec.SetResponse("rollout status", &executor.CommandResult{ExitCode: 1})
+	assert.ErrorContains(t, installer.DeployGitServer(ctx, unpacked, "dev"), "git server did not become ready")
+}
+
+func TestInstaller_SkipsMissingImages(t *testing.T) {
+	manifest := testManifest()
+	manifest.Images = Images{}
+	unpacked := &Unpacked{Dir: "/bundles/abc", Manifest: manifest}
+	mockExec := executor.NewMockCommandExecutor()
+	installer := NewInstaller(mockExec)
+
+	require.NoError(t, installer.LoadHostImages(context.Background(), unpacked))
+	require.NoError(t, installer.ImportClusterImages(context.Background(), unpacked, "dev"))
+	assert.Zero(t, mockExec.GetCommandCount())
+}
+
+func TestGitServerManifest(t *testing.T) {
+	rendered := fmt.Sprintf(gitServerManifest, gitServerNamespace, gitServerName, GitServerImage, nodeBundlePath)
+
+	decoder := yaml.NewDecoder(strings.NewReader(rendered))
+	var kinds []string
+	for {
+		var document map[string]interface{}
+		if err := decoder.Decode(&document); err == io.EOF {
+			break
+		} else {
+			require.NoError(t, err)
+		}
+		kinds = append(kinds, document["kind"].(string))
+	}
+	assert.Equal(t, []string{"Namespace", "Deployment", "Service"}, kinds)
+	assert.Equal(t, []string{GitServerImage}, imagesInManifests(rendered), "the git server image is bundled")
+}
@@ -0,0 +1,124 @@
+package bundle
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Offline bundle manifest identifiers
+const (
+	APIVersion   = "openframe.io/v1alpha1"
+	Kind         = "OfflineBundle"
+	ManifestFile = "manifest.yaml"
+)
+
+// Manifest describes the contents of an offline bundle archive
+// Paths are relative to the root of the archive.
+type Manifest struct {
+	APIVersion string     `yaml:"apiVersion" json:"apiVersion"`
+	Kind       string     `yaml:"kind" json:"kind"`
+	CreatedAt  time.Time  `yaml:"createdAt" json:"createdAt"`
+	Platform   string     `yaml:"platform" json:"platform"` // os/arch the tool binaries and images were collected for
+	Repository Repository `yaml:"repository" json:"repository"`
+	ArgoCD     Chart      `yaml:"argocd" json:"argocd"`
+	Images     Images     `yaml:"images" json:"images"`
+	Tools      []Tool     `yaml:"tools,omitempty" json:"tools,omitempty"`
+}
+
+// Repository records the bundled app-of-apps and application manifests
+type Repository struct {
+	URL      string `yaml:"url" json:"url"`           // Repository the manifests were taken from
+	Revision string `yaml:"revision" json:"revision"` // Branch or tag that was bundled
+	Commit   string `yaml:"commit" json:"commit"`     // Commit the revision pointed to
+	Branch   string `yaml:"branch" json:"branch"`     // Branch of the bundled repository, with chart dependencies vendored
+	Path     string `yaml:"path" json:"path"`         // Bare git repository inside the bundle
+}
+
+// Chart records a bundled Helm chart archive
+type Chart struct {
+	Name    string `yaml:"name" json:"name"`
+	Version string `yaml:"version" json:"version"`
+	Archive string `yaml:"archive" json:"archive"`
+}
+
+// Images records the container images saved into the bundle
+// Host images are loaded into Docker before the cluster is created, cluster images are imported into its nodes.
+type Images struct {
+	Host           []string `yaml:"host,omitempty" json:"host,omitempty"`
+	HostArchive    string   `yaml:"hostArchive,omitempty" json:"hostArchive,omitempty"`
+	Cluster        []string `yaml:"cluster,omitempty" json:"cluster,omitempty"`
+	ClusterArchive string   `yaml:"clusterArchive,omitempty" json:"clusterArchive,omitempty"`
+}
+
+// Tool records a bundled tool binary
+type Tool struct {
+	Name    string `yaml:"name" json:"name"`
+	Version string `yaml:"version" json:"version"`
+	Path    string `yaml:"path" json:"path"`
+}
+
+// Validate checks that a manifest can be installed from
+func (m *Manifest) Validate() error {
+	if m.APIVersion != APIVersion {
+		return fmt.Errorf("invalid bundle manifest: apiVersion must be %s, got %q", APIVersion, m.APIVersion)
+	}
+	if m.Kind != Kind {
+		return fmt.Errorf("invalid bundle manifest: kind must be %s, got %q", Kind, m.Kind)
+	}
+	if m.Repository.Path == "" || m.Repository.Branch == "" {
+		return fmt.Errorf("invalid bundle manifest: repository path and branch are required")
+	}
+	if m.ArgoCD.Archive == "" {
+		return fmt.Errorf("invalid bundle manifest: argocd chart archive is required")
+	}
+	for _, tool := range m.Tools {
+		// Tools are written into the bin directory by name and read from the bundle by path
+		if tool.Name == "" || tool.Name == "." || tool.Name == ".." || strings.ContainsAny(tool.Name, `/\`) {
+			return fmt.Errorf("invalid bundle manifest: tool name %q must be a plain file name", tool.Name)
+		}
+		if !isBundlePath(tool.Path) {
+			return fmt.Errorf("invalid bundle manifest: tool path %q must be relative to the bundle", tool.Path)
+		}
+	}
+	return nil
+}
+
+// isBundlePath reports whether a slash-separated path stays inside the bundle directory
+func isBundlePath(entry string) bool {
+	if entry == "" || path.IsAbs(entry) || strings.Contains(entry, `\`) {
+		return false
+	}
+	clean := path.Clean(entry)
+	return clean != "." && clean != ".." && !strings.HasPrefix(clean, "../")
+}
+
+// ParseManifest decodes and validates a bundle manifest
+func ParseManifest(data []byte) (*Manifest, error) {
+	var manifest Manifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid bundle manifest: %w", err)
+	}
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+// ToYAML renders the manifest as a YAML document
+func (m *Manifest) ToYAML() ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(m); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
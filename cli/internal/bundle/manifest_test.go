@@ -0,0 +1,83 @@
+package bundle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testManifest() *Manifest {
+	return &Manifest{
+		APIVersion: APIVersion,
+		Kind:       Kind,
+		CreatedAt:  time.Date(2026, 10, 17, 9, 30, 0, 0, time.UTC),
+		Platform:   "linux/amd64",
+		Repository: Repository{
+			URL:      DefaultRepository,
+			Revision: "main",
+			Commit:   "0123456789abcdef0123456789abcdef01234567",
+			Branch:   Branch,
+			Path:     repositoryPath,
+		},
+		ArgoCD: Chart{Name: "argo-cd", Version: "8.1.4", Archive: "charts/argo-cd-8.1.4.tgz"},
+		Images: Images{
+			Host:           []string{"rancher/k3s:v1.31.5-k3s1"},
+			HostArchive:    hostImagesArchive,
+			Cluster:        []string{GitServerImage},
+			ClusterArchive: clusterImagesArchive,
+		},
+		Tools: []Tool{{Name: "k3d", Version: "5.7.4", Path: "bin/k3d"}},
+	}
+}
+
+func TestManifestRoundTrip(t *testing.T) {
+	manifest := testManifest()
+
+	data, err := manifest.ToYAML()
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "kind: OfflineBundle")
+	assert.Contains(t, string(data), "branch: openframe-offline")
+
+	parsed, err := ParseManifest(data)
+	require.NoError(t, err)
+	assert.Equal(t, manifest, parsed)
+}
+
+func TestManifestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*Manifest)
+		wantErr string
+	}{
+		{"valid", func(m *Manifest) {}, ""},
+		{"wrong kind", func(m *Manifest) { m.Kind = "ClusterSnapshot" }, "kind must be OfflineBundle"},
+		{"wrong api version", func(m *Manifest) { m.APIVersion = "v2" }, "apiVersion must be"},
+		{"no repository", func(m *Manifest) { m.Repository.Path = "" }, "repository path and branch are required"},
+		{"no argocd chart", func(m *Manifest) { m.ArgoCD.Archive = "" }, "argocd chart archive is required"},
+		{"tool name with a directory", func(m *Manifest) { m.Tools[0].Name = "../../.bashrc" }, "must be a plain file name"},
+		{"tool name dot dot", func(m *Manifest) { m.Tools[0].Name = ".." }, "must be a plain file name"},
+		{"tool path escaping the bundle", func(m *Manifest) { m.Tools[0].Path = "bin/../../k3d" }, "must be relative to the bundle"},
+		{"absolute tool path", func(m *Manifest) { m.Tools[0].Path = "/etc/passwd" }, "must be relative to the bundle"},
+		{"empty tool path", func(m *Manifest) { m.Tools[0].Path = "" }, "must be relative to the bundle"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest := testManifest()
+			tt.modify(manifest)
+
+			err := manifest.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+
+	_, err := ParseManifest([]byte("kind: [unclosed"))
+	assert.Error(t, err)
+}
@@ -5,6 +5,10 @@ import (
 	"strings"
 )
 
+// RootApplication is the ArgoCD application the app-of-apps chart creates; ArgoCD renders the
+// applications chart from it, which creates every other application
+const RootApplication = "argocd-apps"
+
 // AppOfAppsConfig holds configuration for app-of-apps installation
 type AppOfAppsConfig struct {
 	// GitHub repository configuration
@@ -18,6 +22,8 @@ type AppOfAppsConfig struct {
 	// Helm configuration
 	Namespace string // Target namespace (e.g., "argocd")
 	Timeout   string // Installation timeout (e.g., "60m")
+	// Offline configuration
+	ClusterRepoURL string // Repository URL ArgoCD fetches the applications from, overriding global.repoURL (e.g., an in-cluster git server)
 }
 
 // NewAppOfAppsConfig creates a new AppOfAppsConfig with defaults
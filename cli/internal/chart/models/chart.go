@@ -1,12 +1,26 @@
 package models
 
+import "time"
+
+// ReleaseStatusNotInstalled is the status of a release that does not exist in the cluster
+const ReleaseStatusNotInstalled = "not-installed"
+
 // ChartInfo represents information about an installed chart
 type ChartInfo struct {
-	Name       string
-	Namespace  string
-	Status     string
-	Version    string
-	AppVersion string
+	Name         string    `json:"name"`
+	Namespace    string    `json:"namespace"`
+	Status       string    `json:"status"`                  // Helm release status, e.g. deployed or failed
+	Version      string    `json:"version,omitempty"`       // Chart version
+	AppVersion   string    `json:"app_version,omitempty"`   // Version of the application the chart deploys
+	Revision     int       `json:"revision,omitempty"`      // Release revision, incremented on every upgrade
+	LastDeployed time.Time `json:"last_deployed,omitempty"` // Zero when the release is not installed
+	Description  string    `json:"description,omitempty"`   // Result of the last release operation
+	Notes        string    `json:"notes,omitempty"`         // Rendered NOTES.txt of the chart
+}
+
+// IsDeployed reports whether the release was deployed successfully
+func (c ChartInfo) IsDeployed() bool {
+	return c.Status == "deployed"
 }
 
 // ChartType represents the type of chart
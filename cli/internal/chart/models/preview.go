@@ -0,0 +1,37 @@
+package models
+
+// ApplicationAction says what an install does to an ArgoCD application
+type ApplicationAction string
+
+const (
+	ApplicationCreated ApplicationAction = "create"
+	ApplicationChanged ApplicationAction = "change"
+	ApplicationRemoved ApplicationAction = "remove"
+)
+
+// ApplicationChange is an ArgoCD application the install creates, changes or removes
+type ApplicationChange struct {
+	Name    string            `json:"name"`
+	Action  ApplicationAction `json:"action"`
+	Changes []string          `json:"changes,omitempty"` // Changed source and destination fields, e.g. "targetRevision: main → develop"
+}
+
+// InstallPreview shows what chart install would change, without installing
+// Value changes are redacted, so the preview can be printed or written to a report.
+type InstallPreview struct {
+	BaseValuesFile        string              `json:"base_values_file"`
+	BaseChanges           []ValueChange       `json:"base_changes"`               // Base values file against the generated values
+	Installed             bool                `json:"installed"`                  // Whether app-of-apps is already deployed
+	DeployedChanges       []ValueChange       `json:"deployed_changes,omitempty"` // Deployed release values against the generated values
+	Applications          []ApplicationChange `json:"applications"`
+	UnchangedApplications int                 `json:"unchanged_applications"`
+}
+
+// RedactedValueChanges returns value changes with their secrets replaced
+func RedactedValueChanges(changes []ValueChange) []ValueChange {
+	redacted := make([]ValueChange, 0, len(changes))
+	for _, change := range changes {
+		redacted = append(redacted, change.Redacted())
+	}
+	return redacted
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"fmt"
+
+	clusterDomain "github.com/flamingo/openframe/internal/cluster/models"
+)
+
+// ChartStatusKind is the document kind of a chart status report
+const ChartStatusKind = "ChartStatus"
+
+// ChartStatusReport is the state of the OpenFrame Helm releases and ArgoCD applications of a cluster
+type ChartStatusReport struct {
+	APIVersion           string                            `json:"apiVersion"`
+	Kind                 string                            `json:"kind"`
+	Context              string                            `json:"context,omitempty"` // Kubeconfig context, empty for the current one
+	Releases             []ChartInfo                       `json:"releases"`
+	Applications         []clusterDomain.ApplicationStatus `json:"applications,omitempty"`
+	ApplicationsError    string                            `json:"applications_error,omitempty"`
+	DegradedApplications []string                          `json:"degraded_applications,omitempty"`
+}
+
+// Problems lists the releases that are not deployed and the degraded applications
+func (r *ChartStatusReport) Problems() []string {
+	var problems []string
+	for _, release := range r.Releases {
+		if !release.IsDeployed() {
+			problems = append(problems, fmt.Sprintf("release %s is %s", release.Name, release.Status))
+		}
+	}
+	for _, app := range r.DegradedApplications {
+		problems = append(problems, fmt.Sprintf("application %s is degraded", app))
+	}
+	return problems
+}
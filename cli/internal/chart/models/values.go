@@ -0,0 +1,150 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ValueChangeKind says how a Helm value differs between two sets of values
+type ValueChangeKind string
+
+const (
+	ValueAdded   ValueChangeKind = "added"
+	ValueRemoved ValueChangeKind = "removed"
+	ValueChanged ValueChangeKind = "changed"
+)
+
+// RedactedValue replaces secrets when values are displayed
+const RedactedValue = "<redacted>"
+
+// ValueChange is a single Helm value that differs, addressed by its dotted path
+type ValueChange struct {
+	Path string          `json:"path"`
+	Kind ValueChangeKind `json:"kind"`
+	Old  interface{}     `json:"old,omitempty"`
+	New  interface{}     `json:"new,omitempty"`
+}
+
+// DiffValues compares two sets of Helm values leaf by leaf, sorted by path
+// Lists are compared as a whole, like Helm merges them.
+func DiffValues(current, desired map[string]interface{}) []ValueChange {
+	currentLeaves := make(map[string]interface{})
+	desiredLeaves := make(map[string]interface{})
+	flattenValues("", current, currentLeaves)
+	flattenValues("", desired, desiredLeaves)
+
+	var changes []ValueChange
+	for path, old := range currentLeaves {
+		value, ok := desiredLeaves[path]
+		switch {
+		case !ok:
+			changes = append(changes, ValueChange{Path: path, Kind: ValueRemoved, Old: old})
+		case !reflect.DeepEqual(old, value):
+			changes = append(changes, ValueChange{Path: path, Kind: ValueChanged, Old: old, New: value})
+		}
+	}
+	for path, value := range desiredLeaves {
+		if _, ok := currentLeaves[path]; !ok {
+			changes = append(changes, ValueChange{Path: path, Kind: ValueAdded, New: value})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+	return changes
+}
+
+// flattenValues collects the leaves of nested values by dotted path
+func flattenValues(prefix string, value interface{}, leaves map[string]interface{}) {
+	section, ok := value.(map[string]interface{})
+	if !ok || len(section) == 0 {
+		if prefix != "" {
+			leaves[prefix] = value
+		}
+		return
+	}
+	for key, child := range section {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		flattenValues(path, child, leaves)
+	}
+}
+
+// IsSecretValuePath reports whether a dotted value path holds a credential
+func IsSecretValuePath(path string) bool {
+	for _, key := range strings.Split(strings.ToLower(path), ".") {
+		if strings.Contains(key, "password") || strings.Contains(key, "token") ||
+			strings.Contains(key, "secret") || strings.Contains(key, "credential") ||
+			strings.HasSuffix(key, "key") {
+			return true
+		}
+	}
+	return false
+}
+
+// Redacted returns the change with secret values replaced, safe to display or write to a report
+// Lists are leaves of the diff, so secrets of the maps inside them are replaced as well.
+func (c ValueChange) Redacted() ValueChange {
+	if !IsSecretValuePath(c.Path) {
+		c.Old = redactNested(c.Old)
+		c.New = redactNested(c.New)
+		return c
+	}
+	if c.Old != nil {
+		c.Old = RedactedValue
+	}
+	if c.New != nil {
+		c.New = RedactedValue
+	}
+	return c
+}
+
+// redactNested returns a copy of a value with the secrets of nested maps replaced
+func redactNested(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			if child != nil && IsSecretValuePath(key) {
+				redacted[key] = RedactedValue
+			} else {
+				redacted[key] = redactNested(child)
+			}
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, child := range v {
+			redacted[i] = redactNested(child)
+		}
+		return redacted
+	default:
+		return value
+	}
+}
+
+// FormatValue renders a Helm value on one line
+// Multi-line strings such as certificates are summarized by their line count.
+func FormatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		if lines := strings.Count(strings.TrimRight(v, "\n"), "\n") + 1; lines > 1 {
+			return fmt.Sprintf("(%d lines)", lines)
+		}
+		return v
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
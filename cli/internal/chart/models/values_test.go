@@ -0,0 +1,81 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffValues(t *testing.T) {
+	current := map[string]interface{}{
+		"global": map[string]interface{}{"repoBranch": "main", "repoURL": "https://github.com/test/repo.git"},
+		"apps":   map[string]interface{}{"kafka": map[string]interface{}{"enabled": true}},
+		"hosts":  []interface{}{"a", "b"},
+		"empty":  map[string]interface{}{},
+	}
+	desired := map[string]interface{}{
+		"global": map[string]interface{}{"repoBranch": "develop", "repoURL": "https://github.com/test/repo.git"},
+		"apps":   map[string]interface{}{"mongodb": map[string]interface{}{"enabled": true}},
+		"hosts":  []interface{}{"a", "b"},
+		"empty":  map[string]interface{}{},
+	}
+
+	assert.Equal(t, []ValueChange{
+		{Path: "apps.kafka.enabled", Kind: ValueRemoved, Old: true},
+		{Path: "apps.mongodb.enabled", Kind: ValueAdded, New: true},
+		{Path: "global.repoBranch", Kind: ValueChanged, Old: "main", New: "develop"},
+	}, DiffValues(current, desired))
+
+	assert.Empty(t, DiffValues(current, current))
+	assert.Empty(t, DiffValues(nil, map[string]interface{}{}))
+}
+
+func TestIsSecretValuePath(t *testing.T) {
+	secrets := []string{
+		"registry.docker.password",
+		"deployment.oss.ingress.ngrok.credentials.authToken",
+		"deployment.oss.ingress.ngrok.credentials.apiKey",
+		"deployment.oss.ingress.localhost.tls.key",
+		"global.githubToken",
+		"apps.api.values.clientSecret",
+	}
+	for _, path := range secrets {
+		assert.True(t, IsSecretValuePath(path), path)
+	}
+
+	for _, path := range []string{"global.repoBranch", "deployment.oss.ingress.localhost.tls.cert", "registry.docker.username"} {
+		assert.False(t, IsSecretValuePath(path), path)
+	}
+}
+
+func TestValueChangeRedacted(t *testing.T) {
+	secret := ValueChange{Path: "registry.docker.password", Kind: ValueChanged, Old: "old", New: "new"}
+	assert.Equal(t, ValueChange{Path: "registry.docker.password", Kind: ValueChanged, Old: RedactedValue, New: RedactedValue}, secret.Redacted())
+	assert.Equal(t, "old", secret.Old, "the original change is left alone")
+
+	added := ValueChange{Path: "registry.docker.password", Kind: ValueAdded, New: "new"}
+	assert.Nil(t, added.Redacted().Old)
+
+	plain := ValueChange{Path: "global.repoBranch", Kind: ValueChanged, Old: "main", New: "develop"}
+	assert.Equal(t, plain, plain.Redacted())
+
+	users := []interface{}{
+		map[string]interface{}{"name": "admin", "password": "hunter2", "auth": map[string]interface{}{"apiToken": "abc"}},
+		"plain",
+	}
+	list := ValueChange{Path: "registry.users", Kind: ValueAdded, New: users}
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "admin", "password": RedactedValue, "auth": map[string]interface{}{"apiToken": RedactedValue}},
+		"plain",
+	}, list.Redacted().New)
+	assert.Equal(t, "hunter2", users[0].(map[string]interface{})["password"], "the original list is left alone")
+}
+
+func TestFormatValue(t *testing.T) {
+	assert.Equal(t, "null", FormatValue(nil))
+	assert.Equal(t, "main", FormatValue("main"))
+	assert.Equal(t, "(3 lines)", FormatValue("-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"))
+	assert.Equal(t, "true", FormatValue(true))
+	assert.Equal(t, "8080", FormatValue(8080))
+	assert.Equal(t, `["a","b"]`, FormatValue([]interface{}{"a", "b"}))
+}
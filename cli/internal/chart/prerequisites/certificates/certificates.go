@@ -7,10 +7,19 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+
+	"github.com/flamingo/openframe/internal/shared/localbin"
 )
 
 type CertificateInstaller struct{}
 
+// mkcertBinary is the pinned mkcert release installed with --local-tools
+var mkcertBinary = localbin.Binary{
+	Name:    "mkcert",
+	Version: "1.4.4",
+	URL:     "https://github.com/FiloSottile/mkcert/releases/download/v{version}/mkcert-v{version}-{os}-{arch}",
+}
+
 func commandExists(cmd string) bool {
 	_, err := exec.LookPath(cmd)
 	return err == nil
@@ -64,6 +73,11 @@ func (c *CertificateInstaller) IsInstalled() bool {
 	return isMkcertInstalled()
 }
 
+// LocalBinary returns the pinned mkcert release installed with --local-tools
+func (c *CertificateInstaller) LocalBinary() localbin.Binary {
+	return mkcertBinary
+}
+
 func (c *CertificateInstaller) GetInstallHelp() string {
 	return certificateInstallHelp()
 }
@@ -92,6 +106,10 @@ func (c *CertificateInstaller) ForceRegenerate() error {
 }
 
 func (c *CertificateInstaller) installMkcert() error {
+	if localbin.Enabled() {
+		return localbin.Install(mkcertBinary)
+	}
+
 	switch runtime.GOOS {
 	case "darwin":
 		return c.installMkcertMacOS()
@@ -126,3 +126,9 @@ func containsSubstring(str, substr string) bool {
 			return false
 		}()
 }
+
+func TestMkcertBinary(t *testing.T) {
+	if err := mkcertBinary.Validate(); err != nil {
+		t.Errorf("mkcert binary pins an invalid checksum: %v", err)
+	}
+}
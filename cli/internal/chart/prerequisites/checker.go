@@ -7,6 +7,7 @@ import (
 	"github.com/flamingo/openframe/internal/chart/prerequisites/git"
 	"github.com/flamingo/openframe/internal/chart/prerequisites/helm"
 	"github.com/flamingo/openframe/internal/chart/prerequisites/memory"
+	"github.com/flamingo/openframe/internal/shared/version"
 )
 
 type PrerequisiteChecker struct {
@@ -18,6 +19,7 @@ type Requirement struct {
 	Command     string
 	IsInstalled func() bool
 	InstallHelp func() string
+	Status      func() version.ToolStatus // Version check of the tool; nil for requirements that are not tools
 }
 
 func NewPrerequisiteChecker() *PrerequisiteChecker {
@@ -26,14 +28,16 @@ func NewPrerequisiteChecker() *PrerequisiteChecker {
 			{
 				Name:        "Git",
 				Command:     "git",
-				IsInstalled: func() bool { return git.NewGitChecker().IsInstalled() },
+				IsInstalled: func() bool { return git.NewGitChecker().IsSupported() },
 				InstallHelp: func() string { return git.NewGitChecker().GetInstallInstructions() },
+				Status:      func() version.ToolStatus { return git.NewGitChecker().Status() },
 			},
 			{
 				Name:        "Helm",
 				Command:     "helm",
-				IsInstalled: func() bool { return helm.NewHelmInstaller().IsInstalled() },
+				IsInstalled: func() bool { return helm.NewHelmInstaller().IsSupported() },
 				InstallHelp: func() string { return helm.NewHelmInstaller().GetInstallHelp() },
+				Status:      func() version.ToolStatus { return helm.NewHelmInstaller().Status() },
 			},
 			{
 				Name:        "Memory",
@@ -80,6 +84,23 @@ func (pc *PrerequisiteChecker) GetInstallInstructions(missingTools []string) []s
 	return instructions
 }
 
+// Requirements returns the prerequisites checked by CheckAll, in check order
+func (pc *PrerequisiteChecker) Requirements() []Requirement {
+	return pc.requirements
+}
+
+// Outdated reports whether a tool returned by CheckAll is installed but too old or too new,
+// so that callers can offer to replace it with the pinned version instead of an install
+func (pc *PrerequisiteChecker) Outdated(tool string) (version.ToolStatus, bool) {
+	for _, req := range pc.requirements {
+		if strings.EqualFold(req.Name, tool) && req.Status != nil {
+			status := req.Status()
+			return status, status.Unsupported()
+		}
+	}
+	return version.ToolStatus{}, false
+}
+
 func CheckPrerequisites() error {
 	installer := NewInstaller()
 	return installer.CheckAndInstall()
@@ -24,6 +24,20 @@ func TestNewPrerequisiteChecker(t *testing.T) {
 	}
 }
 
+func TestRequirements(t *testing.T) {
+	requirements := NewPrerequisiteChecker().Requirements()
+
+	expectedNames := []string{"Git", "Helm", "Memory", "Certificates"}
+	if len(requirements) != len(expectedNames) {
+		t.Fatalf("Expected %d requirements, got %d", len(expectedNames), len(requirements))
+	}
+	for i, req := range requirements {
+		if req.Name != expectedNames[i] {
+			t.Errorf("Expected requirement %d to be %s, got %s", i, expectedNames[i], req.Name)
+		}
+	}
+}
+
 func TestInstallHelp(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -4,14 +4,21 @@ import (
 	"fmt"
 	"os/exec"
 	"strings"
+
+	"github.com/flamingo/openframe/internal/shared/version"
 )
 
 // GitChecker validates git prerequisites
-type GitChecker struct{}
+type GitChecker struct {
+	version.Prerequisite
+}
+
+// tool declares the supported git versions; git is not installed automatically
+var tool = version.Tool{Command: "git", VersionArgs: []string{"--version"}, Supported: ">= 2.0.0"}
 
 // NewGitChecker creates a new git prerequisite checker
 func NewGitChecker() *GitChecker {
-	return &GitChecker{}
+	return &GitChecker{Prerequisite: version.NewPrerequisite(tool, nil)}
 }
 
 // IsInstalled checks if git is installed and available
@@ -55,20 +62,13 @@ func (g *GitChecker) Validate() error {
 		return fmt.Errorf("git is not installed or not in PATH")
 	}
 
-	version, err := g.GetVersion()
+	output, err := g.GetVersion()
 	if err != nil {
 		return fmt.Errorf("git is installed but not working properly: %w", err)
 	}
 
-	// Check for minimum version (git 2.0+)
-	if strings.Contains(version, "git version ") {
-		versionParts := strings.Split(version, " ")
-		if len(versionParts) >= 3 {
-			gitVersion := versionParts[2]
-			if strings.HasPrefix(gitVersion, "1.") {
-				return fmt.Errorf("git version %s is too old, please upgrade to git 2.0 or newer", gitVersion)
-			}
-		}
+	if status := tool.Evaluate(output); status.State == version.ToolTooOld {
+		return fmt.Errorf("git version %s is too old, please upgrade to git 2.0 or newer", status.Version)
 	}
 
 	return nil
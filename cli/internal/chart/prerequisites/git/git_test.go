@@ -3,6 +3,8 @@ package git
 import (
 	"strings"
 	"testing"
+
+	"github.com/flamingo/openframe/internal/shared/version"
 )
 
 func TestNewGitChecker(t *testing.T) {
@@ -92,3 +94,18 @@ func TestGitChecker_GetVersion_NotInstalled(t *testing.T) {
 		t.Error("Expected error when git is not installed")
 	}
 }
+
+func TestGitChecker_Tool(t *testing.T) {
+	tool := NewGitChecker().Tool()
+
+	if tool.Command != "git" {
+		t.Errorf("Expected tool command to be git, got %s", tool.Command)
+	}
+	constraint, err := version.ParseConstraint(tool.Supported)
+	if err != nil {
+		t.Fatalf("Supported range should parse: %v", err)
+	}
+	if constraint.Check(version.MustParse("1.0.0")) {
+		t.Errorf("Version 1.0.0 should be outside the supported range %s", tool.Supported)
+	}
+}
@@ -4,9 +4,27 @@ import (
 	"fmt"
 	"os/exec"
 	"runtime"
+
+	"github.com/flamingo/openframe/internal/shared/localbin"
+	"github.com/flamingo/openframe/internal/shared/version"
 )
 
-type HelmInstaller struct{}
+type HelmInstaller struct {
+	version.Prerequisite
+}
+
+// tool declares the supported Helm versions; older releases render the chart
+// values differently without an error, and Helm 4 changes the flags and output
+// of the install, upgrade and template commands the chart service runs
+var tool = version.Tool{Command: "helm", VersionArgs: []string{"version", "--short"}, Supported: ">= 3.12.0 < 4.0.0", Pinned: "3.16.2"}
+
+// localBinary is the pinned Helm release installed with --local-tools
+var localBinary = localbin.Binary{
+	Name:        "helm",
+	Version:     tool.Pinned,
+	URL:         "https://get.helm.sh/helm-v{version}-{os}-{arch}.tar.gz",
+	ArchivePath: "{os}-{arch}/helm",
+}
 
 func commandExists(cmd string) bool {
 	_, err := exec.LookPath(cmd)
@@ -36,7 +54,7 @@ func helmInstallHelp() string {
 }
 
 func NewHelmInstaller() *HelmInstaller {
-	return &HelmInstaller{}
+	return &HelmInstaller{Prerequisite: version.NewPrerequisite(tool, isHelmInstalled)}
 }
 
 func (h *HelmInstaller) IsInstalled() bool {
@@ -47,7 +65,16 @@ func (h *HelmInstaller) GetInstallHelp() string {
 	return helmInstallHelp()
 }
 
+// LocalBinary returns the pinned Helm release installed with --local-tools
+func (h *HelmInstaller) LocalBinary() localbin.Binary {
+	return localBinary
+}
+
 func (h *HelmInstaller) Install() error {
+	if localbin.Enabled() {
+		return localbin.Install(localBinary)
+	}
+
 	switch runtime.GOOS {
 	case "darwin":
 		return h.installMacOS()
@@ -65,7 +92,7 @@ func (h *HelmInstaller) installMacOS() error {
 		return fmt.Errorf("Homebrew is required for automatic Helm installation on macOS. Please install brew first: https://brew.sh")
 	}
 
-	cmd := exec.Command("brew", "install", "helm")
+	cmd := version.BrewInstallOrUpgrade("helm", "helm")
 
 	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("failed to install Helm: %w", err)
@@ -108,7 +135,7 @@ func (h *HelmInstaller) installUbuntu() error {
 
 func (h *HelmInstaller) installRedHat() error {
 	commands := []string{
-		"curl https://raw.githubusercontent.com/helm/helm/main/scripts/get-helm-3 | bash",
+		fmt.Sprintf("curl https://raw.githubusercontent.com/helm/helm/main/scripts/get-helm-3 | bash -s -- --version %s", tool.PinnedTag()),
 	}
 
 	for _, cmd := range commands {
@@ -136,8 +163,8 @@ func (h *HelmInstaller) installArch() error {
 }
 
 func (h *HelmInstaller) installScript() error {
-	// Use the official Helm install script
-	installCmd := "curl https://raw.githubusercontent.com/helm/helm/main/scripts/get-helm-3 | bash"
+	// Use the official Helm install script, pinned to the supported release
+	installCmd := fmt.Sprintf("curl https://raw.githubusercontent.com/helm/helm/main/scripts/get-helm-3 | bash -s -- --version %s", tool.PinnedTag())
 
 	if err := h.runShellCommand(installCmd); err != nil {
 		return fmt.Errorf("failed to install Helm via script: %w", err)
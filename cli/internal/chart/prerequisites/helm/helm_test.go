@@ -3,6 +3,8 @@ package helm
 import (
 	"runtime"
 	"testing"
+
+	"github.com/flamingo/openframe/internal/shared/version"
 )
 
 func TestNewHelmInstaller(t *testing.T) {
@@ -101,3 +103,30 @@ func containsSubstring(str, substr string) bool {
 			return false
 		}()
 }
+
+func TestHelmInstaller_Tool(t *testing.T) {
+	tool := NewHelmInstaller().Tool()
+
+	if tool.Command != "helm" {
+		t.Errorf("Expected tool command to be helm, got %s", tool.Command)
+	}
+	constraint, err := version.ParseConstraint(tool.Supported)
+	if err != nil {
+		t.Fatalf("Supported range should parse: %v", err)
+	}
+	if !constraint.Check(version.MustParse(tool.Pinned)) {
+		t.Errorf("Pinned version %s should be within the supported range %s", tool.Pinned, tool.Supported)
+	}
+	if status := tool.Evaluate("v4.0.0+g1234567"); status.State != version.ToolTooNew {
+		t.Errorf("Expected v4.0.0 to be too new, got %s", status.State)
+	}
+}
+
+func TestLocalBinary(t *testing.T) {
+	if localBinary.Version != tool.Pinned {
+		t.Errorf("Local binary version %s should be the pinned version %s", localBinary.Version, tool.Pinned)
+	}
+	if err := localBinary.Validate(); err != nil {
+		t.Errorf("Local binary pins an invalid checksum: %v", err)
+	}
+}
@@ -84,9 +84,12 @@ func (i *Installer) installTool(tool string) error {
 	switch strings.ToLower(tool) {
 	case "git":
 		checker := git.NewGitChecker()
-		if checker.IsInstalled() {
+		if checker.IsSupported() {
 			return nil // Already installed
 		}
+		if status := checker.Status(); status.Unsupported() {
+			return fmt.Errorf("git %s. %s", status, checker.GetInstallInstructions())
+		}
 		return fmt.Errorf("git is not installed. %s", checker.GetInstallInstructions())
 	case "helm":
 		installer := helm.NewHelmInstaller()
@@ -131,19 +134,38 @@ func (i *Installer) CheckAndInstall() error {
 	}
 
 	// Filter out memory from missing tools (we handle it as warning only)
+	// and keep tools in unsupported versions apart, since installing replaces them with the pinned version
 	installableMissing := []string{}
+	outdated := []string{}
 	for _, tool := range missing {
-		if strings.ToLower(tool) != "memory" {
+		if strings.ToLower(tool) == "memory" {
+			continue
+		}
+		if _, isOutdated := i.checker.Outdated(tool); isOutdated {
+			outdated = append(outdated, tool)
+		} else {
 			installableMissing = append(installableMissing, tool)
 		}
 	}
 
-	if len(installableMissing) > 0 {
-		// Show missing prerequisites with nice formatting
-		pterm.Warning.Printf("Missing Prerequisites: %s\n", strings.Join(installableMissing, ", "))
+	if len(installableMissing) > 0 || len(outdated) > 0 {
+		// Show missing and outdated prerequisites with nice formatting
+		if len(installableMissing) > 0 {
+			pterm.Warning.Printf("Missing Prerequisites: %s\n", strings.Join(installableMissing, ", "))
+		}
+		for _, tool := range outdated {
+			status, _ := i.checker.Outdated(tool)
+			pterm.Warning.Printf("Unsupported Prerequisite: %s %s\n", tool, status)
+		}
+		installableMissing = append(installableMissing, outdated...)
+
+		prompt := "Would you like me to install them automatically?"
+		if len(outdated) > 0 {
+			prompt = "Would you like me to install or upgrade them automatically?"
+		}
 
 		// Single confirmation using shared UI
-		confirmed, err := ui.ConfirmActionInteractive("Would you like me to install them automatically?", true)
+		confirmed, err := ui.ConfirmActionInteractive(prompt, true)
 		if err := errors.WrapConfirmationError(err, "failed to get user confirmation"); err != nil {
 			return err
 		}
@@ -2,6 +2,10 @@ package argocd
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/flamingo/openframe/internal/chart/utils/config"
@@ -23,9 +27,48 @@ func NewManager(exec executor.CommandExecutor) *Manager {
 
 // Application represents an ArgoCD application status
 type Application struct {
-	Name   string
-	Health string
-	Sync   string
+	Name             string
+	Health           string
+	Sync             string
+	Revision         string
+	SyncWave         int
+	OperationMessage string
+	TargetRevision   string // Revision the application should be at (spec.source.targetRevision)
+	ComparedRevision string // Target revision of the last comparison, equal to TargetRevision once ArgoCD has caught up
+}
+
+// syncWaveAnnotation is the ArgoCD annotation that orders application syncs
+const syncWaveAnnotation = "argocd.argoproj.io/sync-wave"
+
+// applicationList mirrors the subset of `kubectl get applications -o json` used by the CLI
+type applicationList struct {
+	Items []struct {
+		Metadata struct {
+			Name        string            `json:"name"`
+			Annotations map[string]string `json:"annotations"`
+		} `json:"metadata"`
+		Spec struct {
+			Source  applicationSource   `json:"source"`
+			Sources []applicationSource `json:"sources"`
+		} `json:"spec"`
+		Status struct {
+			Health struct {
+				Status string `json:"status"`
+			} `json:"health"`
+			Sync struct {
+				Status    string   `json:"status"`
+				Revision  string   `json:"revision"`
+				Revisions []string `json:"revisions"`
+				ComparedTo struct {
+					Source  applicationSource   `json:"source"`
+					Sources []applicationSource `json:"sources"`
+				} `json:"comparedTo"`
+			} `json:"sync"`
+			OperationState struct {
+				Message string `json:"message"`
+			} `json:"operationState"`
+		} `json:"status"`
+	} `json:"items"`
 }
 
 // getTotalExpectedApplications tries to determine the total number of applications that will be created
@@ -183,6 +226,83 @@ func (m *Manager) getTotalExpectedApplications(ctx context.Context, config confi
 	return 0
 }
 
+// ListApplications returns every ArgoCD application with its sync, health, revision and sync wave
+// The applications are sorted by sync wave and name. An empty kubeContext uses the current context.
+func (m *Manager) ListApplications(ctx context.Context, kubeContext string) ([]Application, error) {
+	args := []string{"-n", "argocd", "get", "applications.argoproj.io", "-o", "json"}
+	if kubeContext != "" {
+		args = append([]string{"--context", kubeContext}, args...)
+	}
+
+	result, err := m.executor.Execute(ctx, "kubectl", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ArgoCD applications: %w", err)
+	}
+
+	return parseApplicationList([]byte(result.Stdout))
+}
+
+// parseApplicationList converts kubectl JSON output into applications sorted by sync wave and name
+func parseApplicationList(data []byte) ([]Application, error) {
+	var list applicationList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse ArgoCD applications: %w", err)
+	}
+
+	apps := make([]Application, 0, len(list.Items))
+	for _, item := range list.Items {
+		revision := item.Status.Sync.Revision
+		if revision == "" && len(item.Status.Sync.Revisions) > 0 {
+			revision = item.Status.Sync.Revisions[0] // multi-source applications
+		}
+
+		// Applications without the annotation are in the default wave 0
+		syncWave, _ := strconv.Atoi(strings.TrimSpace(item.Metadata.Annotations[syncWaveAnnotation]))
+
+		apps = append(apps, Application{
+			Name:             item.Metadata.Name,
+			Health:           statusOrUnknown(item.Status.Health.Status),
+			Sync:             statusOrUnknown(item.Status.Sync.Status),
+			Revision:         revision,
+			SyncWave:         syncWave,
+			OperationMessage: strings.TrimSpace(item.Status.OperationState.Message),
+			TargetRevision:   targetRevision(item.Spec.Source, item.Spec.Sources),
+			ComparedRevision: targetRevision(item.Status.Sync.ComparedTo.Source, item.Status.Sync.ComparedTo.Sources),
+		})
+	}
+
+	sort.SliceStable(apps, func(i, j int) bool {
+		if apps[i].SyncWave != apps[j].SyncWave {
+			return apps[i].SyncWave < apps[j].SyncWave
+		}
+		return apps[i].Name < apps[j].Name
+	})
+
+	return apps, nil
+}
+
+// applicationSource is the part of an ArgoCD application source the CLI reads
+type applicationSource struct {
+	TargetRevision string `json:"targetRevision"`
+}
+
+// targetRevision returns the target revision of a single or multi-source application
+func targetRevision(source applicationSource, sources []applicationSource) string {
+	if source.TargetRevision == "" && len(sources) > 0 {
+		return sources[0].TargetRevision
+	}
+	return source.TargetRevision
+}
+
+// statusOrUnknown defaults an empty ArgoCD status to "Unknown"
+func statusOrUnknown(status string) string {
+	status = strings.TrimSpace(status)
+	if status == "" {
+		return "Unknown"
+	}
+	return status
+}
+
 // parseApplications gets ArgoCD applications and their status directly via kubectl
 func (m *Manager) parseApplications(ctx context.Context, verbose bool) ([]Application, error) {
 	// Use direct kubectl command instead of parsing JSON string to avoid control character issues
@@ -209,21 +329,11 @@ func (m *Manager) parseApplications(ctx context.Context, verbose bool) ([]Applic
 
 		parts := strings.Split(line, "\t")
 		if len(parts) >= 3 {
-			health := strings.TrimSpace(parts[1])
-			sync := strings.TrimSpace(parts[2])
-			
 			// Default empty values to "Unknown"
-			if health == "" {
-				health = "Unknown"
-			}
-			if sync == "" {
-				sync = "Unknown"
-			}
-			
 			app := Application{
 				Name:   strings.TrimSpace(parts[0]),
-				Health: health,
-				Sync:   sync,
+				Health: statusOrUnknown(parts[1]),
+				Sync:   statusOrUnknown(parts[2]),
 			}
 			
 			// Include ALL applications, even with Unknown status
@@ -182,4 +182,82 @@ func TestParseApplications(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestListApplications(t *testing.T) {
+	const applicationsJSON = `{
+		"items": [
+			{
+				"metadata": {"name": "openframe-api", "annotations": {"argocd.argoproj.io/sync-wave": "2"}},
+				"status": {
+					"health": {"status": "Degraded"},
+					"sync": {"status": "Synced", "revision": "3f2a9c1d8e7b6a5f4e3d2c1b0a9f8e7d6c5b4a39"},
+					"operationState": {"message": "one or more objects failed to apply"}
+				}
+			},
+			{
+				"metadata": {"name": "cassandra"},
+				"status": {"health": {"status": "Healthy"}, "sync": {"status": "Synced", "revisions": ["main", "v1.2.0"]}}
+			},
+			{
+				"metadata": {"name": "argocd-apps", "annotations": {"argocd.argoproj.io/sync-wave": "-1"}},
+				"status": {}
+			},
+			{
+				"metadata": {"name": "bitnami", "annotations": {"argocd.argoproj.io/sync-wave": "0"}},
+				"status": {"health": {"status": "Progressing"}, "sync": {"status": "OutOfSync"}}
+			}
+		]
+	}`
+
+	t.Run("parses and sorts applications by sync wave", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("get applications.argoproj.io -o json", &executor.CommandResult{Stdout: applicationsJSON})
+
+		apps, err := NewManager(mockExec).ListApplications(context.Background(), "k3d-dev")
+
+		assert.NoError(t, err)
+		assert.True(t, mockExec.WasCommandExecuted("kubectl --context k3d-dev -n argocd get applications.argoproj.io -o json"))
+		assert.Equal(t, []Application{
+			{Name: "argocd-apps", Health: "Unknown", Sync: "Unknown", SyncWave: -1},
+			{Name: "bitnami", Health: "Progressing", Sync: "OutOfSync"},
+			{Name: "cassandra", Health: "Healthy", Sync: "Synced", Revision: "main"},
+			{
+				Name:             "openframe-api",
+				Health:           "Degraded",
+				Sync:             "Synced",
+				Revision:         "3f2a9c1d8e7b6a5f4e3d2c1b0a9f8e7d6c5b4a39",
+				SyncWave:         2,
+				OperationMessage: "one or more objects failed to apply",
+			},
+		}, apps)
+	})
+
+	t.Run("uses current context when none is given", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("get applications.argoproj.io -o json", &executor.CommandResult{Stdout: `{"items": []}`})
+
+		apps, err := NewManager(mockExec).ListApplications(context.Background(), "")
+
+		assert.NoError(t, err)
+		assert.Empty(t, apps)
+		assert.Equal(t, "kubectl -n argocd get applications.argoproj.io -o json", mockExec.GetLastCommand())
+	})
+
+	t.Run("returns error when kubectl fails", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetShouldFail(true, "no matches for kind Application")
+
+		_, err := NewManager(mockExec).ListApplications(context.Background(), "k3d-dev")
+
+		assert.ErrorContains(t, err, "failed to get ArgoCD applications")
+	})
+
+	t.Run("returns error for invalid JSON", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("get applications.argoproj.io -o json", &executor.CommandResult{Stdout: "not json"})
+
+		_, err := NewManager(mockExec).ListApplications(context.Background(), "k3d-dev")
+
+		assert.ErrorContains(t, err, "failed to parse ArgoCD applications")
+	})
+}
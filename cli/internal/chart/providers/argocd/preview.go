@@ -0,0 +1,203 @@
+package argocd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/flamingo/openframe/internal/chart/models"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// helmReleaseAnnotation is set by Helm on the resources of a release
+	helmReleaseAnnotation = "meta.helm.sh/release-name"
+	// instanceLabel is set by ArgoCD on the resources of an application that uses label tracking
+	instanceLabel = "app.kubernetes.io/instance"
+	// trackingAnnotation is set by ArgoCD on the resources of an application that uses annotation
+	// tracking, as <application>:<group>/<kind>:<namespace>/<name>
+	trackingAnnotation = "argocd.argoproj.io/tracking-id"
+)
+
+// ApplicationSpec is the part of an ArgoCD application that decides what it deploys and where
+type ApplicationSpec struct {
+	Name           string
+	ManagedBy      string // Helm release or ArgoCD application that manages the application, if any
+	RepoURL        string
+	Path           string
+	Chart          string
+	TargetRevision string
+	Namespace      string
+}
+
+// applicationManifest mirrors the fields of an Application manifest read into ApplicationSpec
+// JSON is valid YAML, so it reads both helm template output and kubectl JSON.
+type applicationManifest struct {
+	Kind     string `yaml:"kind"`
+	Metadata struct {
+		Name        string            `yaml:"name"`
+		Labels      map[string]string `yaml:"labels"`
+		Annotations map[string]string `yaml:"annotations"`
+	} `yaml:"metadata"`
+	Spec struct {
+		Source      manifestSource   `yaml:"source"`
+		Sources     []manifestSource `yaml:"sources"`
+		Destination struct {
+			Namespace string `yaml:"namespace"`
+		} `yaml:"destination"`
+	} `yaml:"spec"`
+}
+
+// manifestSource is an ArgoCD application source
+type manifestSource struct {
+	RepoURL        string `yaml:"repoURL"`
+	Path           string `yaml:"path"`
+	Chart          string `yaml:"chart"`
+	TargetRevision string `yaml:"targetRevision"`
+}
+
+// spec converts a manifest into an ApplicationSpec, using the first source of multi-source applications
+func (a applicationManifest) spec() ApplicationSpec {
+	source := a.Spec.Source
+	if source == (manifestSource{}) && len(a.Spec.Sources) > 0 {
+		source = a.Spec.Sources[0]
+	}
+	return ApplicationSpec{
+		Name:           a.Metadata.Name,
+		ManagedBy:      a.managedBy(),
+		RepoURL:        source.RepoURL,
+		Path:           source.Path,
+		Chart:          source.Chart,
+		TargetRevision: source.TargetRevision,
+		Namespace:      a.Spec.Destination.Namespace,
+	}
+}
+
+// managedBy returns the Helm release or ArgoCD application the application belongs to
+func (a applicationManifest) managedBy() string {
+	if release := a.Metadata.Annotations[helmReleaseAnnotation]; release != "" {
+		return release
+	}
+	if tracking := a.Metadata.Annotations[trackingAnnotation]; tracking != "" {
+		application, _, _ := strings.Cut(tracking, ":")
+		return application
+	}
+	return a.Metadata.Labels[instanceLabel]
+}
+
+// ParseRenderedApplications returns the ArgoCD applications in multi-document helm template output
+func ParseRenderedApplications(manifests []byte) ([]ApplicationSpec, error) {
+	var specs []ApplicationSpec
+	decoder := yaml.NewDecoder(bytes.NewReader(manifests))
+	for {
+		var manifest applicationManifest
+		err := decoder.Decode(&manifest)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse rendered manifests: %w", err)
+		}
+		if manifest.Kind == "Application" && manifest.Metadata.Name != "" {
+			specs = append(specs, manifest.spec())
+		}
+	}
+	return specs, nil
+}
+
+// ApplicationSpecs returns the specs of the applications deployed in the cluster
+func (m *Manager) ApplicationSpecs(ctx context.Context, kubeContext string) ([]ApplicationSpec, error) {
+	args := []string{"-n", "argocd", "get", "applications.argoproj.io", "-o", "json"}
+	if kubeContext != "" {
+		args = append([]string{"--context", kubeContext}, args...)
+	}
+
+	result, err := m.executor.Execute(ctx, "kubectl", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ArgoCD applications: %w", err)
+	}
+
+	var list struct {
+		Items []applicationManifest `yaml:"items"`
+	}
+	if err := yaml.Unmarshal([]byte(result.Stdout), &list); err != nil {
+		return nil, fmt.Errorf("failed to parse ArgoCD applications: %w", err)
+	}
+
+	specs := make([]ApplicationSpec, 0, len(list.Items))
+	for _, item := range list.Items {
+		specs = append(specs, item.spec())
+	}
+	return specs, nil
+}
+
+// CompareApplications lists the applications an install creates, changes or removes
+// Deployed applications are only removed when one of the managers, a Helm release or a root
+// application, manages them; applications created outside the install are left out.
+// It also returns how many rendered applications stay the same.
+func CompareApplications(managers []string, deployed, rendered []ApplicationSpec) ([]models.ApplicationChange, int) {
+	deployedByName := make(map[string]ApplicationSpec, len(deployed))
+	for _, spec := range deployed {
+		deployedByName[spec.Name] = spec
+	}
+	renderedNames := make(map[string]bool, len(rendered))
+
+	var changes []models.ApplicationChange
+	unchanged := 0
+	for _, spec := range rendered {
+		renderedNames[spec.Name] = true
+		current, ok := deployedByName[spec.Name]
+		if !ok {
+			changes = append(changes, models.ApplicationChange{Name: spec.Name, Action: models.ApplicationCreated})
+			continue
+		}
+		if fields := specChanges(current, spec); len(fields) > 0 {
+			changes = append(changes, models.ApplicationChange{Name: spec.Name, Action: models.ApplicationChanged, Changes: fields})
+		} else {
+			unchanged++
+		}
+	}
+	for _, spec := range deployed {
+		if !renderedNames[spec.Name] && spec.ManagedBy != "" && slices.Contains(managers, spec.ManagedBy) {
+			changes = append(changes, models.ApplicationChange{Name: spec.Name, Action: models.ApplicationRemoved})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].Name < changes[j].Name })
+	return changes, unchanged
+}
+
+// specChanges describes the fields that differ between two application specs
+func specChanges(current, desired ApplicationSpec) []string {
+	fields := []struct {
+		name     string
+		old, new string
+	}{
+		{"repoURL", current.RepoURL, desired.RepoURL},
+		{"path", current.Path, desired.Path},
+		{"chart", current.Chart, desired.Chart},
+		{"targetRevision", current.TargetRevision, desired.TargetRevision},
+		{"namespace", current.Namespace, desired.Namespace},
+	}
+
+	var changes []string
+	for _, field := range fields {
+		if field.old != field.new {
+			changes = append(changes, fmt.Sprintf("%s: %s → %s", field.name, valueOrNone(field.old), valueOrNone(field.new)))
+		}
+	}
+	return changes
+}
+
+// valueOrNone shows an empty field as (none)
+func valueOrNone(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return value
+}
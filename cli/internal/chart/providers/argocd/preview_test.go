@@ -0,0 +1,140 @@
+package argocd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flamingo/openframe/internal/chart/models"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const renderedManifests = `---
+# Source: app-of-apps/templates/platform.yaml
+apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: platform
+spec:
+  source:
+    repoURL: https://github.com/test/repo.git
+    path: manifests/platform
+    targetRevision: develop
+  destination:
+    namespace: platform
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+---
+apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: redis
+spec:
+  sources:
+  - repoURL: https://charts.bitnami.com/bitnami
+    chart: redis
+    targetRevision: 19.0.0
+  destination:
+    namespace: datasources
+`
+
+func TestParseRenderedApplications(t *testing.T) {
+	specs, err := ParseRenderedApplications([]byte(renderedManifests))
+	require.NoError(t, err)
+
+	assert.Equal(t, []ApplicationSpec{
+		{Name: "platform", RepoURL: "https://github.com/test/repo.git", Path: "manifests/platform", TargetRevision: "develop", Namespace: "platform"},
+		{Name: "redis", RepoURL: "https://charts.bitnami.com/bitnami", Chart: "redis", TargetRevision: "19.0.0", Namespace: "datasources"},
+	}, specs)
+
+	specs, err = ParseRenderedApplications(nil)
+	require.NoError(t, err)
+	assert.Empty(t, specs)
+
+	_, err = ParseRenderedApplications([]byte("kind: [unclosed"))
+	assert.ErrorContains(t, err, "failed to parse rendered manifests")
+}
+
+func TestManager_ApplicationSpecs(t *testing.T) {
+	mockExec := executor.NewMockCommandExecutor()
+	mockExec.SetResponse("get applications.argoproj.io -o json", &executor.CommandResult{Stdout: `{"items": [
+  {"metadata": {"name": "platform", "annotations": {"meta.helm.sh/release-name": "app-of-apps"}},
+   "spec": {"source": {"repoURL": "https://github.com/test/repo.git", "path": "manifests/platform", "targetRevision": "main"},
+            "destination": {"namespace": "platform"}}}
+]}`})
+
+	specs, err := NewManager(mockExec).ApplicationSpecs(context.Background(), "k3d-dev")
+	require.NoError(t, err)
+	assert.Equal(t, []ApplicationSpec{
+		{Name: "platform", ManagedBy: "app-of-apps", RepoURL: "https://github.com/test/repo.git", Path: "manifests/platform", TargetRevision: "main", Namespace: "platform"},
+	}, specs)
+	assert.True(t, mockExec.WasCommandExecuted("kubectl --context k3d-dev -n argocd get"))
+}
+
+func TestApplicationManifest_ManagedBy(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		expected string
+	}{
+		{"helm release", `metadata: {annotations: {meta.helm.sh/release-name: app-of-apps}}`, "app-of-apps"},
+		{"annotation tracking", `metadata: {annotations: {argocd.argoproj.io/tracking-id: "argocd-apps:argoproj.io/Application:argocd/platform"}}`, "argocd-apps"},
+		{"label tracking", `metadata: {labels: {app.kubernetes.io/instance: argocd-apps}}`, "argocd-apps"},
+		{"unmanaged", `metadata: {name: mongodb}`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var manifest applicationManifest
+			require.NoError(t, yaml.Unmarshal([]byte(tt.manifest), &manifest))
+			assert.Equal(t, tt.expected, manifest.managedBy())
+		})
+	}
+}
+
+func TestCompareApplications(t *testing.T) {
+	deployed := []ApplicationSpec{
+		{Name: "platform", ManagedBy: "app-of-apps", RepoURL: "https://github.com/test/repo.git", Path: "manifests/platform", TargetRevision: "main", Namespace: "platform"},
+		{Name: "kafka", ManagedBy: "app-of-apps", TargetRevision: "main"},
+		{Name: "mongodb", TargetRevision: "main"},
+		{Name: "redis", ManagedBy: "app-of-apps", RepoURL: "https://charts.bitnami.com/bitnami", Chart: "redis", TargetRevision: "19.0.0", Namespace: "datasources"},
+		{Name: "kafka-ui", ManagedBy: "argocd-apps", TargetRevision: "main"},
+	}
+	rendered, err := ParseRenderedApplications([]byte(renderedManifests + `---
+apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: api
+spec:
+  source:
+    path: manifests/api
+`))
+	require.NoError(t, err)
+
+	changes, unchanged := CompareApplications([]string{"app-of-apps", "argocd-apps"}, deployed, rendered)
+	assert.Equal(t, []models.ApplicationChange{
+		{Name: "api", Action: models.ApplicationCreated},
+		{Name: "kafka", Action: models.ApplicationRemoved},
+		{Name: "kafka-ui", Action: models.ApplicationRemoved},
+		{Name: "platform", Action: models.ApplicationChanged, Changes: []string{"targetRevision: main → develop"}},
+	}, changes, "mongodb is not managed by the install, so it is not removed")
+	assert.Equal(t, 1, unchanged)
+
+	changes, unchanged = CompareApplications([]string{"app-of-apps"}, nil, nil)
+	assert.Empty(t, changes)
+	assert.Zero(t, unchanged)
+}
+
+func TestSpecChanges(t *testing.T) {
+	current := ApplicationSpec{RepoURL: "https://github.com/test/repo.git", Path: "a"}
+	desired := ApplicationSpec{RepoURL: "git://git-server/repository.git", Namespace: "platform", Path: "a"}
+
+	assert.Equal(t, []string{
+		"repoURL: https://github.com/test/repo.git → git://git-server/repository.git",
+		"namespace: (none) → platform",
+	}, specChanges(current, desired))
+}
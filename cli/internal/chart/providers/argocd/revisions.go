@@ -0,0 +1,107 @@
+package argocd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flamingo/openframe/internal/chart/models"
+	"github.com/pterm/pterm"
+)
+
+// revisionPollInterval is how often WaitForRevisions lists the applications
+var revisionPollInterval = 5 * time.Second
+
+// TargetRevisions returns the target revision of every application by name
+func (m *Manager) TargetRevisions(ctx context.Context, kubeContext string) (map[string]string, error) {
+	apps, err := m.ListApplications(ctx, kubeContext)
+	if err != nil {
+		return nil, err
+	}
+	revisions := make(map[string]string, len(apps))
+	for _, app := range apps {
+		revisions[app.Name] = app.TargetRevision
+	}
+	return revisions, nil
+}
+
+// ChangedApplications returns the applications that are new or whose target revision differs from before
+func ChangedApplications(before map[string]string, apps []Application) []Application {
+	var changed []Application
+	for _, app := range apps {
+		if revision, ok := before[app.Name]; !ok || revision != app.TargetRevision {
+			changed = append(changed, app)
+		}
+	}
+	return changed
+}
+
+// IsAtTargetRevision reports whether ArgoCD has synced an application to its target revision and it is healthy
+func (a Application) IsAtTargetRevision() bool {
+	return a.Sync == "Synced" && a.Health == "Healthy" && a.ComparedRevision == a.TargetRevision
+}
+
+// WaitForRevisions waits until every application whose target revision changed since before
+// is synced to its new revision and healthy. Applications that kept their revision are not waited for.
+// A root app-of-apps application is waited for as well, since it renders the changed applications.
+// It returns the names of the applications that changed.
+func (m *Manager) WaitForRevisions(ctx context.Context, kubeContext string, before map[string]string, timeout time.Duration) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	spinner, _ := pterm.DefaultSpinner.Start("Waiting for ArgoCD to pick up the new revisions...")
+	ticker := time.NewTicker(revisionPollInterval)
+	defer ticker.Stop()
+
+	var changed []Application
+	for {
+		apps, err := m.ListApplications(ctx, kubeContext)
+		if err == nil {
+			changed = ChangedApplications(before, apps)
+			pending := pendingApplications(changed, apps)
+			if len(pending) == 0 {
+				spinner.Success(fmt.Sprintf("%d applications synced to their new revision", len(changed)))
+				return applicationNames(changed), nil
+			}
+			spinner.UpdateText(fmt.Sprintf("Waiting for %d of %d changed applications: %s",
+				len(pending), len(changed), strings.Join(pending, ", ")))
+		}
+
+		select {
+		case <-ctx.Done():
+			spinner.Fail("Applications did not sync to their new revision")
+			if ctx.Err() == context.DeadlineExceeded {
+				return applicationNames(changed), fmt.Errorf("applications were not synced within %s", timeout)
+			}
+			return applicationNames(changed), ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// pendingApplications returns the names of the changed applications, and of the root application,
+// that are not yet synced to their target revision
+func pendingApplications(changed, apps []Application) []string {
+	var pending []string
+	for _, app := range apps {
+		if app.Name == models.RootApplication && !app.IsAtTargetRevision() {
+			pending = append(pending, app.Name)
+		}
+	}
+	for _, app := range changed {
+		if app.Name != models.RootApplication && !app.IsAtTargetRevision() {
+			pending = append(pending, app.Name)
+		}
+	}
+	return pending
+}
+
+// applicationNames returns the names of applications
+func applicationNames(apps []Application) []string {
+	names := make([]string, 0, len(apps))
+	for _, app := range apps {
+		names = append(names, app.Name)
+	}
+	return names
+}
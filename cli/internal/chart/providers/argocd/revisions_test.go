@@ -0,0 +1,91 @@
+package argocd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const revisionsJSON = `{"items": [
+  {"metadata": {"name": "platform"},
+   "spec": {"source": {"targetRevision": "develop"}},
+   "status": {"health": {"status": "Healthy"}, "sync": {"status": "Synced", "comparedTo": {"source": {"targetRevision": "develop"}}}}},
+  {"metadata": {"name": "kafka"},
+   "spec": {"sources": [{"targetRevision": "develop"}]},
+   "status": {"health": {"status": "Healthy"}, "sync": {"status": "Synced", "comparedTo": {"sources": [{"targetRevision": "main"}]}}}},
+  {"metadata": {"name": "redis"},
+   "spec": {"source": {"targetRevision": "7.2.0"}},
+   "status": {"health": {"status": "Healthy"}, "sync": {"status": "Synced", "comparedTo": {"source": {"targetRevision": "7.2.0"}}}}}
+]}`
+
+func TestParseApplicationListRevisions(t *testing.T) {
+	apps, err := parseApplicationList([]byte(revisionsJSON))
+	require.NoError(t, err)
+	require.Len(t, apps, 3)
+
+	assert.Equal(t, "kafka", apps[0].Name)
+	assert.Equal(t, "develop", apps[0].TargetRevision, "multi-source applications use their first source")
+	assert.Equal(t, "main", apps[0].ComparedRevision)
+	assert.False(t, apps[0].IsAtTargetRevision())
+	assert.True(t, apps[1].IsAtTargetRevision())
+}
+
+func TestChangedApplications(t *testing.T) {
+	apps := []Application{
+		{Name: "platform", TargetRevision: "develop"},
+		{Name: "redis", TargetRevision: "7.2.0"},
+		{Name: "kafka", TargetRevision: "develop"},
+	}
+	before := map[string]string{"platform": "main", "redis": "7.2.0"}
+
+	changed := ChangedApplications(before, apps)
+	assert.Equal(t, []string{"platform", "kafka"}, applicationNames(changed), "changed and new applications")
+}
+
+func TestManager_WaitForRevisions(t *testing.T) {
+	defer func(interval time.Duration) { revisionPollInterval = interval }(revisionPollInterval)
+	revisionPollInterval = time.Millisecond
+
+	before := map[string]string{"platform": "main", "kafka": "main", "redis": "7.2.0"}
+
+	t.Run("waits for the changed applications only", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		synced := `{"items": [
+  {"metadata": {"name": "platform"}, "spec": {"source": {"targetRevision": "develop"}},
+   "status": {"health": {"status": "Healthy"}, "sync": {"status": "Synced", "comparedTo": {"source": {"targetRevision": "develop"}}}}},
+  {"metadata": {"name": "redis"}, "spec": {"source": {"targetRevision": "7.2.0"}},
+   "status": {"health": {"status": "Degraded"}, "sync": {"status": "OutOfSync"}}}
+]}`
+		mockExec.SetResponse("get applications.argoproj.io", &executor.CommandResult{Stdout: synced})
+
+		changed, err := NewManager(mockExec).WaitForRevisions(context.Background(), "k3d-dev", before, time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"platform"}, changed, "redis kept its revision, so its health does not matter")
+		assert.True(t, mockExec.WasCommandExecuted("kubectl --context k3d-dev"))
+	})
+
+	t.Run("times out on an application that does not catch up", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("get applications.argoproj.io", &executor.CommandResult{Stdout: revisionsJSON})
+
+		changed, err := NewManager(mockExec).WaitForRevisions(context.Background(), "", before, 50*time.Millisecond)
+		assert.ErrorContains(t, err, "applications were not synced within 50ms")
+		assert.Equal(t, []string{"kafka", "platform"}, changed)
+		assert.Greater(t, mockExec.GetCommandCount(), 1, "the applications are polled")
+	})
+
+	t.Run("root application is waited for", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("get applications.argoproj.io", &executor.CommandResult{Stdout: `{"items": [
+  {"metadata": {"name": "argocd-apps"}, "spec": {"source": {"targetRevision": "develop"}},
+   "status": {"health": {"status": "Healthy"}, "sync": {"status": "OutOfSync", "comparedTo": {"source": {"targetRevision": "main"}}}}}
+]}`})
+
+		_, err := NewManager(mockExec).WaitForRevisions(context.Background(), "", map[string]string{"argocd-apps": "develop"}, 20*time.Millisecond)
+		assert.Error(t, err, "the root application renders the others, so an unsynced root is pending")
+	})
+}
@@ -0,0 +1,114 @@
+package argocd
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CRDs are the custom resource definitions installed by the ArgoCD chart
+// The chart keeps them on uninstall, so they are removed separately.
+var CRDs = []string{
+	"applications.argoproj.io",
+	"applicationsets.argoproj.io",
+	"appprojects.argoproj.io",
+}
+
+const (
+	// resourcesFinalizer makes ArgoCD delete the resources of an application before the application itself
+	resourcesFinalizer = "resources-finalizer.argocd.argoproj.io"
+	// keepDeleteOption tells ArgoCD to leave a resource in place when its application is deleted
+	keepDeleteOption = "argocd.argoproj.io/sync-options=Delete=false"
+)
+
+// detachPatch makes the deletion of an application cascade to the resources it manages
+var detachPatch = fmt.Sprintf(`{"metadata":{"finalizers":[%q]}}`, resourcesFinalizer)
+
+// kubectl runs kubectl against a kubeconfig context, the current one when kubeContext is empty
+func (m *Manager) kubectl(ctx context.Context, kubeContext string, args ...string) error {
+	if kubeContext != "" {
+		args = append([]string{"--context", kubeContext}, args...)
+	}
+	_, err := m.executor.Execute(ctx, "kubectl", args...)
+	return err
+}
+
+// HasApplicationCRD reports whether the ArgoCD Application CRD is installed
+func (m *Manager) HasApplicationCRD(ctx context.Context, kubeContext string) bool {
+	return m.kubectl(ctx, kubeContext, "get", "crd", CRDs[0]) == nil
+}
+
+// DetachApplication prepares an application that outlived its root application to be pruned
+// Deleting it later removes everything it deployed, not only the application itself.
+func (m *Manager) DetachApplication(ctx context.Context, kubeContext, name string) error {
+	if err := m.kubectl(ctx, kubeContext, "-n", "argocd", "patch", "applications.argoproj.io", name,
+		"--type", "merge", "-p", detachPatch); err != nil {
+		return fmt.Errorf("failed to detach application %s: %w", name, err)
+	}
+	return nil
+}
+
+// OrphanApplication deletes an application without pruning what it deployed
+func (m *Manager) OrphanApplication(ctx context.Context, kubeContext, name string) error {
+	if err := m.kubectl(ctx, kubeContext, "-n", "argocd", "patch", "applications.argoproj.io", name,
+		"--type", "merge", "-p", `{"metadata":{"finalizers":null}}`); err != nil {
+		return fmt.Errorf("failed to orphan application %s: %w", name, err)
+	}
+	if err := m.kubectl(ctx, kubeContext, "-n", "argocd", "delete", "applications.argoproj.io", name, "--ignore-not-found"); err != nil {
+		return fmt.Errorf("failed to delete application %s: %w", name, err)
+	}
+	return nil
+}
+
+// DeleteApplications deletes applications and waits until ArgoCD has pruned their resources
+func (m *Manager) DeleteApplications(ctx context.Context, kubeContext string, names []string, timeout time.Duration) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	deleteArgs := append([]string{"-n", "argocd", "delete", "applications.argoproj.io"}, names...)
+	deleteArgs = append(deleteArgs, "--ignore-not-found", "--wait=false")
+	if err := m.kubectl(ctx, kubeContext, deleteArgs...); err != nil {
+		return fmt.Errorf("failed to delete applications: %w", err)
+	}
+
+	waitArgs := []string{"-n", "argocd", "wait", "--for=delete", fmt.Sprintf("--timeout=%s", timeout)}
+	for _, name := range names {
+		waitArgs = append(waitArgs, "applications.argoproj.io/"+name)
+	}
+	if err := m.kubectl(ctx, kubeContext, waitArgs...); err != nil {
+		return fmt.Errorf("applications were not pruned within %s: %w", timeout, err)
+	}
+	return nil
+}
+
+// ProtectVolumeClaims stops ArgoCD from deleting the persistent volume claims of a namespace
+// together with their application
+func (m *Manager) ProtectVolumeClaims(ctx context.Context, kubeContext, namespace string) error {
+	if err := m.kubectl(ctx, kubeContext, "-n", namespace, "annotate", "pvc", "--all", keepDeleteOption, "--overwrite"); err != nil {
+		return fmt.Errorf("failed to protect volume claims in namespace %s: %w", namespace, err)
+	}
+	return nil
+}
+
+// DeleteCRDs removes the ArgoCD custom resource definitions
+func (m *Manager) DeleteCRDs(ctx context.Context, kubeContext string) error {
+	args := append([]string{"delete", "crd"}, CRDs...)
+	if err := m.kubectl(ctx, kubeContext, append(args, "--ignore-not-found")...); err != nil {
+		return fmt.Errorf("failed to delete ArgoCD CRDs: %w", err)
+	}
+	return nil
+}
+
+// DeleteNamespaces removes namespaces and waits until they are gone
+func (m *Manager) DeleteNamespaces(ctx context.Context, kubeContext string, namespaces []string, timeout time.Duration) error {
+	if len(namespaces) == 0 {
+		return nil
+	}
+	args := append([]string{"delete", "namespace"}, namespaces...)
+	args = append(args, "--ignore-not-found", "--wait", fmt.Sprintf("--timeout=%s", timeout))
+	if err := m.kubectl(ctx, kubeContext, args...); err != nil {
+		return fmt.Errorf("failed to delete namespaces: %w", err)
+	}
+	return nil
+}
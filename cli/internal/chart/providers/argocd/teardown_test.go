@@ -0,0 +1,87 @@
+package argocd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flamingo/openframe/internal/chart/models"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager_HasApplicationCRD(t *testing.T) {
+	mockExec := executor.NewMockCommandExecutor()
+	assert.True(t, NewManager(mockExec).HasApplicationCRD(context.Background(), "k3d-dev"))
+	assert.True(t, mockExec.WasCommandExecuted("kubectl --context k3d-dev get crd applications.argoproj.io"))
+
+	mockExec.SetShouldFail(true, "not found")
+	assert.False(t, NewManager(mockExec).HasApplicationCRD(context.Background(), ""))
+}
+
+func TestManager_DetachApplication(t *testing.T) {
+	mockExec := executor.NewMockCommandExecutor()
+	require.NoError(t, NewManager(mockExec).DetachApplication(context.Background(), "", "kafka"))
+
+	command := mockExec.GetLastCommand()
+	assert.Equal(t, `kubectl -n argocd patch applications.argoproj.io kafka --type merge -p {"metadata":{"finalizers":["resources-finalizer.argocd.argoproj.io"]}}`, command)
+	assert.NotContains(t, command, "helm.sh/resource-policy", "helm decides what to keep from the release manifest")
+
+	mockExec.SetShouldFail(true, "forbidden")
+	assert.ErrorContains(t, NewManager(mockExec).DetachApplication(context.Background(), "", "kafka"), "failed to detach application kafka")
+}
+
+func TestManager_OrphanApplication(t *testing.T) {
+	mockExec := executor.NewMockCommandExecutor()
+	require.NoError(t, NewManager(mockExec).OrphanApplication(context.Background(), "", models.RootApplication))
+
+	commands := mockExec.GetExecutedCommands()
+	require.Len(t, commands, 2)
+	assert.Equal(t, `kubectl -n argocd patch applications.argoproj.io argocd-apps --type merge -p {"metadata":{"finalizers":null}}`, commands[0])
+	assert.Equal(t, "kubectl -n argocd delete applications.argoproj.io argocd-apps --ignore-not-found", commands[1])
+}
+
+func TestManager_DeleteApplications(t *testing.T) {
+	t.Run("deletes and waits", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		err := NewManager(mockExec).DeleteApplications(context.Background(), "k3d-dev", []string{"kafka", "mongodb"}, 5*time.Minute)
+		require.NoError(t, err)
+
+		commands := mockExec.GetExecutedCommands()
+		require.Len(t, commands, 2)
+		assert.Equal(t, "kubectl --context k3d-dev -n argocd delete applications.argoproj.io kafka mongodb --ignore-not-found --wait=false", commands[0])
+		assert.Equal(t, "kubectl --context k3d-dev -n argocd wait --for=delete --timeout=5m0s applications.argoproj.io/kafka applications.argoproj.io/mongodb", commands[1])
+	})
+
+	t.Run("nothing to delete", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		require.NoError(t, NewManager(mockExec).DeleteApplications(context.Background(), "", nil, time.Minute))
+		assert.Equal(t, 0, mockExec.GetCommandCount())
+	})
+
+	t.Run("prune timeout", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("wait --for=delete", &executor.CommandResult{ExitCode: 1, Stderr: "timed out waiting for the condition"})
+
+		err := NewManager(mockExec).DeleteApplications(context.Background(), "", []string{"kafka"}, time.Minute)
+		assert.ErrorContains(t, err, "applications were not pruned within 1m0s")
+	})
+}
+
+func TestManager_ProtectVolumeClaims(t *testing.T) {
+	mockExec := executor.NewMockCommandExecutor()
+	require.NoError(t, NewManager(mockExec).ProtectVolumeClaims(context.Background(), "", "datasources"))
+	assert.Equal(t, "kubectl -n datasources annotate pvc --all argocd.argoproj.io/sync-options=Delete=false --overwrite", mockExec.GetLastCommand())
+}
+
+func TestManager_DeleteCRDsAndNamespaces(t *testing.T) {
+	mockExec := executor.NewMockCommandExecutor()
+	manager := NewManager(mockExec)
+
+	require.NoError(t, manager.DeleteCRDs(context.Background(), ""))
+	assert.Equal(t, "kubectl delete crd applications.argoproj.io applicationsets.argoproj.io appprojects.argoproj.io --ignore-not-found", mockExec.GetLastCommand())
+
+	require.NoError(t, manager.DeleteNamespaces(context.Background(), "", []string{"platform", "argocd"}, 10*time.Minute))
+	assert.Equal(t, "kubectl delete namespace platform argocd --ignore-not-found --wait --timeout=10m0s", mockExec.GetLastCommand())
+}
@@ -2,16 +2,29 @@ package helm
 
 import (
 	"context"
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/flamingo/openframe/internal/chart/models"
 	"github.com/flamingo/openframe/internal/chart/providers/argocd"
 	"github.com/flamingo/openframe/internal/chart/utils/config"
 	"github.com/flamingo/openframe/internal/chart/utils/errors"
 	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/files"
 	"github.com/pterm/pterm"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ArgoCDChartVersion is the version of the argo/argo-cd chart that is installed
+	ArgoCDChartVersion = "8.1.4"
+	// ArgoCDRepositoryURL is the Helm repository the ArgoCD chart is installed from
+	ArgoCDRepositoryURL = "https://argoproj.github.io/argo-helm"
 )
 
 // HelmManager handles Helm operations
@@ -59,16 +72,19 @@ func (h *HelmManager) IsChartInstalled(ctx context.Context, releaseName, namespa
 
 // InstallArgoCD installs ArgoCD using Helm with exact commands specified
 func (h *HelmManager) InstallArgoCD(ctx context.Context, config config.ChartInstallConfig) error {
-	// Add ArgoCD Helm repository
-	_, err := h.executor.Execute(ctx, "helm", "repo", "add", "argo", "https://argoproj.github.io/argo-helm")
-	if err != nil {
-		return fmt.Errorf("failed to add ArgoCD repository: %w", err)
-	}
+	// A local chart archive needs no repository
+	if config.ArgoCDChart == "" {
+		// Add ArgoCD Helm repository
+		_, err := h.executor.Execute(ctx, "helm", "repo", "add", "argo", ArgoCDRepositoryURL)
+		if err != nil {
+			return fmt.Errorf("failed to add ArgoCD repository: %w", err)
+		}
 
-	// Update repositories
-	_, err = h.executor.Execute(ctx, "helm", "repo", "update")
-	if err != nil {
-		return fmt.Errorf("failed to update Helm repositories: %w", err)
+		// Update repositories
+		_, err = h.executor.Execute(ctx, "helm", "repo", "update")
+		if err != nil {
+			return fmt.Errorf("failed to update Helm repositories: %w", err)
+		}
 	}
 
 	// Create a temporary file with ArgoCD values
@@ -85,15 +101,14 @@ func (h *HelmManager) InstallArgoCD(ctx context.Context, config config.ChartInst
 	tmpFile.Close()
 
 	// Install ArgoCD with upgrade --install
-	args := []string{
-		"upgrade", "--install", "argo-cd", "argo/argo-cd",
-		"--version=8.1.4",
+	args := append([]string{"upgrade", "--install", "argo-cd"}, argoCDChartArgs(config)...)
+	args = append(args,
 		"--namespace", "argocd",
 		"--create-namespace",
 		"--wait",
 		"--timeout", "5m",
 		"-f", tmpFile.Name(),
-	}
+	)
 
 	if config.DryRun {
 		args = append(args, "--dry-run")
@@ -121,21 +136,24 @@ func (h *HelmManager) InstallArgoCDWithProgress(ctx context.Context, config conf
 	// Show progress for each step
 	spinner, _ := pterm.DefaultSpinner.Start("Installing ArgoCD...")
 
-	// Add ArgoCD repository silently
-	_, err := h.executor.Execute(ctx, "helm", "repo", "add", "argo", "https://argoproj.github.io/argo-helm")
-	if err != nil {
-		// Ignore if already exists
-		if !strings.Contains(err.Error(), "already exists") {
-			spinner.Stop()
-			return fmt.Errorf("failed to add ArgoCD repository: %w", err)
+	// A local chart archive needs no repository
+	if config.ArgoCDChart == "" {
+		// Add ArgoCD repository silently
+		_, err := h.executor.Execute(ctx, "helm", "repo", "add", "argo", ArgoCDRepositoryURL)
+		if err != nil {
+			// Ignore if already exists
+			if !strings.Contains(err.Error(), "already exists") {
+				spinner.Stop()
+				return fmt.Errorf("failed to add ArgoCD repository: %w", err)
+			}
 		}
-	}
 
-	// Update repositories silently
-	_, err = h.executor.Execute(ctx, "helm", "repo", "update")
-	if err != nil {
-		spinner.Stop()
-		return fmt.Errorf("failed to update Helm repositories: %w", err)
+		// Update repositories silently
+		_, err = h.executor.Execute(ctx, "helm", "repo", "update")
+		if err != nil {
+			spinner.Stop()
+			return fmt.Errorf("failed to update Helm repositories: %w", err)
+		}
 	}
 
 	// Create a temporary file with ArgoCD values
@@ -155,21 +173,20 @@ func (h *HelmManager) InstallArgoCDWithProgress(ctx context.Context, config conf
 
 	// Installation details are now silent - just show in verbose mode
 	if config.Verbose {
-		pterm.Info.Printf("   Version: 8.1.4\n")
+		pterm.Info.Printf("   Version: %s\n", ArgoCDChartVersion)
 		pterm.Info.Printf("   Namespace: argocd\n")
 		pterm.Info.Printf("   Values file: %s\n", tmpFile.Name())
 	}
 
 	// Install ArgoCD with upgrade --install
-	args := []string{
-		"upgrade", "--install", "argo-cd", "argo/argo-cd",
-		"--version=8.1.4",
+	args := append([]string{"upgrade", "--install", "argo-cd"}, argoCDChartArgs(config)...)
+	args = append(args,
 		"--namespace", "argocd",
 		"--create-namespace",
 		"--wait",
 		"--timeout", "5m",
 		"-f", tmpFile.Name(),
-	}
+	)
 
 	if config.DryRun {
 		args = append(args, "--dry-run")
@@ -204,6 +221,15 @@ func (h *HelmManager) InstallArgoCDWithProgress(ctx context.Context, config conf
 	return nil
 }
 
+// argoCDChartArgs returns the chart reference of ArgoCD, either the pinned repository chart
+// or the local archive of an offline bundle, which already is the pinned version
+func argoCDChartArgs(config config.ChartInstallConfig) []string {
+	if config.ArgoCDChart != "" {
+		return []string{config.ArgoCDChart}
+	}
+	return []string{"argo/argo-cd", "--version=" + ArgoCDChartVersion}
+}
+
 // InstallAppOfAppsFromLocal installs the app-of-apps chart from a local path
 func (h *HelmManager) InstallAppOfAppsFromLocal(ctx context.Context, config config.ChartInstallConfig, certFile, keyFile string) error {
 	// Validate configuration
@@ -222,10 +248,8 @@ func (h *HelmManager) InstallAppOfAppsFromLocal(ctx context.Context, config conf
 		"--namespace", appConfig.Namespace,
 		"--wait",
 		"--timeout", appConfig.Timeout,
-		"-f", appConfig.ValuesFile,
-		"--set-file", fmt.Sprintf("deployment.oss.ingress.localhost.tls.cert=%s", certFile),
-		"--set-file", fmt.Sprintf("deployment.oss.ingress.localhost.tls.key=%s", keyFile),
 	}
+	args = append(args, appOfAppsValueArgs(appConfig, certFile, keyFile)...)
 
 	if config.DryRun {
 		args = append(args, "--dry-run")
@@ -250,21 +274,199 @@ func (h *HelmManager) InstallAppOfAppsFromLocal(ctx context.Context, config conf
 	return nil
 }
 
-// GetChartStatus returns the status of a chart
+// appOfAppsValueArgs returns the helm arguments that set the app-of-apps values
+// The certificates are skipped when no files are given.
+func appOfAppsValueArgs(appConfig *models.AppOfAppsConfig, certFile, keyFile string) []string {
+	args := []string{"-f", appConfig.ValuesFile}
+	if certFile != "" && keyFile != "" {
+		args = append(args,
+			"--set-file", fmt.Sprintf("deployment.oss.ingress.localhost.tls.cert=%s", certFile),
+			"--set-file", fmt.Sprintf("deployment.oss.ingress.localhost.tls.key=%s", keyFile),
+		)
+	}
+
+	// Applications of an offline install are fetched from the in-cluster git server
+	if appConfig.ClusterRepoURL != "" {
+		args = append(args,
+			"--set", "global.repoURL="+appConfig.ClusterRepoURL,
+			"--set", "global.repoBranch="+appConfig.GitHubBranch,
+		)
+	}
+	return args
+}
+
+// TemplateAppOfApps renders the app-of-apps chart at appConfig.ChartPath without touching the cluster
+func (h *HelmManager) TemplateAppOfApps(ctx context.Context, appConfig *models.AppOfAppsConfig, certFile, keyFile string) (string, error) {
+	args := []string{"template", "app-of-apps", appConfig.ChartPath, "--namespace", appConfig.Namespace}
+	args = append(args, appOfAppsValueArgs(appConfig, certFile, keyFile)...)
+
+	result, err := h.executor.Execute(ctx, "helm", args...)
+	if err != nil {
+		if result != nil && result.Stderr != "" {
+			return "", fmt.Errorf("failed to render app-of-apps: %w\nHelm output: %s", err, result.Stderr)
+		}
+		return "", fmt.Errorf("failed to render app-of-apps: %w", err)
+	}
+	return result.Stdout, nil
+}
+
+// TemplateApps renders the chart the root application deploys from appsPath, with the values the root
+// application passes on: the app-of-apps chart defaults at appConfig.ChartPath overridden by its values
+func (h *HelmManager) TemplateApps(ctx context.Context, appConfig *models.AppOfAppsConfig, appsPath, certFile, keyFile string) (string, error) {
+	args := []string{"template", models.RootApplication, appsPath, "--namespace", appConfig.Namespace}
+	if defaults := filepath.Join(appConfig.ChartPath, "values.yaml"); files.Exists(defaults) {
+		args = append(args, "-f", defaults)
+	}
+	args = append(args, appOfAppsValueArgs(appConfig, certFile, keyFile)...)
+
+	result, err := h.executor.Execute(ctx, "helm", args...)
+	if err != nil {
+		if result != nil && result.Stderr != "" {
+			return "", fmt.Errorf("failed to render %s: %w\nHelm output: %s", models.RootApplication, err, result.Stderr)
+		}
+		return "", fmt.Errorf("failed to render %s: %w", models.RootApplication, err)
+	}
+	return result.Stdout, nil
+}
+
+// GetChartStatus returns the status of a chart in the current kubeconfig context
 func (h *HelmManager) GetChartStatus(ctx context.Context, releaseName, namespace string) (models.ChartInfo, error) {
+	return h.GetChartStatusInContext(ctx, "", releaseName, namespace)
+}
+
+// GetChartStatusInContext returns the status of a chart in the given kubeconfig context
+// An empty kubeContext uses the current context. A missing release returns errors.ErrChartNotFound.
+func (h *HelmManager) GetChartStatusInContext(ctx context.Context, kubeContext, releaseName, namespace string) (models.ChartInfo, error) {
 	args := []string{"status", releaseName, "-n", namespace, "--output", "json"}
+	if kubeContext != "" {
+		args = append(args, "--kube-context", kubeContext)
+	}
 
-	_, err := h.executor.Execute(ctx, "helm", args...)
+	result, err := h.executor.Execute(ctx, "helm", args...)
 	if err != nil {
+		if result != nil && strings.Contains(result.Stderr, "not found") {
+			return models.ChartInfo{}, fmt.Errorf("release %s in namespace %s: %w", releaseName, namespace, errors.ErrChartNotFound)
+		}
 		return models.ChartInfo{}, fmt.Errorf("failed to get chart status: %w", err)
 	}
 
-	// Parse JSON output and return chart info
-	// For now, return basic info
+	return parseReleaseStatus([]byte(result.Stdout))
+}
+
+// helmRelease is the part of `helm status --output json` that is reported
+type helmRelease struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Version   int    `json:"version"` // Release revision
+	Info      struct {
+		Status       string    `json:"status"`
+		LastDeployed time.Time `json:"last_deployed"`
+		Description  string    `json:"description"`
+		Notes        string    `json:"notes"`
+	} `json:"info"`
+	Chart struct {
+		Metadata struct {
+			Version    string `json:"version"`
+			AppVersion string `json:"appVersion"`
+		} `json:"metadata"`
+	} `json:"chart"`
+}
+
+// parseReleaseStatus converts `helm status --output json` into chart info
+func parseReleaseStatus(data []byte) (models.ChartInfo, error) {
+	var release helmRelease
+	if err := json.Unmarshal(data, &release); err != nil {
+		return models.ChartInfo{}, fmt.Errorf("failed to parse helm status: %w", err)
+	}
+
 	return models.ChartInfo{
-		Name:      releaseName,
-		Namespace: namespace,
-		Status:    "deployed", // Parse from JSON
-		Version:   "1.0.0",    // Parse from JSON
+		Name:         release.Name,
+		Namespace:    release.Namespace,
+		Status:       release.Info.Status,
+		Version:      release.Chart.Metadata.Version,
+		AppVersion:   release.Chart.Metadata.AppVersion,
+		Revision:     release.Version,
+		LastDeployed: release.Info.LastDeployed,
+		Description:  release.Info.Description,
+		Notes:        strings.TrimSpace(release.Info.Notes),
 	}, nil
 }
+
+// UninstallRelease removes a release and waits for its resources to be deleted
+// An empty kubeContext uses the current context. A missing release is not an error.
+func (h *HelmManager) UninstallRelease(ctx context.Context, kubeContext, releaseName, namespace string) error {
+	if _, err := h.GetChartStatusInContext(ctx, kubeContext, releaseName, namespace); err != nil {
+		if stderrors.Is(err, errors.ErrChartNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	args := []string{"uninstall", releaseName, "--namespace", namespace, "--wait", "--timeout", "10m"}
+	if kubeContext != "" {
+		args = append(args, "--kube-context", kubeContext)
+	}
+
+	result, err := h.executor.Execute(ctx, "helm", args...)
+	if err != nil {
+		if result != nil && result.Stderr != "" {
+			return fmt.Errorf("failed to uninstall %s: %w\nHelm output: %s", releaseName, err, result.Stderr)
+		}
+		return fmt.Errorf("failed to uninstall %s: %w", releaseName, err)
+	}
+	return nil
+}
+
+// GetReleaseValues returns the user-supplied values of a release
+// An empty kubeContext uses the current context. A missing release returns errors.ErrChartNotFound.
+func (h *HelmManager) GetReleaseValues(ctx context.Context, kubeContext, releaseName, namespace string) (map[string]interface{}, error) {
+	args := []string{"get", "values", releaseName, "-n", namespace, "--output", "yaml"}
+	if kubeContext != "" {
+		args = append(args, "--kube-context", kubeContext)
+	}
+
+	result, err := h.executor.Execute(ctx, "helm", args...)
+	if err != nil {
+		if result != nil && strings.Contains(result.Stderr, "not found") {
+			return nil, fmt.Errorf("release %s in namespace %s: %w", releaseName, namespace, errors.ErrChartNotFound)
+		}
+		return nil, fmt.Errorf("failed to get values of %s: %w", releaseName, err)
+	}
+
+	var values map[string]interface{}
+	if err := yaml.Unmarshal([]byte(result.Stdout), &values); err != nil {
+		return nil, fmt.Errorf("failed to parse values of %s: %w", releaseName, err)
+	}
+	// A release without user-supplied values prints null
+	if values == nil {
+		values = make(map[string]interface{})
+	}
+	return values, nil
+}
+
+// UpgradeAppOfApps upgrades the app-of-apps release in place with a complete values file
+// Unlike InstallAppOfAppsFromLocal it passes no certificates, since the values already carry them.
+func (h *HelmManager) UpgradeAppOfApps(ctx context.Context, kubeContext, chartPath, valuesFile, timeout string) error {
+	args := []string{
+		"upgrade", "app-of-apps", chartPath,
+		"--namespace", "argocd",
+		"--wait",
+		"--timeout", timeout,
+		"-f", valuesFile,
+	}
+	if kubeContext != "" {
+		args = append(args, "--kube-context", kubeContext)
+	}
+
+	result, err := h.executor.Execute(ctx, "helm", args...)
+	if err != nil {
+		if ctx.Err() == context.Canceled {
+			return ctx.Err()
+		}
+		if result != nil && result.Stderr != "" {
+			return fmt.Errorf("failed to upgrade app-of-apps: %w\nHelm output: %s", err, result.Stderr)
+		}
+		return fmt.Errorf("failed to upgrade app-of-apps: %w", err)
+	}
+	return nil
+}
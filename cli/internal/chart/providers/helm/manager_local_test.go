@@ -111,3 +111,27 @@ func TestHelmManager_InstallAppOfAppsFromLocal(t *testing.T) {
 		})
 	}
 }
+
+func TestHelmManager_InstallAppOfAppsFromLocalWithClusterRepo(t *testing.T) {
+	mockExec := NewMockExecutor()
+	manager := NewHelmManager(mockExec)
+
+	err := manager.InstallAppOfAppsFromLocal(context.Background(), config.ChartInstallConfig{
+		AppOfApps: &models.AppOfAppsConfig{
+			GitHubBranch:   "openframe-offline",
+			ChartPath:      "/tmp/chart/manifests/app-of-apps",
+			ValuesFile:     "/path/to/values.yaml",
+			Namespace:      "argocd",
+			Timeout:        "60m",
+			ClusterRepoURL: "git://git-server.openframe-git.svc.cluster.local/repository.git",
+		},
+	}, "/path/to/cert.pem", "/path/to/key.pem")
+	assert.NoError(t, err)
+
+	commands := mockExec.GetCommands()
+	assert.Len(t, commands, 1)
+	assert.Equal(t, []string{
+		"--set", "global.repoURL=git://git-server.openframe-git.svc.cluster.local/repository.git",
+		"--set", "global.repoBranch=openframe-offline",
+	}, commands[0][len(commands[0])-4:])
+}
@@ -2,9 +2,13 @@ package helm
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/flamingo/openframe/internal/chart/models"
 	"github.com/flamingo/openframe/internal/chart/utils/config"
 	"github.com/flamingo/openframe/internal/chart/utils/errors"
 	"github.com/flamingo/openframe/internal/shared/executor"
@@ -245,6 +249,23 @@ func TestHelmManager_InstallArgoCD(t *testing.T) {
 				assert.Contains(t, installCmd, "--dry-run")
 			},
 		},
+		{
+			name: "local chart archive from an offline bundle",
+			config: config.ChartInstallConfig{
+				ArgoCDChart: "/bundles/abc/charts/argo-cd-8.1.4.tgz",
+			},
+			setupMock: func(m *MockExecutor) {
+				// Any repository access fails, as it would without network
+				m.SetError("helm repo", assert.AnError)
+			},
+			expectError: false,
+			checkCommands: func(t *testing.T, commands [][]string) {
+				require.Len(t, commands, 1)
+				installCmd := commands[0]
+				assert.Equal(t, []string{"helm", "upgrade", "--install", "argo-cd", "/bundles/abc/charts/argo-cd-8.1.4.tgz", "--namespace", "argocd"}, installCmd[:7])
+				assert.NotContains(t, installCmd, "--version=8.1.4")
+			},
+		},
 		{
 			name: "repo add fails",
 			config: config.ChartInstallConfig{
@@ -337,3 +358,198 @@ func TestHelmManager_GetChartStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestHelmManager_GetChartStatusInContext(t *testing.T) {
+	releaseJSON := `{
+  "name": "argo-cd",
+  "namespace": "argocd",
+  "version": 3,
+  "info": {
+    "first_deployed": "2025-01-10T09:00:00.000000+01:00",
+    "last_deployed": "2025-01-12T14:30:05.123456+01:00",
+    "description": "Upgrade complete",
+    "status": "deployed",
+    "notes": "In order to access the server UI you have the following options:\n"
+  },
+  "chart": {"metadata": {"name": "argo-cd", "version": "8.1.4", "appVersion": "v3.0.11"}},
+  "config": {}
+}`
+
+	t.Run("parses the release", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm status argo-cd -n argocd --output json --kube-context k3d-dev", &executor.CommandResult{Stdout: releaseJSON})
+
+		info, err := NewHelmManager(mockExec).GetChartStatusInContext(context.Background(), "k3d-dev", "argo-cd", "argocd")
+		require.NoError(t, err)
+
+		assert.Equal(t, "argo-cd", info.Name)
+		assert.Equal(t, "argocd", info.Namespace)
+		assert.Equal(t, "deployed", info.Status)
+		assert.True(t, info.IsDeployed())
+		assert.Equal(t, "8.1.4", info.Version)
+		assert.Equal(t, "v3.0.11", info.AppVersion)
+		assert.Equal(t, 3, info.Revision)
+		assert.Equal(t, "Upgrade complete", info.Description)
+		assert.Equal(t, "In order to access the server UI you have the following options:", info.Notes)
+		assert.Equal(t, time.Date(2025, 1, 12, 13, 30, 5, 123456000, time.UTC), info.LastDeployed.UTC())
+	})
+
+	t.Run("missing release", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm status app-of-apps", &executor.CommandResult{ExitCode: 1, Stderr: "Error: release: not found"})
+
+		_, err := NewHelmManager(mockExec).GetChartStatusInContext(context.Background(), "", "app-of-apps", "argocd")
+		assert.ErrorIs(t, err, errors.ErrChartNotFound)
+		assert.False(t, mockExec.WasCommandExecuted("--kube-context"), "the current context is used without a kube context")
+	})
+
+	t.Run("invalid output", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm status", &executor.CommandResult{Stdout: "not json"})
+
+		_, err := NewHelmManager(mockExec).GetChartStatusInContext(context.Background(), "", "argo-cd", "argocd")
+		assert.ErrorContains(t, err, "failed to parse helm status")
+	})
+}
+
+func TestHelmManager_UninstallRelease(t *testing.T) {
+	t.Run("uninstalls an installed release", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm status", &executor.CommandResult{Stdout: `{"name": "argo-cd", "info": {"status": "deployed"}}`})
+
+		require.NoError(t, NewHelmManager(mockExec).UninstallRelease(context.Background(), "k3d-dev", "argo-cd", "argocd"))
+		assert.Equal(t, "helm uninstall argo-cd --namespace argocd --wait --timeout 10m --kube-context k3d-dev", mockExec.GetLastCommand())
+	})
+
+	t.Run("missing release is skipped", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm status", &executor.CommandResult{ExitCode: 1, Stderr: "Error: release: not found"})
+
+		require.NoError(t, NewHelmManager(mockExec).UninstallRelease(context.Background(), "", "app-of-apps", "argocd"))
+		assert.False(t, mockExec.WasCommandExecuted("helm uninstall"))
+	})
+
+	t.Run("uninstall failure", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm status", &executor.CommandResult{Stdout: `{"name": "argo-cd", "info": {"status": "deployed"}}`})
+		mockExec.SetResponse("helm uninstall", &executor.CommandResult{ExitCode: 1, Stderr: "timed out waiting for the condition"})
+
+		err := NewHelmManager(mockExec).UninstallRelease(context.Background(), "", "argo-cd", "argocd")
+		assert.ErrorContains(t, err, "failed to uninstall argo-cd")
+		assert.ErrorContains(t, err, "timed out waiting for the condition")
+	})
+}
+
+func TestHelmManager_GetReleaseValues(t *testing.T) {
+	t.Run("parses the values", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm get values app-of-apps -n argocd --output yaml --kube-context k3d-dev",
+			&executor.CommandResult{Stdout: "global:\n  repoBranch: main\n"})
+
+		values, err := NewHelmManager(mockExec).GetReleaseValues(context.Background(), "k3d-dev", "app-of-apps", "argocd")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"global": map[string]interface{}{"repoBranch": "main"}}, values)
+	})
+
+	t.Run("release without values", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm get values", &executor.CommandResult{Stdout: "null\n"})
+
+		values, err := NewHelmManager(mockExec).GetReleaseValues(context.Background(), "", "app-of-apps", "argocd")
+		require.NoError(t, err)
+		assert.Empty(t, values)
+		assert.NotNil(t, values)
+	})
+
+	t.Run("missing release", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm get values", &executor.CommandResult{ExitCode: 1, Stderr: "Error: release: not found"})
+
+		_, err := NewHelmManager(mockExec).GetReleaseValues(context.Background(), "", "app-of-apps", "argocd")
+		assert.ErrorIs(t, err, errors.ErrChartNotFound)
+	})
+}
+
+func TestHelmManager_UpgradeAppOfApps(t *testing.T) {
+	mockExec := executor.NewMockCommandExecutor()
+	err := NewHelmManager(mockExec).UpgradeAppOfApps(context.Background(), "k3d-dev", "/tmp/chart", "/tmp/values.yaml", "30m0s")
+	require.NoError(t, err)
+	assert.Equal(t, "helm upgrade app-of-apps /tmp/chart --namespace argocd --wait --timeout 30m0s -f /tmp/values.yaml --kube-context k3d-dev",
+		mockExec.GetLastCommand())
+	assert.False(t, mockExec.WasCommandExecuted("--install"), "an upgrade never installs")
+	assert.False(t, mockExec.WasCommandExecuted("--set-file"), "the certificates come with the values")
+
+	mockExec.SetResponse("helm upgrade", &executor.CommandResult{ExitCode: 1, Stderr: "UPGRADE FAILED"})
+	err = NewHelmManager(mockExec).UpgradeAppOfApps(context.Background(), "", "/tmp/chart", "/tmp/values.yaml", "30m0s")
+	assert.ErrorContains(t, err, "UPGRADE FAILED")
+}
+
+func TestHelmManager_TemplateApps(t *testing.T) {
+	chartDir := t.TempDir()
+	appConfig := &models.AppOfAppsConfig{ChartPath: chartDir, Namespace: "argocd", ValuesFile: "values.yaml", GitHubBranch: "main"}
+
+	t.Run("renders with the app-of-apps values", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		_, err := NewHelmManager(mockExec).TemplateApps(context.Background(), appConfig, "/tmp/repo/manifests/apps", "", "")
+		require.NoError(t, err)
+		assert.Equal(t, "helm template argocd-apps /tmp/repo/manifests/apps --namespace argocd -f values.yaml", mockExec.GetLastCommand(),
+			"without chart defaults only the given values are passed")
+
+		require.NoError(t, os.WriteFile(filepath.Join(chartDir, "values.yaml"), []byte("global: {}\n"), 0644))
+		_, err = NewHelmManager(mockExec).TemplateApps(context.Background(), appConfig, "/tmp/repo/manifests/apps", "cert.pem", "key.pem")
+		require.NoError(t, err)
+		assert.Equal(t, "helm template argocd-apps /tmp/repo/manifests/apps --namespace argocd -f "+filepath.Join(chartDir, "values.yaml")+" -f values.yaml "+
+			"--set-file deployment.oss.ingress.localhost.tls.cert=cert.pem --set-file deployment.oss.ingress.localhost.tls.key=key.pem",
+			mockExec.GetLastCommand(), "the chart defaults come before the values that override them")
+	})
+
+	t.Run("render failure", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm template", &executor.CommandResult{ExitCode: 1, Stderr: "parse error in application.yaml"})
+
+		_, err := NewHelmManager(mockExec).TemplateApps(context.Background(), appConfig, "/tmp/repo/manifests/apps", "", "")
+		assert.ErrorContains(t, err, "failed to render argocd-apps")
+		assert.ErrorContains(t, err, "parse error in application.yaml")
+	})
+}
+
+func TestHelmManager_TemplateAppOfApps(t *testing.T) {
+	appConfig := &models.AppOfAppsConfig{ChartPath: "/tmp/chart", Namespace: "argocd", ValuesFile: "values.yaml", GitHubBranch: "main"}
+
+	t.Run("renders with certificates", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm template", &executor.CommandResult{Stdout: "kind: Application\n"})
+
+		manifests, err := NewHelmManager(mockExec).TemplateAppOfApps(context.Background(), appConfig, "cert.pem", "key.pem")
+		require.NoError(t, err)
+		assert.Equal(t, "kind: Application\n", manifests)
+		assert.Equal(t, "helm template app-of-apps /tmp/chart --namespace argocd -f values.yaml "+
+			"--set-file deployment.oss.ingress.localhost.tls.cert=cert.pem --set-file deployment.oss.ingress.localhost.tls.key=key.pem",
+			mockExec.GetLastCommand())
+	})
+
+	t.Run("renders without certificates", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		_, err := NewHelmManager(mockExec).TemplateAppOfApps(context.Background(), appConfig, "", "")
+		require.NoError(t, err)
+		assert.False(t, mockExec.WasCommandExecuted("--set-file"))
+	})
+
+	t.Run("offline repository", func(t *testing.T) {
+		offline := *appConfig
+		offline.ClusterRepoURL = "git://git-server/repository.git"
+
+		mockExec := executor.NewMockCommandExecutor()
+		_, err := NewHelmManager(mockExec).TemplateAppOfApps(context.Background(), &offline, "", "")
+		require.NoError(t, err)
+		assert.True(t, mockExec.WasCommandExecuted("--set global.repoURL=git://git-server/repository.git --set global.repoBranch=main"))
+	})
+
+	t.Run("render failure", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm template", &executor.CommandResult{ExitCode: 1, Stderr: "parse error in application.yaml"})
+
+		_, err := NewHelmManager(mockExec).TemplateAppOfApps(context.Background(), appConfig, "", "")
+		assert.ErrorContains(t, err, "parse error in application.yaml")
+	})
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/flamingo/openframe/internal/chart/utils/types"
 	utilTypes "github.com/flamingo/openframe/internal/chart/utils/types"
 	"github.com/flamingo/openframe/internal/cluster"
+	clusterUtils "github.com/flamingo/openframe/internal/cluster/utils"
 	sharedErrors "github.com/flamingo/openframe/internal/shared/errors"
 	"github.com/flamingo/openframe/internal/shared/executor"
 	"github.com/flamingo/openframe/internal/shared/files"
@@ -120,7 +121,14 @@ func (w *InstallationWorkflow) ExecuteWithContext(parentCtx context.Context, req
 
 	// Step 1: Run configuration wizard first (skip in dry-run mode for tests)
 	var chartConfig *types.ChartConfiguration
-	if req.DryRun {
+	if req.Scripted != nil {
+		var err error
+		chartConfig, err = configuration.NewConfigurationWizard().ConfigureNonInteractive(req.Scripted)
+		if err != nil {
+			return fmt.Errorf("configuration failed: %w", err)
+		}
+		w.registerTempValuesFile(chartConfig)
+	} else if req.DryRun {
 		// Create minimal configuration for dry-run mode using base values from current directory
 		modifier := templates.NewHelmValuesModifier()
 		baseValues, err := modifier.LoadOrCreateBaseValues()
@@ -141,23 +149,29 @@ func (w *InstallationWorkflow) ExecuteWithContext(parentCtx context.Context, req
 		if err != nil {
 			return fmt.Errorf("configuration wizard failed: %w", err)
 		}
-
-		// Register temporary file for cleanup
-		if chartConfig.TempHelmValuesPath != "" {
-			if backupErr := w.fileCleanup.RegisterTempFile(chartConfig.TempHelmValuesPath); backupErr != nil {
-				pterm.Warning.Printf("Failed to register temp file for cleanup: %v\n", backupErr)
-			}
-		}
+		w.registerTempValuesFile(chartConfig)
 	}
 
 	// Step 2: Select cluster
-	clusterName, err := w.selectCluster(req.Args, req.Verbose)
+	clusterName, err := w.selectCluster(req.Args, req.Verbose, req.AssumeYes)
 	if err != nil || clusterName == "" {
 		return err
 	}
+	if req.Report != nil {
+		req.Report.Cluster = clusterName
+	}
+
+	// With --diff, show what the install would change and stop before touching the cluster
+	if req.Diff {
+		err := w.previewInstallation(ctx, req, clusterName, chartConfig)
+		if cleanupErr := w.fileCleanup.RestoreFiles(req.Verbose); cleanupErr != nil {
+			pterm.Warning.Printf("Failed to clean up files after preview: %v\n", cleanupErr)
+		}
+		return err
+	}
 
 	// Step 3: Confirm installation on the selected cluster
-	if !w.confirmInstallationOnCluster(clusterName) {
+	if !req.AssumeYes && !w.confirmInstallationOnCluster(clusterName) {
 		pterm.Info.Println("Installation cancelled.")
 		return fmt.Errorf("installation cancelled by user")
 	}
@@ -196,17 +210,79 @@ func (w *InstallationWorkflow) ExecuteWithContext(parentCtx context.Context, req
 	// Step 8: ArgoCD sync is already handled by installer.InstallCharts
 	// The installer waits for all ArgoCD applications after installing app-of-apps
 
-	// Step 9: Installation successful - clean up temporary files
+	// Step 9: Remember the installed branch, ingress mode and values before the temp file is removed
+	if !req.DryRun {
+		recordInstallationInDefaultStore(config, chartConfig.TempHelmValuesPath)
+	}
+
+	// Step 10: Installation successful - clean up temporary files
 	if cleanupErr := w.fileCleanup.RestoreFilesOnSuccess(req.Verbose); cleanupErr != nil {
 		pterm.Warning.Printf("Failed to clean up files after successful installation: %v\n", cleanupErr)
 	}
 
+	// Step 11: Record the final application state for machine-readable output
+	if req.Report != nil {
+		if kubeContext, contextErr := w.clusterService.KubeContext(clusterName); contextErr == nil {
+			lister := clusterUtils.NewArgoCDApplicationLister(w.chartService.executor)
+			if apps, listErr := lister.ListApplications(ctx, kubeContext); listErr == nil {
+				req.Report.Applications = apps
+			}
+		}
+	}
+
+	return nil
+}
+
+// previewInstallation shows the values diff and the applications the install would create, change or remove
+func (w *InstallationWorkflow) previewInstallation(ctx context.Context, req utilTypes.InstallationRequest, clusterName string, chartConfig *types.ChartConfiguration) error {
+	installConfig, err := w.buildConfiguration(req, clusterName, chartConfig.TempHelmValuesPath)
+	if err != nil {
+		return errors.WrapAsChartError("configuration", "build", err).WithCluster(clusterName)
+	}
+
+	// Compare against the selected cluster rather than whatever kubectl context is current
+	kubeContext, err := w.clusterService.KubeContext(clusterName)
+	if err != nil {
+		return errors.WrapAsChartError("preview", "cluster", err).WithCluster(clusterName)
+	}
+
+	certFile, keyFile := w.chartService.configService.GetPathResolver().GetCertificateFiles()
+	preview, err := NewPreviewer(w.chartService.executor).Preview(ctx, PreviewOptions{
+		KubeContext:    kubeContext,
+		BaseValuesFile: chartConfig.BaseHelmValuesPath,
+		ValuesFile:     chartConfig.TempHelmValuesPath,
+		AppOfApps:      installConfig.AppOfApps,
+		CertFile:       certFile,
+		KeyFile:        keyFile,
+	})
+	if err != nil {
+		return err
+	}
+
+	if req.Report != nil {
+		req.Report.Status = utilTypes.InstallationStatusPreviewed
+		req.Report.Preview = preview
+	}
+	w.chartService.displayService.ShowInstallPreview(preview)
 	return nil
 }
 
-// selectCluster handles cluster selection
-func (w *InstallationWorkflow) selectCluster(args []string, verbose bool) (string, error) {
+// registerTempValuesFile registers the generated values file for cleanup
+func (w *InstallationWorkflow) registerTempValuesFile(chartConfig *types.ChartConfiguration) {
+	if chartConfig.TempHelmValuesPath == "" {
+		return
+	}
+	if backupErr := w.fileCleanup.RegisterTempFile(chartConfig.TempHelmValuesPath); backupErr != nil {
+		pterm.Warning.Printf("Failed to register temp file for cleanup: %v\n", backupErr)
+	}
+}
+
+// selectCluster handles cluster selection, without prompting when assumeYes is set
+func (w *InstallationWorkflow) selectCluster(args []string, verbose, assumeYes bool) (string, error) {
 	clusterSelector := NewClusterSelector(w.clusterService, w.chartService.operationsUI)
+	if assumeYes {
+		return clusterSelector.SelectClusterNonInteractive(args, verbose)
+	}
 	return clusterSelector.SelectCluster(args, verbose)
 }
 
@@ -269,11 +345,30 @@ func (w *InstallationWorkflow) waitForArgoCDSync(ctx context.Context, config con
 // buildConfiguration constructs the installation configuration
 func (w *InstallationWorkflow) buildConfiguration(req utilTypes.InstallationRequest, clusterName string, helmValuesPath string) (config.ChartInstallConfig, error) {
 	configBuilder := config.NewBuilder(w.chartService.operationsUI)
-	return configBuilder.BuildInstallConfigWithCustomHelmPath(
+	installConfig, err := configBuilder.BuildInstallConfigWithCustomHelmPath(
 		req.Force, req.DryRun, req.Verbose, clusterName,
 		req.GitHubRepo, req.GitHubBranch, req.CertDir,
 		helmValuesPath,
 	)
+	if err != nil {
+		return installConfig, err
+	}
+	applyOfflineSource(&installConfig, req.Offline)
+	return installConfig, nil
+}
+
+// applyOfflineSource switches an installation to the charts and repository of an offline bundle
+// The bundled revision wins over the branch in the Helm values, since it is the only one available.
+func applyOfflineSource(installConfig *config.ChartInstallConfig, offline *utilTypes.OfflineSource) {
+	if offline == nil {
+		return
+	}
+	installConfig.ArgoCDChart = offline.ArgoCDChart
+	if installConfig.AppOfApps != nil {
+		installConfig.AppOfApps.GitHubRepo = offline.Repository
+		installConfig.AppOfApps.GitHubBranch = offline.Revision
+		installConfig.AppOfApps.ClusterRepoURL = offline.ClusterRepoURL
+	}
 }
 
 // performInstallation executes the actual installation
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/flamingo/openframe/internal/chart/models"
+	"github.com/flamingo/openframe/internal/chart/utils/config"
 	"github.com/flamingo/openframe/internal/chart/utils/types"
 	clusterDomain "github.com/flamingo/openframe/internal/cluster/models"
 	"github.com/stretchr/testify/assert"
@@ -23,6 +25,16 @@ func (m *MockClusterLister) ListClusters() ([]clusterDomain.ClusterInfo, error)
 	return m.clusters, nil
 }
 
+// KubeContext implements ClusterLister interface
+func (m *MockClusterLister) KubeContext(name string) (string, error) {
+	for _, cluster := range m.clusters {
+		if cluster.Name == name && cluster.Type == clusterDomain.ClusterTypeKind {
+			return "kind-" + name, nil
+		}
+	}
+	return "k3d-" + name, nil
+}
+
 // NewMockClusterLister creates a new mock cluster lister
 func NewMockClusterLister() *MockClusterLister {
 	return &MockClusterLister{
@@ -169,4 +181,50 @@ func TestInstallationWorkflow_EmptyRequest(t *testing.T) {
 	assert.False(t, req.Force)
 	assert.False(t, req.DryRun)
 	assert.False(t, req.Verbose)
-}
\ No newline at end of file
+}
+func TestApplyOfflineSource(t *testing.T) {
+	installConfig := config.ChartInstallConfig{AppOfApps: models.NewAppOfAppsConfig()}
+	installConfig.AppOfApps.GitHubBranch = "develop" // From global.repoBranch in the Helm values
+
+	applyOfflineSource(&installConfig, &types.OfflineSource{
+		ArgoCDChart:    "/bundles/abc/charts/argo-cd-8.1.4.tgz",
+		Repository:     "/bundles/abc/repository.git",
+		Revision:       "openframe-offline",
+		ClusterRepoURL: "git://git-server.openframe-git.svc.cluster.local/repository.git",
+	})
+
+	assert.Equal(t, "/bundles/abc/charts/argo-cd-8.1.4.tgz", installConfig.ArgoCDChart)
+	assert.Equal(t, "/bundles/abc/repository.git", installConfig.AppOfApps.GitHubRepo)
+	assert.Equal(t, "openframe-offline", installConfig.AppOfApps.GitHubBranch, "the bundled branch wins over the Helm values")
+	assert.Equal(t, "git://git-server.openframe-git.svc.cluster.local/repository.git", installConfig.AppOfApps.ClusterRepoURL)
+
+	online := config.ChartInstallConfig{AppOfApps: models.NewAppOfAppsConfig()}
+	applyOfflineSource(&online, nil)
+	assert.Empty(t, online.ArgoCDChart)
+	assert.Equal(t, "https://github.com/flamingo-stack/openframe-oss-tenant", online.AppOfApps.GitHubRepo)
+}
+
+func TestClusterSelector_SelectClusterNonInteractive(t *testing.T) {
+	lister := NewMockClusterLister()
+	selector := NewClusterSelector(lister, NewChartService(true, false).operationsUI)
+
+	_, err := selector.SelectClusterNonInteractive(nil, false)
+	assert.ErrorContains(t, err, "no clusters found")
+
+	lister.SetClusters([]clusterDomain.ClusterInfo{{Name: "only", Status: "running"}})
+	name, err := selector.SelectClusterNonInteractive(nil, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "only", name, "the only cluster is picked without a prompt")
+
+	lister.SetClusters([]clusterDomain.ClusterInfo{{Name: "one"}, {Name: "two"}})
+	_, err = selector.SelectClusterNonInteractive(nil, false)
+	assert.ErrorContains(t, err, "pass the cluster name")
+
+	name, err = selector.SelectClusterNonInteractive([]string{"two"}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "two", name)
+
+	lister.SetError(errors.New("docker not running"))
+	_, err = selector.SelectClusterNonInteractive([]string{"two"}, false)
+	assert.ErrorContains(t, err, "failed to list clusters")
+}
@@ -1,6 +1,8 @@
 package services
 
 import (
+	"fmt"
+
 	"github.com/flamingo/openframe/internal/chart/utils/types"
 	chartUI "github.com/flamingo/openframe/internal/chart/ui"
 	"github.com/pterm/pterm"
@@ -48,3 +50,26 @@ func (c *ClusterSelector) SelectCluster(args []string, verbose bool) (string, er
 
 	return c.operationsUI.SelectClusterForInstall(clusters, args)
 }
+
+// SelectClusterNonInteractive picks the named cluster, or the only cluster when no name is given
+func (c *ClusterSelector) SelectClusterNonInteractive(args []string, verbose bool) (string, error) {
+	clusters, err := c.clusterService.ListClusters()
+	if err != nil {
+		return "", fmt.Errorf("failed to list clusters: %w", err)
+	}
+	if len(clusters) == 0 {
+		return "", fmt.Errorf("no clusters found, create one first with: openframe cluster create")
+	}
+
+	if len(args) > 0 {
+		return c.operationsUI.SelectClusterForInstall(clusters, args)
+	}
+
+	if len(clusters) > 1 {
+		return "", fmt.Errorf("found %d clusters, pass the cluster name to install on", len(clusters))
+	}
+	if verbose {
+		pterm.Info.Printf("Using the only cluster: %s\n", clusters[0].Name)
+	}
+	return clusters[0].Name, nil
+}
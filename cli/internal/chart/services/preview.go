@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/flamingo/openframe/internal/chart/models"
+	"github.com/flamingo/openframe/internal/chart/providers/argocd"
+	"github.com/flamingo/openframe/internal/chart/providers/git"
+	"github.com/flamingo/openframe/internal/chart/providers/helm"
+	"github.com/flamingo/openframe/internal/chart/ui/templates"
+	chartErrors "github.com/flamingo/openframe/internal/chart/utils/errors"
+	sharedErrors "github.com/flamingo/openframe/internal/shared/errors"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/files"
+	"github.com/pterm/pterm"
+)
+
+// PreviewOptions describes the install to preview
+type PreviewOptions struct {
+	KubeContext    string                  // Empty for the current kubeconfig context
+	BaseValuesFile string                  // Values the configuration started from, may not exist
+	ValuesFile     string                  // Generated values the install would use
+	AppOfApps      *models.AppOfAppsConfig // Repository and branch of the app-of-apps chart
+	CertFile       string                  // Certificates passed to the chart, skipped when missing
+	KeyFile        string
+}
+
+// Previewer shows what an install would change without installing
+type Previewer struct {
+	helmManager *helm.HelmManager
+	argoCD      *argocd.Manager
+	gitRepo     *git.Repository
+	modifier    *templates.HelmValuesModifier
+}
+
+// NewPreviewer creates an install previewer
+func NewPreviewer(exec executor.CommandExecutor) *Previewer {
+	return &Previewer{
+		helmManager: helm.NewHelmManager(exec),
+		argoCD:      argocd.NewManager(exec),
+		gitRepo:     git.NewRepository(exec),
+		modifier:    templates.NewHelmValuesModifier(),
+	}
+}
+
+// Preview diffs the generated values against the base values and the deployed release,
+// and renders the app-of-apps chart and the applications of its root application to list
+// the applications the install creates, changes or removes
+func (p *Previewer) Preview(ctx context.Context, opts PreviewOptions) (*models.InstallPreview, error) {
+	generated, err := p.modifier.LoadExistingValues(opts.ValuesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	// A missing base file means the install starts from empty values
+	base := map[string]interface{}{}
+	if _, statErr := os.Stat(opts.BaseValuesFile); statErr == nil {
+		if base, err = p.modifier.LoadExistingValues(opts.BaseValuesFile); err != nil {
+			return nil, err
+		}
+	}
+
+	preview := &models.InstallPreview{
+		BaseValuesFile: opts.BaseValuesFile,
+		BaseChanges:    models.RedactedValueChanges(models.DiffValues(base, generated)),
+	}
+
+	deployed, err := p.helmManager.GetReleaseValues(ctx, opts.KubeContext, "app-of-apps", "argocd")
+	switch {
+	case errors.Is(err, chartErrors.ErrChartNotFound):
+	case err != nil:
+		return nil, err
+	default:
+		preview.Installed = true
+		// The certificates are regenerated and passed as files on every install, so they always differ
+		for _, key := range certificateValueKeys {
+			p.modifier.DeleteValue(deployed, key)
+		}
+		preview.DeployedChanges = models.RedactedValueChanges(models.DiffValues(deployed, generated))
+	}
+
+	// Without an app-of-apps repository the install deploys no applications
+	if opts.AppOfApps == nil {
+		return preview, nil
+	}
+
+	rendered, err := p.renderApplications(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	var live []argocd.ApplicationSpec
+	if p.argoCD.HasApplicationCRD(ctx, opts.KubeContext) {
+		if live, err = p.argoCD.ApplicationSpecs(ctx, opts.KubeContext); err != nil {
+			return nil, err
+		}
+	}
+	preview.Applications, preview.UnchangedApplications = argocd.CompareApplications([]string{"app-of-apps", models.RootApplication}, live, rendered)
+
+	return preview, nil
+}
+
+// renderApplications clones the app-of-apps chart and renders it with the generated values,
+// along with the chart its root application deploys from global.repoDir/global.appsDir
+func (p *Previewer) renderApplications(ctx context.Context, opts PreviewOptions) ([]argocd.ApplicationSpec, error) {
+	appConfig := *opts.AppOfApps
+	pterm.Info.Printf("Rendering app-of-apps from branch '%s'...\n", appConfig.GitHubBranch)
+
+	cloneResult, err := p.gitRepo.CloneChartRepository(ctx, &appConfig)
+	if err != nil {
+		if strings.Contains(err.Error(), "branch") && strings.Contains(err.Error(), "does not exist") {
+			return nil, sharedErrors.NewBranchNotFoundError(appConfig.GitHubBranch)
+		}
+		return nil, err
+	}
+	defer p.gitRepo.Cleanup(cloneResult.TempDir)
+
+	appConfig.ChartPath = cloneResult.ChartPath
+	appConfig.ValuesFile = opts.ValuesFile
+
+	certFile, keyFile := opts.CertFile, opts.KeyFile
+	if !files.Exists(certFile) || !files.Exists(keyFile) {
+		certFile, keyFile = "", ""
+	}
+
+	manifests, err := p.helmManager.TemplateAppOfApps(ctx, &appConfig, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	rendered, err := argocd.ParseRenderedApplications([]byte(manifests))
+	if err != nil {
+		return nil, err
+	}
+
+	// The root application is rendered with the generated values, so its path already is
+	// global.repoDir/global.appsDir; ArgoCD renders that chart with the same values
+	for _, app := range rendered {
+		if app.Name != models.RootApplication || app.Path == "" {
+			continue
+		}
+		apps, err := p.helmManager.TemplateApps(ctx, &appConfig, filepath.Join(cloneResult.TempDir, app.Path), certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		children, err := argocd.ParseRenderedApplications([]byte(apps))
+		if err != nil {
+			return nil, err
+		}
+		return append(rendered, children...), nil
+	}
+	return rendered, nil
+}
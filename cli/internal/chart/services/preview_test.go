@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flamingo/openframe/internal/chart/models"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const renderedAppOfApps = `---
+apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: argocd-apps
+spec:
+  source:
+    path: manifests/apps
+    targetRevision: develop
+`
+
+const renderedApps = `---
+apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: platform
+spec:
+  source:
+    targetRevision: develop
+---
+apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: api
+spec:
+  source:
+    targetRevision: develop
+`
+
+// writePreviewValues writes base and generated values files for a preview
+func writePreviewValues(t *testing.T) (string, string) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "helm-values.yaml")
+	generated := filepath.Join(dir, "helm-values-tmp.yaml")
+	require.NoError(t, os.WriteFile(base, []byte("global:\n  repoBranch: main\nregistry:\n  docker:\n    password: base\n"), 0600))
+	require.NoError(t, os.WriteFile(generated, []byte("global:\n  repoBranch: develop\nregistry:\n  docker:\n    password: hunter2\n"), 0600))
+	return base, generated
+}
+
+func TestPreviewer_Preview(t *testing.T) {
+	t.Run("fresh install", func(t *testing.T) {
+		base, generated := writePreviewValues(t)
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm get values", &executor.CommandResult{ExitCode: 1, Stderr: "Error: release: not found"})
+		mockExec.SetResponse("helm template app-of-apps", &executor.CommandResult{Stdout: renderedAppOfApps})
+		mockExec.SetResponse("helm template argocd-apps", &executor.CommandResult{Stdout: renderedApps})
+		mockExec.SetResponse("get crd", &executor.CommandResult{ExitCode: 1, Stderr: "NotFound"})
+
+		preview, err := NewPreviewer(mockExec).Preview(context.Background(), PreviewOptions{
+			BaseValuesFile: base,
+			ValuesFile:     generated,
+			AppOfApps:      &models.AppOfAppsConfig{GitHubRepo: "https://github.com/test/repo.git", GitHubBranch: "develop", Namespace: "argocd"},
+			CertFile:       "/nonexistent/localhost.pem",
+			KeyFile:        "/nonexistent/localhost-key.pem",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []models.ValueChange{
+			{Path: "global.repoBranch", Kind: models.ValueChanged, Old: "main", New: "develop"},
+			{Path: "registry.docker.password", Kind: models.ValueChanged, Old: models.RedactedValue, New: models.RedactedValue},
+		}, preview.BaseChanges)
+		assert.False(t, preview.Installed)
+		assert.Empty(t, preview.DeployedChanges)
+		assert.Equal(t, []models.ApplicationChange{
+			{Name: "api", Action: models.ApplicationCreated},
+			{Name: "argocd-apps", Action: models.ApplicationCreated},
+			{Name: "platform", Action: models.ApplicationCreated},
+		}, preview.Applications)
+		assert.True(t, mockExec.WasCommandExecuted("manifests/apps --namespace argocd"), "the root application's chart is rendered from the clone")
+
+		assert.True(t, mockExec.WasCommandExecuted("git clone --depth 1 --single-branch --no-tags --branch develop"))
+		assert.False(t, mockExec.WasCommandExecuted("--set-file"), "missing certificates are not passed")
+		for _, command := range mockExec.GetExecutedCommands() {
+			assert.NotContains(t, command, "upgrade", "a preview never installs")
+		}
+	})
+
+	t.Run("existing install", func(t *testing.T) {
+		base, generated := writePreviewValues(t)
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm get values", &executor.CommandResult{Stdout: `global:
+  repoBranch: main
+registry:
+  docker:
+    password: hunter2
+deployment:
+  oss:
+    ingress:
+      localhost:
+        tls:
+          cert: CERT
+          key: KEY
+`})
+		mockExec.SetResponse("helm template app-of-apps", &executor.CommandResult{Stdout: renderedAppOfApps})
+		mockExec.SetResponse("helm template argocd-apps", &executor.CommandResult{Stdout: renderedApps})
+		mockExec.SetResponse("get applications.argoproj.io -o json", &executor.CommandResult{Stdout: `{"items": [
+  {"metadata": {"name": "argocd-apps", "annotations": {"meta.helm.sh/release-name": "app-of-apps"}}, "spec": {"source": {"path": "manifests/apps", "targetRevision": "develop"}}},
+  {"metadata": {"name": "platform", "labels": {"app.kubernetes.io/instance": "argocd-apps"}}, "spec": {"source": {"targetRevision": "main"}}},
+  {"metadata": {"name": "api", "labels": {"app.kubernetes.io/instance": "argocd-apps"}}, "spec": {"source": {"targetRevision": "develop"}}},
+  {"metadata": {"name": "kafka-ui", "labels": {"app.kubernetes.io/instance": "argocd-apps"}}, "spec": {"source": {"targetRevision": "main"}}},
+  {"metadata": {"name": "mongodb"}, "spec": {"source": {"targetRevision": "main"}}}
+]}`})
+
+		preview, err := NewPreviewer(mockExec).Preview(context.Background(), PreviewOptions{
+			BaseValuesFile: base,
+			ValuesFile:     generated,
+			AppOfApps:      &models.AppOfAppsConfig{GitHubRepo: "https://github.com/test/repo.git", GitHubBranch: "develop", Namespace: "argocd"},
+		})
+		require.NoError(t, err)
+
+		assert.True(t, preview.Installed)
+		assert.Equal(t, []models.ValueChange{
+			{Path: "global.repoBranch", Kind: models.ValueChanged, Old: "main", New: "develop"},
+		}, preview.DeployedChanges, "certificates are regenerated on install, so they are left out")
+		assert.Equal(t, []models.ApplicationChange{
+			{Name: "kafka-ui", Action: models.ApplicationRemoved},
+			{Name: "platform", Action: models.ApplicationChanged, Changes: []string{"targetRevision: main → develop"}},
+		}, preview.Applications, "applications created outside the install are left alone")
+		assert.Equal(t, 2, preview.UnchangedApplications)
+	})
+
+	t.Run("missing base values and no repository", func(t *testing.T) {
+		_, generated := writePreviewValues(t)
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm get values", &executor.CommandResult{ExitCode: 1, Stderr: "Error: release: not found"})
+
+		preview, err := NewPreviewer(mockExec).Preview(context.Background(), PreviewOptions{
+			BaseValuesFile: filepath.Join(t.TempDir(), "helm-values.yaml"),
+			ValuesFile:     generated,
+		})
+		require.NoError(t, err)
+
+		assert.Len(t, preview.BaseChanges, 2, "every generated value is added")
+		assert.Equal(t, models.ValueAdded, preview.BaseChanges[0].Kind)
+		assert.Empty(t, preview.Applications)
+		assert.False(t, mockExec.WasCommandExecuted("git clone"))
+	})
+}
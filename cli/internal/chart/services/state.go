@@ -0,0 +1,75 @@
+package services
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/flamingo/openframe/internal/chart/ui/templates"
+	"github.com/flamingo/openframe/internal/chart/utils/config"
+	"github.com/flamingo/openframe/internal/shared/state"
+	"github.com/pterm/pterm"
+)
+
+// recordInstallation remembers the branch, ingress mode and helm values of a successful install
+// in the cluster state store, keeping a copy of the values since the temporary file is cleaned up
+func recordInstallation(store *state.Store, installConfig config.ChartInstallConfig, helmValuesPath string) error {
+	install := &state.InstallRecord{InstalledAt: time.Now().UTC()}
+	if installConfig.AppOfApps != nil {
+		install.Repository = installConfig.AppOfApps.GitHubRepo
+		install.Branch = installConfig.AppOfApps.GitHubBranch
+	}
+
+	if helmValuesPath != "" {
+		if data, err := os.ReadFile(helmValuesPath); err == nil {
+			modifier := templates.NewHelmValuesModifier()
+			if values, err := modifier.LoadExistingValues(helmValuesPath); err == nil {
+				install.IngressMode = modifier.GetCurrentIngressSettings(values)
+			}
+			valuesFile, err := store.SaveValues(installConfig.ClusterName, data)
+			if err != nil {
+				return err
+			}
+			install.ValuesFile = valuesFile
+		}
+	}
+
+	return store.Update(installConfig.ClusterName, func(record *state.ClusterRecord) {
+		record.Install = install
+	})
+}
+
+// recordInstallationInDefaultStore records an install in ~/.config/openframe/clusters, warning on failure
+func recordInstallationInDefaultStore(installConfig config.ChartInstallConfig, helmValuesPath string) {
+	store, err := state.NewDefaultStore()
+	if err == nil {
+		err = recordInstallation(store, installConfig, helmValuesPath)
+	}
+	if err != nil {
+		pterm.Warning.Printf("Failed to save installation state of cluster %s: %v\n", installConfig.ClusterName, err)
+	}
+}
+
+// clearInstallation forgets the chart install of a cluster that has a record
+func clearInstallation(store *state.Store, clusterName string) error {
+	if _, err := store.Load(clusterName); err != nil {
+		if errors.Is(err, state.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	return store.Update(clusterName, func(record *state.ClusterRecord) {
+		record.Install = nil
+	})
+}
+
+// ClearInstallationInDefaultStore forgets the chart install of a cluster after an uninstall, warning on failure
+func ClearInstallationInDefaultStore(clusterName string) {
+	store, err := state.NewDefaultStore()
+	if err == nil {
+		err = clearInstallation(store, clusterName)
+	}
+	if err != nil {
+		pterm.Warning.Printf("Failed to update the state of cluster %s: %v\n", clusterName, err)
+	}
+}
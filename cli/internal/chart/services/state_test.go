@@ -0,0 +1,76 @@
+package services
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flamingo/openframe/internal/chart/models"
+	"github.com/flamingo/openframe/internal/chart/utils/config"
+	"github.com/flamingo/openframe/internal/shared/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordInstallation(t *testing.T) {
+	t.Run("records branch, ingress mode and a copy of the values", func(t *testing.T) {
+		store := state.NewStore(t.TempDir())
+		require.NoError(t, store.Save(&state.ClusterRecord{Name: "dev", Type: "k3d"}))
+
+		valuesPath := filepath.Join(t.TempDir(), "helm-values.yaml")
+		values := "global:\n  repoBranch: develop\ndeployment:\n  oss:\n    ingress:\n      ngrok:\n        enabled: true\n"
+		require.NoError(t, os.WriteFile(valuesPath, []byte(values), 0600))
+
+		installConfig := config.ChartInstallConfig{
+			ClusterName: "dev",
+			AppOfApps: &models.AppOfAppsConfig{
+				GitHubRepo:   "https://github.com/flamingo-stack/openframe-oss-tenant",
+				GitHubBranch: "develop",
+			},
+		}
+
+		require.NoError(t, recordInstallation(store, installConfig, valuesPath))
+		// The temporary values file may be cleaned up after the install
+		require.NoError(t, os.Remove(valuesPath))
+
+		record, err := store.Load("dev")
+		require.NoError(t, err)
+		assert.Equal(t, "k3d", record.Type, "existing cluster state is kept")
+		require.NotNil(t, record.Install)
+		assert.Equal(t, "develop", record.Install.Branch)
+		assert.Equal(t, "https://github.com/flamingo-stack/openframe-oss-tenant", record.Install.Repository)
+		assert.Equal(t, "ngrok", record.Install.IngressMode)
+		assert.False(t, record.Install.InstalledAt.IsZero())
+
+		saved, err := os.ReadFile(record.Install.ValuesFile)
+		require.NoError(t, err)
+		assert.Equal(t, values, string(saved))
+	})
+
+	t.Run("records an install without values or app-of-apps", func(t *testing.T) {
+		store := state.NewStore(t.TempDir())
+
+		require.NoError(t, recordInstallation(store, config.ChartInstallConfig{ClusterName: "dev"}, ""))
+
+		record, err := store.Load("dev")
+		require.NoError(t, err)
+		require.NotNil(t, record.Install)
+		assert.Empty(t, record.Install.Branch)
+		assert.Empty(t, record.Install.ValuesFile)
+	})
+}
+
+func TestClearInstallation(t *testing.T) {
+	store := state.NewStore(t.TempDir())
+	require.NoError(t, store.Save(&state.ClusterRecord{Name: "dev", Type: "k3d", Install: &state.InstallRecord{Branch: "main"}}))
+
+	require.NoError(t, clearInstallation(store, "dev"))
+	record, err := store.Load("dev")
+	require.NoError(t, err)
+	assert.Nil(t, record.Install)
+	assert.Equal(t, "k3d", record.Type, "the cluster state is kept")
+
+	require.NoError(t, clearInstallation(store, "unknown"))
+	_, err = store.Load("unknown")
+	assert.ErrorIs(t, err, state.ErrRecordNotFound, "no record is created for clusters without one")
+}
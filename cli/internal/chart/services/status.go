@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/flamingo/openframe/internal/chart/models"
+	"github.com/flamingo/openframe/internal/chart/providers/helm"
+	chartErrors "github.com/flamingo/openframe/internal/chart/utils/errors"
+	clusterDomain "github.com/flamingo/openframe/internal/cluster/models"
+	clusterUtils "github.com/flamingo/openframe/internal/cluster/utils"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/output"
+)
+
+// statusReleases are the Helm releases installed by chart install, in install order
+var statusReleases = []struct {
+	name      string
+	namespace string
+}{
+	{name: "argo-cd", namespace: "argocd"},
+	{name: "app-of-apps", namespace: "argocd"},
+}
+
+// StatusService reports the Helm releases and ArgoCD applications installed by chart install
+type StatusService struct {
+	helmManager  *helm.HelmManager
+	applications clusterDomain.ApplicationLister
+}
+
+// NewStatusService creates a chart status service
+func NewStatusService(exec executor.CommandExecutor) *StatusService {
+	return &StatusService{
+		helmManager:  helm.NewHelmManager(exec),
+		applications: clusterUtils.NewArgoCDApplicationLister(exec),
+	}
+}
+
+// GetStatus collects the release and application state for a kubeconfig context
+// An empty kubeContext uses the current context. Missing releases are reported as not installed.
+func (s *StatusService) GetStatus(ctx context.Context, kubeContext string) (*models.ChartStatusReport, error) {
+	report := &models.ChartStatusReport{
+		APIVersion: output.DocumentAPIVersion,
+		Kind:       models.ChartStatusKind,
+		Context:    kubeContext,
+		Releases:   make([]models.ChartInfo, 0, len(statusReleases)),
+	}
+
+	for _, release := range statusReleases {
+		info, err := s.helmManager.GetChartStatusInContext(ctx, kubeContext, release.name, release.namespace)
+		if errors.Is(err, chartErrors.ErrChartNotFound) {
+			info = models.ChartInfo{Name: release.name, Namespace: release.namespace, Status: models.ReleaseStatusNotInstalled}
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get status of release %s: %w", release.name, err)
+		}
+		report.Releases = append(report.Releases, info)
+	}
+
+	// Applications only exist once ArgoCD and its CRDs are installed
+	if report.Releases[0].Status == models.ReleaseStatusNotInstalled {
+		return report, nil
+	}
+
+	apps, err := s.applications.ListApplications(ctx, kubeContext)
+	if err != nil {
+		report.ApplicationsError = err.Error()
+	}
+	report.Applications = apps
+	for _, app := range apps {
+		if app.IsDegraded() {
+			report.DegradedApplications = append(report.DegradedApplications, app.Name)
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flamingo/openframe/internal/chart/models"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const argoCDReleaseJSON = `{"name": "argo-cd", "namespace": "argocd", "version": 2,
+  "info": {"status": "deployed", "last_deployed": "2025-01-12T14:30:05Z", "notes": "argo notes"},
+  "chart": {"metadata": {"version": "8.1.4", "appVersion": "v3.0.11"}}}`
+
+const applicationsJSON = `{"items": [
+  {"metadata": {"name": "platform", "annotations": {"argocd.argoproj.io/sync-wave": "0"}},
+   "status": {"health": {"status": "Healthy"}, "sync": {"status": "Synced", "revision": "main"}}},
+  {"metadata": {"name": "kafka", "annotations": {"argocd.argoproj.io/sync-wave": "2"}},
+   "status": {"health": {"status": "Degraded"}, "sync": {"status": "Synced", "revision": "main"}}}
+]}`
+
+func TestStatusService_GetStatus(t *testing.T) {
+	t.Run("releases and applications", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm status argo-cd", &executor.CommandResult{Stdout: argoCDReleaseJSON})
+		mockExec.SetResponse("helm status app-of-apps", &executor.CommandResult{Stdout: `{"name": "app-of-apps", "namespace": "argocd", "version": 1, "info": {"status": "failed"}}`})
+		mockExec.SetResponse("get applications.argoproj.io", &executor.CommandResult{Stdout: applicationsJSON})
+
+		report, err := NewStatusService(mockExec).GetStatus(context.Background(), "k3d-dev")
+		require.NoError(t, err)
+
+		assert.Equal(t, "openframe.io/v1alpha1", report.APIVersion)
+		assert.Equal(t, models.ChartStatusKind, report.Kind)
+		assert.Equal(t, "k3d-dev", report.Context)
+		require.Len(t, report.Releases, 2)
+		assert.Equal(t, "8.1.4", report.Releases[0].Version)
+		assert.Equal(t, 2, report.Releases[0].Revision)
+		assert.Equal(t, "failed", report.Releases[1].Status)
+		require.Len(t, report.Applications, 2)
+		assert.Equal(t, []string{"kafka"}, report.DegradedApplications)
+		assert.Equal(t, []string{"release app-of-apps is failed", "application kafka is degraded"}, report.Problems())
+
+		assert.True(t, mockExec.WasCommandExecuted("--kube-context k3d-dev"))
+		assert.True(t, mockExec.WasCommandExecuted("kubectl --context k3d-dev"))
+	})
+
+	t.Run("nothing installed", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm status", &executor.CommandResult{ExitCode: 1, Stderr: "Error: release: not found"})
+
+		report, err := NewStatusService(mockExec).GetStatus(context.Background(), "")
+		require.NoError(t, err)
+
+		for _, release := range report.Releases {
+			assert.Equal(t, models.ReleaseStatusNotInstalled, release.Status)
+		}
+		assert.Empty(t, report.Applications)
+		assert.False(t, mockExec.WasCommandExecuted("kubectl"), "applications are not listed without ArgoCD")
+		assert.Len(t, report.Problems(), 2)
+	})
+
+	t.Run("application listing fails", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm status", &executor.CommandResult{Stdout: argoCDReleaseJSON})
+		mockExec.SetResponse("get applications.argoproj.io", &executor.CommandResult{ExitCode: 1, Stderr: "the server doesn't have a resource type"})
+
+		report, err := NewStatusService(mockExec).GetStatus(context.Background(), "")
+		require.NoError(t, err)
+		assert.NotEmpty(t, report.ApplicationsError)
+		assert.Empty(t, report.Problems())
+	})
+
+	t.Run("cluster unreachable", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm status", &executor.CommandResult{ExitCode: 1, Stderr: "Kubernetes cluster unreachable"})
+
+		_, err := NewStatusService(mockExec).GetStatus(context.Background(), "")
+		assert.ErrorContains(t, err, "failed to get status of release argo-cd")
+	})
+}
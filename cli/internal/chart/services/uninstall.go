@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flamingo/openframe/internal/chart/models"
+	"github.com/flamingo/openframe/internal/chart/providers/argocd"
+	"github.com/flamingo/openframe/internal/chart/providers/helm"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/pterm/pterm"
+)
+
+// ApplicationNamespaces are the namespaces the OpenFrame applications are deployed to
+var ApplicationNamespaces = []string{"platform", "datasources", "microservices", "integrated-tools", "client-tools"}
+
+// UninstallOptions controls a chart uninstall
+type UninstallOptions struct {
+	KubeContext string        // Empty for the current kubeconfig context
+	KeepData    bool          // Keep persistent volume claims and the namespaces that hold them
+	Timeout     time.Duration // Time ArgoCD gets to prune each sync wave
+}
+
+// Uninstaller tears down what chart install deployed, in the reverse order of installation
+type Uninstaller struct {
+	helmManager *helm.HelmManager
+	argoCD      *argocd.Manager
+}
+
+// NewUninstaller creates a chart uninstaller
+func NewUninstaller(exec executor.CommandExecutor) *Uninstaller {
+	return &Uninstaller{
+		helmManager: helm.NewHelmManager(exec),
+		argoCD:      argocd.NewManager(exec),
+	}
+}
+
+// Uninstall removes app-of-apps, lets ArgoCD prune the applications from the last sync wave
+// to the first, then removes ArgoCD, its CRDs and the application namespaces
+// Every step tolerates what is already gone, so an interrupted uninstall can be run again.
+func (u *Uninstaller) Uninstall(ctx context.Context, opts UninstallOptions) error {
+	kubeContext := opts.KubeContext
+
+	var apps []argocd.Application
+	hasRoot := false
+	if u.argoCD.HasApplicationCRD(ctx, kubeContext) {
+		listed, err := u.argoCD.ListApplications(ctx, kubeContext)
+		if err != nil {
+			return err
+		}
+		// The root application is handled separately, it is orphaned rather than pruned
+		for _, app := range listed {
+			if app.Name == models.RootApplication {
+				hasRoot = true
+				continue
+			}
+			apps = append(apps, app)
+		}
+	}
+
+	if opts.KeepData {
+		for _, namespace := range ApplicationNamespaces {
+			if err := u.argoCD.ProtectVolumeClaims(ctx, kubeContext, namespace); err != nil {
+				pterm.Warning.Println(err.Error())
+			}
+		}
+	}
+
+	// Step 1: Remove app-of-apps, keeping its applications so they can be pruned in order
+	// The root application has the resources finalizer, so deleting it through helm uninstall
+	// would prune every application at once. It is deleted without the finalizer first, which
+	// also stops it from recreating its children during the prune.
+	spinner, _ := pterm.DefaultSpinner.Start("Removing app-of-apps...")
+	if hasRoot {
+		if err := u.argoCD.OrphanApplication(ctx, kubeContext, models.RootApplication); err != nil {
+			spinner.Fail("Failed to remove app-of-apps")
+			return err
+		}
+	}
+	for _, app := range apps {
+		if err := u.argoCD.DetachApplication(ctx, kubeContext, app.Name); err != nil {
+			spinner.Fail("Failed to remove app-of-apps")
+			return err
+		}
+	}
+	if err := u.helmManager.UninstallRelease(ctx, kubeContext, "app-of-apps", "argocd"); err != nil {
+		spinner.Fail("Failed to remove app-of-apps")
+		return err
+	}
+	spinner.Success("Removed app-of-apps")
+
+	// Step 2: Prune applications in reverse sync wave order
+	for _, wave := range reverseSyncWaves(apps) {
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Pruning sync wave %d (%d applications)...", wave.number, len(wave.names)))
+		if err := u.argoCD.DeleteApplications(ctx, kubeContext, wave.names, opts.Timeout); err != nil {
+			spinner.Fail(fmt.Sprintf("Sync wave %d was not pruned", wave.number))
+			return err
+		}
+		spinner.Success(fmt.Sprintf("Pruned sync wave %d (%d applications)", wave.number, len(wave.names)))
+	}
+
+	// Step 3: Remove ArgoCD and the CRDs its chart leaves behind
+	spinner, _ = pterm.DefaultSpinner.Start("Removing ArgoCD...")
+	if err := u.helmManager.UninstallRelease(ctx, kubeContext, "argo-cd", "argocd"); err != nil {
+		spinner.Fail("Failed to remove ArgoCD")
+		return err
+	}
+	if err := u.argoCD.DeleteCRDs(ctx, kubeContext); err != nil {
+		spinner.Fail("Failed to remove ArgoCD")
+		return err
+	}
+	spinner.Success("Removed ArgoCD")
+
+	// Step 4: Remove the namespaces, unless they hold data that should be kept
+	var namespaces []string
+	if !opts.KeepData {
+		namespaces = append(namespaces, ApplicationNamespaces...)
+	}
+	namespaces = append(namespaces, "argocd")
+	spinner, _ = pterm.DefaultSpinner.Start("Removing namespaces...")
+	if err := u.argoCD.DeleteNamespaces(ctx, kubeContext, namespaces, opts.Timeout); err != nil {
+		spinner.Fail("Failed to remove namespaces")
+		return err
+	}
+	spinner.Success(fmt.Sprintf("Removed namespaces %v", namespaces))
+
+	if opts.KeepData {
+		pterm.Info.Printf("Kept the persistent volume claims in %v\n", ApplicationNamespaces)
+	}
+	return nil
+}
+
+// syncWave is a group of applications that ArgoCD syncs together
+type syncWave struct {
+	number int
+	names  []string
+}
+
+// reverseSyncWaves groups applications by sync wave, last wave first
+// The applications must be sorted by sync wave, as ListApplications returns them.
+func reverseSyncWaves(apps []argocd.Application) []syncWave {
+	var waves []syncWave
+	for _, app := range apps {
+		if len(waves) == 0 || waves[0].number != app.SyncWave {
+			waves = append([]syncWave{{number: app.SyncWave}}, waves...)
+		}
+		waves[0].names = append(waves[0].names, app.Name)
+	}
+	return waves
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flamingo/openframe/internal/chart/providers/argocd"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const uninstallApplicationsJSON = `{"items": [
+  {"metadata": {"name": "argocd-apps", "finalizers": ["resources-finalizer.argocd.argoproj.io"], "annotations": {"argocd.argoproj.io/sync-wave": "-1"}}},
+  {"metadata": {"name": "platform", "annotations": {"argocd.argoproj.io/sync-wave": "0"}}},
+  {"metadata": {"name": "mongodb", "annotations": {"argocd.argoproj.io/sync-wave": "2"}}},
+  {"metadata": {"name": "kafka", "annotations": {"argocd.argoproj.io/sync-wave": "2"}}},
+  {"metadata": {"name": "api", "annotations": {"argocd.argoproj.io/sync-wave": "5"}}}
+]}`
+
+func TestReverseSyncWaves(t *testing.T) {
+	apps := []argocd.Application{
+		{Name: "platform", SyncWave: 0},
+		{Name: "kafka", SyncWave: 2},
+		{Name: "mongodb", SyncWave: 2},
+		{Name: "api", SyncWave: 5},
+	}
+
+	waves := reverseSyncWaves(apps)
+	assert.Equal(t, []syncWave{
+		{number: 5, names: []string{"api"}},
+		{number: 2, names: []string{"kafka", "mongodb"}},
+		{number: 0, names: []string{"platform"}},
+	}, waves)
+
+	assert.Empty(t, reverseSyncWaves(nil))
+}
+
+// commandIndex returns the position of the first executed command containing pattern, or -1
+func commandIndex(commands []string, pattern string) int {
+	for i, command := range commands {
+		if strings.Contains(command, pattern) {
+			return i
+		}
+	}
+	return -1
+}
+
+// countCommands returns how many executed commands contain pattern
+func countCommands(commands []string, pattern string) int {
+	count := 0
+	for _, command := range commands {
+		if strings.Contains(command, pattern) {
+			count++
+		}
+	}
+	return count
+}
+
+func TestUninstaller_Uninstall(t *testing.T) {
+	installed := func() *executor.MockCommandExecutor {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("get applications.argoproj.io -o json", &executor.CommandResult{Stdout: uninstallApplicationsJSON})
+		mockExec.SetResponse("helm status", &executor.CommandResult{Stdout: `{"name": "release", "info": {"status": "deployed"}}`})
+		return mockExec
+	}
+
+	t.Run("tears down in reverse order", func(t *testing.T) {
+		mockExec := installed()
+		err := NewUninstaller(mockExec).Uninstall(context.Background(), UninstallOptions{KubeContext: "k3d-dev", Timeout: time.Minute})
+		require.NoError(t, err)
+
+		commands := mockExec.GetExecutedCommands()
+		steps := []string{
+			`patch applications.argoproj.io argocd-apps --type merge -p {"metadata":{"finalizers":null}}`,
+			"delete applications.argoproj.io argocd-apps --ignore-not-found",
+			"patch applications.argoproj.io platform",
+			"helm uninstall app-of-apps",
+			"delete applications.argoproj.io api ",
+			"delete applications.argoproj.io kafka mongodb ",
+			"delete applications.argoproj.io platform ",
+			"helm uninstall argo-cd",
+			"delete crd",
+			"delete namespace platform datasources microservices integrated-tools client-tools argocd",
+		}
+		previous := -1
+		for _, step := range steps {
+			index := commandIndex(commands, step)
+			require.NotEqual(t, -1, index, "missing step %q", step)
+			assert.Greater(t, index, previous, "step %q is out of order", step)
+			previous = index
+		}
+
+		// Deleting the root with its finalizer would prune every application at once
+		assert.Equal(t, 1, countCommands(commands, "patch applications.argoproj.io argocd-apps"), "the root application is only orphaned")
+		assert.Less(t, commandIndex(commands, "delete applications.argoproj.io argocd-apps"), commandIndex(commands, "helm uninstall app-of-apps"),
+			"the root application is gone before helm could delete it")
+		for _, command := range commands {
+			assert.NotContains(t, command, "helm.sh/resource-policy")
+			assert.NotContains(t, command, "wait --for=delete applications.argoproj.io/argocd-apps")
+		}
+		assert.False(t, mockExec.WasCommandExecuted("annotate pvc"))
+		for _, command := range commands {
+			assert.Contains(t, command, "k3d-dev", "every command targets the cluster context")
+		}
+	})
+
+	t.Run("keep data", func(t *testing.T) {
+		mockExec := installed()
+		err := NewUninstaller(mockExec).Uninstall(context.Background(), UninstallOptions{KeepData: true, Timeout: time.Minute})
+		require.NoError(t, err)
+
+		commands := mockExec.GetExecutedCommands()
+		assert.Less(t, commandIndex(commands, "-n datasources annotate pvc --all"), commandIndex(commands, "helm uninstall app-of-apps"),
+			"volume claims are protected before anything is pruned")
+		assert.Equal(t, "kubectl delete namespace argocd --ignore-not-found --wait --timeout=1m0s", mockExec.GetLastCommand())
+	})
+
+	t.Run("nothing installed", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("get crd", &executor.CommandResult{ExitCode: 1, Stderr: "NotFound"})
+		mockExec.SetResponse("helm status", &executor.CommandResult{ExitCode: 1, Stderr: "Error: release: not found"})
+
+		err := NewUninstaller(mockExec).Uninstall(context.Background(), UninstallOptions{Timeout: time.Minute})
+		require.NoError(t, err)
+
+		assert.False(t, mockExec.WasCommandExecuted("get applications.argoproj.io"))
+		assert.False(t, mockExec.WasCommandExecuted("helm uninstall"))
+		assert.True(t, mockExec.WasCommandExecuted("delete crd"), "leftover CRDs are still removed")
+		assert.True(t, mockExec.WasCommandExecuted("delete namespace"))
+	})
+
+	t.Run("prune failure stops the teardown", func(t *testing.T) {
+		mockExec := installed()
+		mockExec.SetResponse("wait --for=delete", &executor.CommandResult{ExitCode: 1, Stderr: "timed out"})
+
+		err := NewUninstaller(mockExec).Uninstall(context.Background(), UninstallOptions{Timeout: time.Minute})
+		assert.ErrorContains(t, err, "applications were not pruned")
+		assert.False(t, mockExec.WasCommandExecuted("helm uninstall argo-cd"))
+	})
+}
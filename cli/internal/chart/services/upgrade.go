@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/flamingo/openframe/internal/chart/models"
+	"github.com/flamingo/openframe/internal/chart/providers/argocd"
+	"github.com/flamingo/openframe/internal/chart/providers/git"
+	"github.com/flamingo/openframe/internal/chart/providers/helm"
+	"github.com/flamingo/openframe/internal/chart/ui/templates"
+	"github.com/flamingo/openframe/internal/chart/utils/config"
+	chartErrors "github.com/flamingo/openframe/internal/chart/utils/errors"
+	"github.com/flamingo/openframe/internal/chart/utils/types"
+	sharedErrors "github.com/flamingo/openframe/internal/shared/errors"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/pterm/pterm"
+	"gopkg.in/yaml.v3"
+)
+
+// certificateValueKeys are the values install fills from the generated certificates
+// An upgrade carries them over, so certificates are not regenerated.
+var certificateValueKeys = []string{
+	"deployment.oss.ingress.localhost.tls.cert",
+	"deployment.oss.ingress.localhost.tls.key",
+}
+
+// UpgradeOptions controls a chart upgrade
+type UpgradeOptions struct {
+	KubeContext string        // Empty for the current kubeconfig context
+	ClusterName string        // Cluster whose saved state is updated, empty to leave the state alone
+	Branch      string        // Manifests branch, empty to keep the branch of the values
+	ValuesFile  string        // Base values, empty to start from the deployed values
+	GitHubRepo  string        // Repository the app-of-apps chart is cloned from, empty for the default
+	Timeout     time.Duration // Time for the Helm upgrade and for the changed applications to sync
+}
+
+// UpgradePlan is what an upgrade changes on a live install
+type UpgradePlan struct {
+	Branch   string
+	Deployed map[string]interface{}
+	Desired  map[string]interface{}
+	Changes  []models.ValueChange
+}
+
+// HasChanges reports whether the upgrade changes any Helm value
+func (p *UpgradePlan) HasChanges() bool {
+	return len(p.Changes) > 0
+}
+
+// Upgrader changes the branch or values of a live install without reinstalling it
+type Upgrader struct {
+	helmManager *helm.HelmManager
+	argoCD      *argocd.Manager
+	gitRepo     *git.Repository
+	modifier    *templates.HelmValuesModifier
+}
+
+// NewUpgrader creates a chart upgrader
+func NewUpgrader(exec executor.CommandExecutor) *Upgrader {
+	return &Upgrader{
+		helmManager: helm.NewHelmManager(exec),
+		argoCD:      argocd.NewManager(exec),
+		gitRepo:     git.NewRepository(exec),
+		modifier:    templates.NewHelmValuesModifier(),
+	}
+}
+
+// Plan computes the new app-of-apps values and how they differ from the deployed ones
+// The new values start from the values file, or from the deployed values when there is none.
+func (u *Upgrader) Plan(ctx context.Context, opts UpgradeOptions) (*UpgradePlan, error) {
+	deployed, err := u.helmManager.GetReleaseValues(ctx, opts.KubeContext, "app-of-apps", "argocd")
+	if err != nil {
+		if errors.Is(err, chartErrors.ErrChartNotFound) {
+			return nil, fmt.Errorf("app-of-apps is not installed, run 'openframe chart install' first")
+		}
+		return nil, err
+	}
+
+	var desired map[string]interface{}
+	if opts.ValuesFile != "" {
+		if desired, err = u.modifier.LoadExistingValues(opts.ValuesFile); err != nil {
+			return nil, err
+		}
+	} else if desired, err = copyValues(deployed); err != nil {
+		return nil, err
+	}
+
+	for _, key := range certificateValueKeys {
+		if _, ok := u.modifier.LookupValue(desired, key); ok {
+			continue
+		}
+		if value, ok := u.modifier.LookupValue(deployed, key); ok {
+			if err := u.modifier.SetValueAt(desired, key, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	branch := opts.Branch
+	if branch != "" {
+		if err := u.modifier.ApplyConfiguration(desired, &types.ChartConfiguration{Branch: &branch}); err != nil {
+			return nil, err
+		}
+	} else {
+		branch = u.modifier.GetCurrentBranch(desired)
+	}
+
+	return &UpgradePlan{
+		Branch:   branch,
+		Deployed: deployed,
+		Desired:  desired,
+		Changes:  models.DiffValues(deployed, desired),
+	}, nil
+}
+
+// Apply upgrades app-of-apps to the planned values and waits for the applications
+// whose target revision changed. It returns the names of those applications.
+func (u *Upgrader) Apply(ctx context.Context, opts UpgradeOptions, plan *UpgradePlan) ([]string, error) {
+	kubeContext := opts.KubeContext
+
+	// Remember the target revisions, so only the applications the upgrade changes are waited for
+	before := map[string]string{}
+	if u.argoCD.HasApplicationCRD(ctx, kubeContext) {
+		var err error
+		if before, err = u.argoCD.TargetRevisions(ctx, kubeContext); err != nil {
+			return nil, err
+		}
+	}
+
+	valuesFile, err := writeValuesFile(plan.Desired)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(valuesFile)
+
+	appConfig := models.NewAppOfAppsConfig()
+	if opts.GitHubRepo != "" {
+		appConfig.GitHubRepo = opts.GitHubRepo
+	}
+	appConfig.GitHubBranch = plan.Branch
+
+	pterm.Info.Printf("Using branch '%s'...\n", plan.Branch)
+	cloneResult, err := u.gitRepo.CloneChartRepository(ctx, appConfig)
+	if err != nil {
+		if strings.Contains(err.Error(), "branch") && strings.Contains(err.Error(), "does not exist") {
+			return nil, sharedErrors.NewBranchNotFoundError(plan.Branch)
+		}
+		return nil, err
+	}
+	defer u.gitRepo.Cleanup(cloneResult.TempDir)
+
+	spinner, _ := pterm.DefaultSpinner.Start("Upgrading app-of-apps...")
+	if err := u.helmManager.UpgradeAppOfApps(ctx, kubeContext, cloneResult.ChartPath, valuesFile, opts.Timeout.String()); err != nil {
+		spinner.Fail("Failed to upgrade app-of-apps")
+		return nil, err
+	}
+	spinner.Success("Upgraded app-of-apps")
+
+	changed, err := u.argoCD.WaitForRevisions(ctx, kubeContext, before, opts.Timeout)
+	if err != nil {
+		return changed, err
+	}
+
+	if opts.ClusterName != "" {
+		recordInstallationInDefaultStore(config.ChartInstallConfig{ClusterName: opts.ClusterName, AppOfApps: appConfig}, valuesFile)
+	}
+	return changed, nil
+}
+
+// writeValuesFile writes values to a temporary file only the current user can read, since they hold credentials
+func writeValuesFile(values map[string]interface{}) (string, error) {
+	data, err := yaml.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal helm values: %w", err)
+	}
+
+	file, err := os.CreateTemp("", "openframe-values-*.yaml")
+	if err != nil {
+		return "", fmt.Errorf("failed to create helm values file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(data); err != nil {
+		os.Remove(file.Name())
+		return "", fmt.Errorf("failed to write helm values file: %w", err)
+	}
+	return file.Name(), nil
+}
+
+// copyValues returns a deep copy of Helm values
+func copyValues(values map[string]interface{}) (map[string]interface{}, error) {
+	data, err := yaml.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy helm values: %w", err)
+	}
+	copied := make(map[string]interface{})
+	if err := yaml.Unmarshal(data, &copied); err != nil {
+		return nil, fmt.Errorf("failed to copy helm values: %w", err)
+	}
+	return copied, nil
+}
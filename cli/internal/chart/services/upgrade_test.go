@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flamingo/openframe/internal/chart/models"
+	sharedErrors "github.com/flamingo/openframe/internal/shared/errors"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const deployedValuesYAML = `global:
+  repoBranch: main
+  repoURL: https://github.com/test/repo.git
+registry:
+  docker:
+    password: hunter2
+deployment:
+  oss:
+    ingress:
+      localhost:
+        enabled: true
+        tls:
+          cert: CERT
+          key: KEY
+`
+
+func TestUpgrader_Plan(t *testing.T) {
+	deployed := func() *executor.MockCommandExecutor {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm get values app-of-apps", &executor.CommandResult{Stdout: deployedValuesYAML})
+		return mockExec
+	}
+
+	t.Run("branch switch keeps the deployed values", func(t *testing.T) {
+		mockExec := deployed()
+		plan, err := NewUpgrader(mockExec).Plan(context.Background(), UpgradeOptions{KubeContext: "k3d-dev", Branch: "develop"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "develop", plan.Branch)
+		assert.True(t, plan.HasChanges())
+		assert.Equal(t, []models.ValueChange{
+			{Path: "global.repoBranch", Kind: models.ValueChanged, Old: "main", New: "develop"},
+		}, plan.Changes)
+		assert.Equal(t, "main", plan.Deployed["global"].(map[string]interface{})["repoBranch"], "the deployed values are not modified")
+		assert.True(t, mockExec.WasCommandExecuted("--kube-context k3d-dev"))
+	})
+
+	t.Run("values file keeps the certificates", func(t *testing.T) {
+		valuesFile := filepath.Join(t.TempDir(), "values.yaml")
+		require.NoError(t, os.WriteFile(valuesFile, []byte("global:\n  repoBranch: release\n  repoURL: https://github.com/test/repo.git\nregistry:\n  docker:\n    password: changed\n"), 0600))
+
+		plan, err := NewUpgrader(deployed()).Plan(context.Background(), UpgradeOptions{ValuesFile: valuesFile})
+		require.NoError(t, err)
+
+		assert.Equal(t, "release", plan.Branch, "without --branch the branch of the values is used")
+		paths := make([]string, 0, len(plan.Changes))
+		for _, change := range plan.Changes {
+			paths = append(paths, change.Path)
+		}
+		assert.Equal(t, []string{"deployment.oss.ingress.localhost.enabled", "global.repoBranch", "registry.docker.password"}, paths)
+
+		tls := plan.Desired["deployment"].(map[string]interface{})["oss"].(map[string]interface{})["ingress"].(map[string]interface{})["localhost"].(map[string]interface{})["tls"]
+		assert.Equal(t, map[string]interface{}{"cert": "CERT", "key": "KEY"}, tls)
+	})
+
+	t.Run("nothing changes", func(t *testing.T) {
+		plan, err := NewUpgrader(deployed()).Plan(context.Background(), UpgradeOptions{Branch: "main"})
+		require.NoError(t, err)
+		assert.False(t, plan.HasChanges())
+	})
+
+	t.Run("not installed", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("helm get values", &executor.CommandResult{ExitCode: 1, Stderr: "Error: release: not found"})
+
+		_, err := NewUpgrader(mockExec).Plan(context.Background(), UpgradeOptions{Branch: "develop"})
+		assert.ErrorContains(t, err, "app-of-apps is not installed")
+	})
+}
+
+func TestUpgrader_ApplyUnknownBranch(t *testing.T) {
+	mockExec := executor.NewMockCommandExecutor()
+	mockExec.SetResponse("get applications.argoproj.io", &executor.CommandResult{Stdout: `{"items": []}`})
+	mockExec.SetResponse("git clone", &executor.CommandResult{ExitCode: 128, Stderr: "warning: Could not find remote branch nope to clone.\nfatal: Remote branch nope not found in upstream origin"})
+
+	plan := &UpgradePlan{Branch: "nope", Desired: map[string]interface{}{}}
+	_, err := NewUpgrader(mockExec).Apply(context.Background(), UpgradeOptions{Timeout: time.Minute}, plan)
+
+	var branchErr *sharedErrors.BranchNotFoundError
+	assert.ErrorAs(t, err, &branchErr)
+	assert.True(t, mockExec.WasCommandExecuted("get applications.argoproj.io"), "target revisions are recorded before the upgrade")
+	assert.False(t, mockExec.WasCommandExecuted("helm upgrade"))
+}
+
+func TestWriteValuesFile(t *testing.T) {
+	path, err := writeValuesFile(map[string]interface{}{"global": map[string]interface{}{"repoBranch": "develop"}})
+	require.NoError(t, err)
+	defer os.Remove(path)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm(), "values hold credentials")
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "global:\n    repoBranch: develop\n", string(data))
+}
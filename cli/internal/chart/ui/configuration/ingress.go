@@ -94,35 +94,7 @@ func (i *IngressConfigurator) configureNgrok(existingValues map[string]interface
 
 // getCurrentNgrokSettings extracts current Ngrok settings from existing values
 func (i *IngressConfigurator) getCurrentNgrokSettings(values map[string]interface{}) *types.NgrokConfig {
-	current := &types.NgrokConfig{}
-
-	if deployment, ok := values["deployment"].(map[string]interface{}); ok {
-		if oss, ok := deployment["oss"].(map[string]interface{}); ok {
-			if ingress, ok := oss["ingress"].(map[string]interface{}); ok {
-				if ngrok, ok := ingress["ngrok"].(map[string]interface{}); ok {
-					// Extract URL/Domain
-					if url, ok := ngrok["url"].(string); ok {
-						current.Domain = url
-					}
-
-					// Extract credentials
-					if credentials, ok := ngrok["credentials"].(map[string]interface{}); ok {
-						if apiKey, ok := credentials["apiKey"].(string); ok {
-							current.APIKey = apiKey
-						}
-						// Check both possible field names for auth token
-						if authToken, ok := credentials["authToken"].(string); ok {
-							current.AuthToken = authToken
-						} else if authToken, ok := credentials["authtoken"].(string); ok {
-							current.AuthToken = authToken
-						}
-					}
-				}
-			}
-		}
-	}
-
-	return current
+	return i.modifier.GetCurrentNgrokSettings(values)
 }
 
 // collectNgrokCredentials collects all required Ngrok credentials
@@ -225,34 +197,7 @@ func (i *IngressConfigurator) applyLocalhostConfig(values map[string]interface{}
 		return fmt.Errorf("values map is nil")
 	}
 
-	deployment, ok := values["deployment"].(map[string]interface{})
-	if !ok {
-		deployment = make(map[string]interface{})
-		values["deployment"] = deployment
-	}
-
-	oss, ok := deployment["oss"].(map[string]interface{})
-	if !ok {
-		oss = make(map[string]interface{})
-		deployment["oss"] = oss
-	}
-
-	ingress, ok := oss["ingress"].(map[string]interface{})
-	if !ok {
-		ingress = make(map[string]interface{})
-		oss["ingress"] = ingress
-	}
-
-	// Configure localhost ingress
-	ingress["localhost"] = map[string]interface{}{
-		"enabled": true,
-	}
-
-	// Disable ngrok if it exists
-	if ngrokSection, ok := ingress["ngrok"].(map[string]interface{}); ok {
-		ngrokSection["enabled"] = false
-	}
-
+	i.modifier.ApplyLocalhostIngress(values)
 	return nil
 }
 
@@ -263,45 +208,6 @@ func (i *IngressConfigurator) applyNgrokConfig(values map[string]interface{}, ng
 		return fmt.Errorf("values map is nil")
 	}
 
-	deployment, ok := values["deployment"].(map[string]interface{})
-	if !ok {
-		deployment = make(map[string]interface{})
-		values["deployment"] = deployment
-	}
-
-	oss, ok := deployment["oss"].(map[string]interface{})
-	if !ok {
-		oss = make(map[string]interface{})
-		deployment["oss"] = oss
-	}
-
-	ingress, ok := oss["ingress"].(map[string]interface{})
-	if !ok {
-		ingress = make(map[string]interface{})
-		oss["ingress"] = ingress
-	}
-
-	// Configure ngrok ingress
-	ngrokSection := map[string]interface{}{
-		"enabled": true,
-		"url":     ngrokConfig.Domain,
-		"credentials": map[string]interface{}{
-			"apiKey":    ngrokConfig.APIKey,
-			"authToken": ngrokConfig.AuthToken,
-		},
-	}
-
-	// Add IP allowlist configuration if specified
-	if ngrokConfig.UseAllowedIPs && len(ngrokConfig.AllowedIPs) > 0 {
-		ngrokSection["allowedIPs"] = ngrokConfig.AllowedIPs
-	}
-
-	ingress["ngrok"] = ngrokSection
-
-	// Disable localhost if it exists
-	if localhostSection, ok := ingress["localhost"].(map[string]interface{}); ok {
-		localhostSection["enabled"] = false
-	}
-
+	i.modifier.ApplyNgrokIngress(values, ngrokConfig)
 	return nil
 }
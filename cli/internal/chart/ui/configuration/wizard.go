@@ -122,6 +122,72 @@ func (w *ConfigurationWizard) configureInteractive() (*types.ChartConfiguration,
 	return config, nil
 }
 
+// ConfigureNonInteractive builds the configuration from command line settings without prompting
+// It goes through the same temporary values file as the wizard, so scripted and interactive installs match.
+func (w *ConfigurationWizard) ConfigureNonInteractive(scripted *types.ScriptedConfiguration) (*types.ChartConfiguration, error) {
+	pterm.Info.Println("Using command line configuration for chart installation")
+
+	config, err := w.loadValuesFrom(scripted.ValuesFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load base values: %w", err)
+	}
+
+	// Apply overrides up front as well, so ngrok settings given with --set are picked up below
+	for _, override := range scripted.Overrides {
+		if err := w.modifier.SetValue(config.ExistingValues, override); err != nil {
+			return nil, err
+		}
+	}
+	config.ValueOverrides = scripted.Overrides
+
+	if scripted.Branch != "" {
+		branch := scripted.Branch
+		config.Branch = &branch
+		config.ModifiedSections = append(config.ModifiedSections, "branch")
+	}
+
+	switch scripted.Ingress {
+	case "":
+	case types.IngressTypeLocalhost:
+		config.IngressConfig = &types.IngressConfig{Type: types.IngressTypeLocalhost}
+		config.ModifiedSections = append(config.ModifiedSections, "ingress")
+	case types.IngressTypeNgrok:
+		ngrokConfig := w.modifier.GetCurrentNgrokSettings(config.ExistingValues)
+		if ngrokConfig.Domain == "" || ngrokConfig.APIKey == "" || ngrokConfig.AuthToken == "" {
+			return nil, fmt.Errorf("ngrok ingress requires deployment.oss.ingress.ngrok.url, .credentials.apiKey and .credentials.authToken in the values file or --set")
+		}
+		config.IngressConfig = &types.IngressConfig{Type: types.IngressTypeNgrok, NgrokConfig: ngrokConfig}
+		config.ModifiedSections = append(config.ModifiedSections, "ingress")
+	default:
+		return nil, fmt.Errorf("unknown ingress type %q, expected localhost or ngrok", scripted.Ingress)
+	}
+
+	if err := w.createTemporaryValuesFile(config); err != nil {
+		return nil, fmt.Errorf("failed to create temporary values file: %w", err)
+	}
+
+	w.ShowConfigurationSummary(config)
+	return config, nil
+}
+
+// loadValuesFrom loads base values from a given file, or from the current directory when none is given
+func (w *ConfigurationWizard) loadValuesFrom(valuesFile string) (*types.ChartConfiguration, error) {
+	if valuesFile == "" {
+		return w.loadBaseValues()
+	}
+
+	values, err := w.modifier.LoadExistingValues(valuesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ChartConfiguration{
+		BaseHelmValuesPath: valuesFile,
+		ExistingValues:     values,
+		ModifiedSections:   make([]string, 0),
+	}, nil
+}
+
 // loadBaseValues loads base values from current directory or creates default
 func (w *ConfigurationWizard) loadBaseValues() (*types.ChartConfiguration, error) {
 	values, err := w.modifier.LoadOrCreateBaseValues()
@@ -208,4 +208,69 @@ registry:
 	assert.Equal(t, "newuser", docker["username"])
 	assert.Equal(t, "newpass", docker["password"])
 	assert.Equal(t, "new@example.com", docker["email"])
-}
\ No newline at end of file
+}
+func TestConfigurationWizard_ConfigureNonInteractive(t *testing.T) {
+	tmpDir := t.TempDir()
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	defer os.Chdir(originalDir)
+	require.NoError(t, os.Chdir(tmpDir))
+
+	valuesFile := filepath.Join(tmpDir, "ci-values.yaml")
+	require.NoError(t, os.WriteFile(valuesFile, []byte(`global:
+  repoBranch: main
+deployment:
+  oss:
+    ingress:
+      ngrok:
+        url: example.ngrok-free.app
+        credentials:
+          apiKey: api-key
+`), 0644))
+
+	wizard := NewConfigurationWizard()
+
+	t.Run("values file, branch and overrides", func(t *testing.T) {
+		config, err := wizard.ConfigureNonInteractive(&types.ScriptedConfiguration{
+			ValuesFile: valuesFile,
+			Overrides:  []string{"registry.docker.username=ci"},
+			Ingress:    types.IngressTypeLocalhost,
+			Branch:     "develop",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, valuesFile, config.BaseHelmValuesPath)
+		assert.Equal(t, []string{"branch", "ingress"}, config.ModifiedSections)
+
+		written, err := wizard.modifier.LoadExistingValues(config.TempHelmValuesPath)
+		require.NoError(t, err)
+		assert.Equal(t, "develop", wizard.modifier.GetCurrentBranch(written))
+		assert.Equal(t, "localhost", wizard.modifier.GetCurrentIngressSettings(written))
+		assert.Equal(t, "ci", wizard.modifier.GetCurrentDockerSettings(written).Username)
+	})
+
+	t.Run("ngrok needs credentials", func(t *testing.T) {
+		_, err := wizard.ConfigureNonInteractive(&types.ScriptedConfiguration{
+			ValuesFile: valuesFile,
+			Ingress:    types.IngressTypeNgrok,
+		})
+		assert.ErrorContains(t, err, "ngrok ingress requires")
+	})
+
+	t.Run("ngrok credentials from --set", func(t *testing.T) {
+		config, err := wizard.ConfigureNonInteractive(&types.ScriptedConfiguration{
+			ValuesFile: valuesFile,
+			Overrides:  []string{"deployment.oss.ingress.ngrok.credentials.authToken=auth-token"},
+			Ingress:    types.IngressTypeNgrok,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "auth-token", config.IngressConfig.NgrokConfig.AuthToken)
+		assert.Equal(t, "example.ngrok-free.app", config.IngressConfig.NgrokConfig.Domain)
+	})
+
+	t.Run("missing values file", func(t *testing.T) {
+		_, err := wizard.ConfigureNonInteractive(&types.ScriptedConfiguration{
+			ValuesFile: filepath.Join(tmpDir, "missing.yaml"),
+		})
+		assert.Error(t, err)
+	})
+}
@@ -3,8 +3,12 @@ package ui
 import (
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/flamingo/openframe/internal/chart/models"
+	sharedUI "github.com/flamingo/openframe/internal/shared/ui"
 	"github.com/pterm/pterm"
 )
 
@@ -61,6 +65,196 @@ func (d *DisplayService) ShowDryRunResults(w io.Writer, results []string) {
 	}
 }
 
+// ShowChartStatus displays the Helm releases and ArgoCD applications of a chart status report
+// Release notes are long, so they are only shown when showNotes is set.
+func (d *DisplayService) ShowChartStatus(report *models.ChartStatusReport, showNotes bool) {
+	if report.Context != "" {
+		pterm.Info.Printf("Context: %s\n", report.Context)
+	}
+
+	fmt.Println()
+	pterm.Info.Println("📦 Helm Releases:")
+	releaseData := pterm.TableData{{"NAME", "NAMESPACE", "STATUS", "REVISION", "CHART", "APP VERSION", "LAST DEPLOYED"}}
+	for _, release := range report.Releases {
+		releaseData = append(releaseData, []string{
+			release.Name,
+			release.Namespace,
+			colorReleaseStatus(release.Status),
+			valueOrDash(revisionString(release.Revision)),
+			valueOrDash(release.Version),
+			valueOrDash(release.AppVersion),
+			valueOrDash(deployedAgo(release.LastDeployed)),
+		})
+	}
+	sharedUI.RenderTableWithFallback(releaseData, true)
+
+	if showNotes {
+		for _, release := range report.Releases {
+			if release.Notes == "" {
+				continue
+			}
+			fmt.Println()
+			pterm.Info.Printf("Notes for %s:\n", release.Name)
+			fmt.Println(release.Notes)
+		}
+	}
+
+	fmt.Println()
+	pterm.Info.Println("🚢 ArgoCD Applications:")
+	if len(report.Applications) == 0 {
+		if report.ApplicationsError != "" {
+			pterm.Printf("  Application status not available: %s\n", report.ApplicationsError)
+		} else {
+			pterm.Printf("  No ArgoCD applications found (install with: openframe chart install)\n")
+		}
+		return
+	}
+
+	appData := pterm.TableData{{"NAME", "WAVE", "SYNC", "HEALTH", "REVISION", "MESSAGE"}}
+	for _, app := range report.Applications {
+		appData = append(appData, []string{
+			app.Name,
+			strconv.Itoa(app.SyncWave),
+			app.Sync,
+			colorApplicationHealth(app.Health),
+			valueOrDash(shortRevision(app.Revision)),
+			truncate(app.Message, 60),
+		})
+	}
+	sharedUI.RenderTableWithFallback(appData, true)
+}
+
+// ShowValuesDiff displays changed Helm values, one line per value, with secrets redacted
+func (d *DisplayService) ShowValuesDiff(changes []models.ValueChange) {
+	if len(changes) == 0 {
+		pterm.Info.Println("No Helm value changes")
+		return
+	}
+
+	for _, change := range changes {
+		change = change.Redacted()
+		switch change.Kind {
+		case models.ValueAdded:
+			pterm.FgGreen.Printf("  + %s: %s\n", change.Path, models.FormatValue(change.New))
+		case models.ValueRemoved:
+			pterm.FgRed.Printf("  - %s: %s\n", change.Path, models.FormatValue(change.Old))
+		default:
+			pterm.FgYellow.Printf("  ~ %s: %s → %s\n", change.Path, models.FormatValue(change.Old), models.FormatValue(change.New))
+		}
+	}
+}
+
+// ShowInstallPreview displays what chart install would change: the values against the base file
+// and the deployed release, and the applications it creates, changes or removes
+func (d *DisplayService) ShowInstallPreview(preview *models.InstallPreview) {
+	fmt.Println()
+	pterm.Info.Printf("📝 Generated values against %s:\n", preview.BaseValuesFile)
+	d.ShowValuesDiff(preview.BaseChanges)
+
+	fmt.Println()
+	if preview.Installed {
+		pterm.Info.Println("📝 Generated values against the deployed app-of-apps release:")
+		d.ShowValuesDiff(preview.DeployedChanges)
+	} else {
+		pterm.Info.Println("app-of-apps is not deployed yet")
+	}
+
+	fmt.Println()
+	pterm.Info.Println("🚢 ArgoCD Applications:")
+	if len(preview.Applications) == 0 {
+		pterm.Printf("  No application changes (%d unchanged)\n", preview.UnchangedApplications)
+		return
+	}
+
+	appData := pterm.TableData{{"NAME", "ACTION", "CHANGES"}}
+	for _, app := range preview.Applications {
+		appData = append(appData, []string{app.Name, colorApplicationAction(app.Action), valueOrDash(strings.Join(app.Changes, ", "))})
+	}
+	sharedUI.RenderTableWithFallback(appData, true)
+	pterm.Printf("  %d applications unchanged\n", preview.UnchangedApplications)
+}
+
+// colorApplicationAction colors what an install does to an application for display
+func colorApplicationAction(action models.ApplicationAction) string {
+	switch action {
+	case models.ApplicationCreated:
+		return pterm.Green(string(action))
+	case models.ApplicationRemoved:
+		return pterm.Red(string(action))
+	default:
+		return pterm.Yellow(string(action))
+	}
+}
+
+// colorReleaseStatus colors a Helm release status for display
+func colorReleaseStatus(status string) string {
+	switch {
+	case status == "deployed":
+		return pterm.Green(status)
+	case strings.HasPrefix(status, "pending"):
+		return pterm.Yellow(status)
+	case status == "failed":
+		return pterm.Red(status)
+	default:
+		return pterm.Gray(status)
+	}
+}
+
+// colorApplicationHealth colors an ArgoCD health status for display
+func colorApplicationHealth(health string) string {
+	switch health {
+	case "Healthy":
+		return pterm.Green(health)
+	case "Progressing", "Suspended":
+		return pterm.Yellow(health)
+	case "Degraded", "Missing":
+		return pterm.Red(health)
+	default:
+		return pterm.Gray(health)
+	}
+}
+
+// revisionString formats a release revision, leaving it empty when unknown
+func revisionString(revision int) string {
+	if revision == 0 {
+		return ""
+	}
+	return strconv.Itoa(revision)
+}
+
+// shortRevision abbreviates git commit SHAs for display
+func shortRevision(revision string) string {
+	if len(revision) == 40 {
+		return revision[:8]
+	}
+	return revision
+}
+
+// deployedAgo formats a deployment time with its age
+func deployedAgo(deployed time.Time) string {
+	if deployed.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%s (%s ago)", deployed.Local().Format("2006-01-02 15:04"), time.Since(deployed).Round(time.Minute))
+}
+
+// truncate shortens a message to a maximum length for table display
+func truncate(message string, max int) string {
+	message = strings.TrimSpace(strings.ReplaceAll(message, "\n", " "))
+	if len(message) <= max {
+		return message
+	}
+	return message[:max-3] + "..."
+}
+
+// valueOrDash shows a dash for empty table cells
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
 // getChartDisplayName returns a user-friendly display name for chart types
 func (d *DisplayService) getChartDisplayName(chartType models.ChartType) string {
 	switch chartType {
@@ -3,8 +3,10 @@ package ui
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/flamingo/openframe/internal/chart/models"
+	clusterDomain "github.com/flamingo/openframe/internal/cluster/models"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -157,3 +159,59 @@ func TestDisplayService_getChartDisplayName(t *testing.T) {
 		})
 	}
 }
+
+func TestDisplayService_ShowChartStatus(t *testing.T) {
+	service := NewDisplayService()
+
+	report := &models.ChartStatusReport{
+		Context: "k3d-dev",
+		Releases: []models.ChartInfo{
+			{Name: "argo-cd", Namespace: "argocd", Status: "deployed", Version: "8.1.4", Revision: 2, LastDeployed: time.Now().Add(-time.Hour), Notes: "notes"},
+			{Name: "app-of-apps", Namespace: "argocd", Status: models.ReleaseStatusNotInstalled},
+		},
+		Applications: []clusterDomain.ApplicationStatus{
+			{Name: "kafka", Sync: "Synced", Health: "Degraded", Revision: "0123456789abcdef0123456789abcdef01234567", SyncWave: 2},
+		},
+	}
+
+	assert.NotPanics(t, func() {
+		service.ShowChartStatus(report, true)
+		service.ShowChartStatus(&models.ChartStatusReport{ApplicationsError: "no CRD"}, false)
+	})
+}
+
+func TestChartStatusFormatting(t *testing.T) {
+	assert.Equal(t, "01234567", shortRevision("0123456789abcdef0123456789abcdef01234567"))
+	assert.Equal(t, "main", shortRevision("main"))
+	assert.Equal(t, "", revisionString(0))
+	assert.Equal(t, "3", revisionString(3))
+	assert.Equal(t, "", deployedAgo(time.Time{}))
+	assert.Equal(t, "-", valueOrDash(""))
+	assert.Equal(t, "sync failed: one two", truncate("sync failed:\none two", 60))
+	assert.Equal(t, "abcdefg...", truncate("abcdefghijklmnop", 10))
+}
+
+func TestDisplayService_ShowInstallPreview(t *testing.T) {
+	service := NewDisplayService()
+
+	preview := &models.InstallPreview{
+		BaseValuesFile: "helm-values.yaml",
+		BaseChanges: []models.ValueChange{
+			{Path: "global.repoBranch", Kind: models.ValueChanged, Old: "main", New: "develop"},
+			{Path: "registry.docker.password", Kind: models.ValueAdded, New: "secret"},
+		},
+		Installed:       true,
+		DeployedChanges: []models.ValueChange{{Path: "apps.kafka.enabled", Kind: models.ValueRemoved, Old: true}},
+		Applications: []models.ApplicationChange{
+			{Name: "api", Action: models.ApplicationCreated},
+			{Name: "platform", Action: models.ApplicationChanged, Changes: []string{"targetRevision: main → develop"}},
+		},
+		UnchangedApplications: 12,
+	}
+
+	assert.NotPanics(t, func() {
+		service.ShowInstallPreview(preview)
+		service.ShowInstallPreview(&models.InstallPreview{BaseValuesFile: "helm-values.yaml"})
+		service.ShowValuesDiff(nil)
+	})
+}
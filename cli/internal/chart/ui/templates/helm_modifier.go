@@ -3,6 +3,8 @@ package templates
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/flamingo/openframe/internal/chart/utils/types"
 	"gopkg.in/yaml.v3"
@@ -120,6 +122,210 @@ func (h *HelmValuesModifier) ApplyConfiguration(values map[string]interface{}, c
 		docker["email"] = config.DockerRegistry.Email
 	}
 
+	// Update ingress if it was modified
+	if config.IngressConfig != nil {
+		switch config.IngressConfig.Type {
+		case types.IngressTypeLocalhost:
+			h.ApplyLocalhostIngress(values)
+		case types.IngressTypeNgrok:
+			if config.IngressConfig.NgrokConfig == nil {
+				return fmt.Errorf("ngrok ingress requires ngrok settings")
+			}
+			h.ApplyNgrokIngress(values, config.IngressConfig.NgrokConfig)
+		default:
+			return fmt.Errorf("unknown ingress type %q", config.IngressConfig.Type)
+		}
+	}
+
+	// Apply explicit overrides last so they win over every other section
+	for _, override := range config.ValueOverrides {
+		if err := h.SetValue(values, override); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ingressSection returns deployment.oss.ingress, creating the missing levels
+func (h *HelmValuesModifier) ingressSection(values map[string]interface{}) map[string]interface{} {
+	deployment, ok := values["deployment"].(map[string]interface{})
+	if !ok {
+		deployment = make(map[string]interface{})
+		values["deployment"] = deployment
+	}
+
+	oss, ok := deployment["oss"].(map[string]interface{})
+	if !ok {
+		oss = make(map[string]interface{})
+		deployment["oss"] = oss
+	}
+
+	ingress, ok := oss["ingress"].(map[string]interface{})
+	if !ok {
+		ingress = make(map[string]interface{})
+		oss["ingress"] = ingress
+	}
+
+	return ingress
+}
+
+// ApplyLocalhostIngress enables localhost ingress and disables ngrok
+func (h *HelmValuesModifier) ApplyLocalhostIngress(values map[string]interface{}) {
+	ingress := h.ingressSection(values)
+
+	ingress["localhost"] = map[string]interface{}{
+		"enabled": true,
+	}
+
+	// Disable ngrok if it exists
+	if ngrokSection, ok := ingress["ngrok"].(map[string]interface{}); ok {
+		ngrokSection["enabled"] = false
+	}
+}
+
+// ApplyNgrokIngress enables ngrok ingress with the given settings and disables localhost
+func (h *HelmValuesModifier) ApplyNgrokIngress(values map[string]interface{}, ngrokConfig *types.NgrokConfig) {
+	ingress := h.ingressSection(values)
+
+	ngrokSection := map[string]interface{}{
+		"enabled": true,
+		"url":     ngrokConfig.Domain,
+		"credentials": map[string]interface{}{
+			"apiKey":    ngrokConfig.APIKey,
+			"authToken": ngrokConfig.AuthToken,
+		},
+	}
+
+	// Add IP allowlist configuration if specified
+	if ngrokConfig.UseAllowedIPs && len(ngrokConfig.AllowedIPs) > 0 {
+		ngrokSection["allowedIPs"] = ngrokConfig.AllowedIPs
+	}
+
+	ingress["ngrok"] = ngrokSection
+
+	// Disable localhost if it exists
+	if localhostSection, ok := ingress["localhost"].(map[string]interface{}); ok {
+		localhostSection["enabled"] = false
+	}
+}
+
+// ParseValueOverride splits a key=value assignment into its key path and typed value
+// Like helm --set, dots separate nested keys and true, false and integers are not kept as strings.
+func (h *HelmValuesModifier) ParseValueOverride(override string) ([]string, interface{}, error) {
+	key, raw, found := strings.Cut(override, "=")
+	if !found || strings.TrimSpace(key) == "" {
+		return nil, nil, fmt.Errorf("invalid value %q, expected key=value", override)
+	}
+
+	path, err := splitValueKey(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w in %q", err, override)
+	}
+
+	var value interface{} = raw
+	if raw == "true" || raw == "false" {
+		value = raw == "true"
+	} else if parsed, err := strconv.Atoi(raw); err == nil {
+		value = parsed
+	}
+
+	return path, value, nil
+}
+
+// SetValue applies a key=value assignment to Helm values, creating missing sections
+func (h *HelmValuesModifier) SetValue(values map[string]interface{}, override string) error {
+	path, value, err := h.ParseValueOverride(override)
+	if err != nil {
+		return err
+	}
+	if err := setPath(values, path, value); err != nil {
+		return fmt.Errorf("cannot set %s: %w", override, err)
+	}
+	return nil
+}
+
+// SetValueAt sets the value at a dotted key of Helm values, creating missing sections
+func (h *HelmValuesModifier) SetValueAt(values map[string]interface{}, key string, value interface{}) error {
+	path, err := splitValueKey(key)
+	if err != nil {
+		return err
+	}
+	if err := setPath(values, path, value); err != nil {
+		return fmt.Errorf("cannot set %s: %w", key, err)
+	}
+	return nil
+}
+
+// LookupValue returns the value at a dotted key of Helm values
+func (h *HelmValuesModifier) LookupValue(values map[string]interface{}, key string) (interface{}, bool) {
+	path, err := splitValueKey(key)
+	if err != nil {
+		return nil, false
+	}
+
+	section := values
+	for _, part := range path[:len(path)-1] {
+		next, ok := section[part].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		section = next
+	}
+	value, ok := section[path[len(path)-1]]
+	return value, ok
+}
+
+// DeleteValue removes the value at a dotted key of Helm values, if present,
+// along with the sections it leaves empty
+func (h *HelmValuesModifier) DeleteValue(values map[string]interface{}, key string) {
+	if path, err := splitValueKey(key); err == nil {
+		deletePath(values, path)
+	}
+}
+
+// deletePath removes the value at a path of Helm values and the sections it leaves empty
+func deletePath(values map[string]interface{}, path []string) {
+	if len(path) == 1 {
+		delete(values, path[0])
+		return
+	}
+	section, ok := values[path[0]].(map[string]interface{})
+	if !ok {
+		return
+	}
+	deletePath(section, path[1:])
+	if len(section) == 0 {
+		delete(values, path[0])
+	}
+}
+
+// splitValueKey splits a dotted key, as used by --set, into the sections of its path
+func splitValueKey(key string) ([]string, error) {
+	path := strings.Split(strings.TrimSpace(key), ".")
+	for _, part := range path {
+		if part == "" {
+			return nil, fmt.Errorf("invalid key %q", key)
+		}
+	}
+	return path, nil
+}
+
+// setPath sets the value at a path of Helm values, creating missing sections
+func setPath(values map[string]interface{}, path []string, value interface{}) error {
+	section := values
+	for _, key := range path[:len(path)-1] {
+		next, ok := section[key].(map[string]interface{})
+		if !ok {
+			if _, exists := section[key]; exists {
+				return fmt.Errorf("%s is not a map", key)
+			}
+			next = make(map[string]interface{})
+			section[key] = next
+		}
+		section = next
+	}
+	section[path[len(path)-1]] = value
 	return nil
 }
 
@@ -198,3 +404,46 @@ func (h *HelmValuesModifier) GetCurrentIngressSettings(values map[string]interfa
 
 	return "localhost" // default fallback
 }
+
+// GetCurrentNgrokSettings extracts current Ngrok settings from Helm values
+func (h *HelmValuesModifier) GetCurrentNgrokSettings(values map[string]interface{}) *types.NgrokConfig {
+	current := &types.NgrokConfig{}
+
+	if deployment, ok := values["deployment"].(map[string]interface{}); ok {
+		if oss, ok := deployment["oss"].(map[string]interface{}); ok {
+			if ingress, ok := oss["ingress"].(map[string]interface{}); ok {
+				if ngrok, ok := ingress["ngrok"].(map[string]interface{}); ok {
+					// Extract URL/Domain
+					if url, ok := ngrok["url"].(string); ok {
+						current.Domain = url
+					}
+
+					// Extract credentials
+					if credentials, ok := ngrok["credentials"].(map[string]interface{}); ok {
+						if apiKey, ok := credentials["apiKey"].(string); ok {
+							current.APIKey = apiKey
+						}
+						// Check both possible field names for auth token
+						if authToken, ok := credentials["authToken"].(string); ok {
+							current.AuthToken = authToken
+						} else if authToken, ok := credentials["authtoken"].(string); ok {
+							current.AuthToken = authToken
+						}
+					}
+
+					// Extract IP allowlist
+					if allowedIPs, ok := ngrok["allowedIPs"].([]interface{}); ok {
+						for _, ip := range allowedIPs {
+							if ipString, ok := ip.(string); ok {
+								current.AllowedIPs = append(current.AllowedIPs, ipString)
+							}
+						}
+						current.UseAllowedIPs = len(current.AllowedIPs) > 0
+					}
+				}
+			}
+		}
+	}
+
+	return current
+}
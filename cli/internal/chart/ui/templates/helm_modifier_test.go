@@ -468,3 +468,153 @@ func TestHelmValuesModifier_GetCurrentIngressSettings(t *testing.T) {
 	noIngress := modifier.GetCurrentIngressSettings(noIngressValues)
 	assert.Equal(t, "localhost", noIngress)
 }
+
+func TestHelmValuesModifier_ApplyConfiguration_Ingress(t *testing.T) {
+	modifier := NewHelmValuesModifier()
+
+	values := map[string]interface{}{
+		"deployment": map[string]interface{}{
+			"oss": map[string]interface{}{
+				"ingress": map[string]interface{}{
+					"localhost": map[string]interface{}{"enabled": true},
+				},
+			},
+		},
+	}
+
+	config := &types.ChartConfiguration{
+		IngressConfig: &types.IngressConfig{
+			Type: types.IngressTypeNgrok,
+			NgrokConfig: &types.NgrokConfig{
+				Domain:    "example.ngrok-free.app",
+				APIKey:    "api-key",
+				AuthToken: "auth-token",
+			},
+		},
+	}
+	require.NoError(t, modifier.ApplyConfiguration(values, config))
+	assert.Equal(t, "ngrok", modifier.GetCurrentIngressSettings(values))
+	assert.Equal(t, config.IngressConfig.NgrokConfig, modifier.GetCurrentNgrokSettings(values))
+
+	config.IngressConfig = &types.IngressConfig{Type: types.IngressTypeLocalhost}
+	require.NoError(t, modifier.ApplyConfiguration(values, config))
+	assert.Equal(t, "localhost", modifier.GetCurrentIngressSettings(values))
+
+	config.IngressConfig = &types.IngressConfig{Type: types.IngressTypeNgrok}
+	assert.Error(t, modifier.ApplyConfiguration(values, config), "ngrok without settings")
+}
+
+func TestHelmValuesModifier_ApplyConfiguration_OverridesWin(t *testing.T) {
+	modifier := NewHelmValuesModifier()
+
+	values := map[string]interface{}{}
+	branch := "develop"
+	config := &types.ChartConfiguration{
+		Branch:         &branch,
+		ValueOverrides: []string{"global.repoBranch=release", "registry.docker.username=ci"},
+	}
+	require.NoError(t, modifier.ApplyConfiguration(values, config))
+
+	assert.Equal(t, "release", modifier.GetCurrentBranch(values))
+	assert.Equal(t, "ci", modifier.GetCurrentDockerSettings(values).Username)
+}
+
+func TestHelmValuesModifier_ParseValueOverride(t *testing.T) {
+	modifier := NewHelmValuesModifier()
+
+	tests := []struct {
+		override string
+		path     []string
+		value    interface{}
+		wantErr  bool
+	}{
+		{override: "global.repoBranch=main", path: []string{"global", "repoBranch"}, value: "main"},
+		{override: "a.enabled=true", path: []string{"a", "enabled"}, value: true},
+		{override: "a.enabled=False", path: []string{"a", "enabled"}, value: "False"},
+		{override: "replicas=3", path: []string{"replicas"}, value: 3},
+		{override: "url=https://x.io/?a=b", path: []string{"url"}, value: "https://x.io/?a=b"},
+		{override: "empty=", path: []string{"empty"}, value: ""},
+		{override: "novalue", wantErr: true},
+		{override: "=value", wantErr: true},
+		{override: "a..b=value", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.override, func(t *testing.T) {
+			path, value, err := modifier.ParseValueOverride(tt.override)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.path, path)
+			assert.Equal(t, tt.value, value)
+		})
+	}
+}
+
+func TestHelmValuesModifier_SetValue(t *testing.T) {
+	modifier := NewHelmValuesModifier()
+
+	values := map[string]interface{}{
+		"global": map[string]interface{}{"repoURL": "https://github.com/test/repo.git"},
+		"name":   "openframe",
+	}
+	require.NoError(t, modifier.SetValue(values, "global.repoBranch=develop"))
+	require.NoError(t, modifier.SetValue(values, "deployment.oss.ingress.ngrok.enabled=true"))
+
+	global := values["global"].(map[string]interface{})
+	assert.Equal(t, "develop", global["repoBranch"])
+	assert.Equal(t, "https://github.com/test/repo.git", global["repoURL"], "siblings are kept")
+	assert.Equal(t, "ngrok", modifier.GetCurrentIngressSettings(values))
+
+	err := modifier.SetValue(values, "name.first=open")
+	assert.Error(t, err, "a scalar cannot become a map")
+}
+
+func TestHelmValuesModifier_LookupValue(t *testing.T) {
+	modifier := NewHelmValuesModifier()
+	values := map[string]interface{}{
+		"global": map[string]interface{}{"repoBranch": "main"},
+		"name":   "openframe",
+	}
+
+	value, ok := modifier.LookupValue(values, "global.repoBranch")
+	assert.True(t, ok)
+	assert.Equal(t, "main", value)
+
+	for _, key := range []string{"global.repoURL", "name.first", "missing.key", "global..repoBranch"} {
+		_, ok := modifier.LookupValue(values, key)
+		assert.False(t, ok, key)
+	}
+}
+
+func TestHelmValuesModifier_SetValueAt(t *testing.T) {
+	modifier := NewHelmValuesModifier()
+	values := map[string]interface{}{"name": "openframe"}
+
+	require.NoError(t, modifier.SetValueAt(values, "deployment.oss.tls.cert", "CERT"))
+	value, ok := modifier.LookupValue(values, "deployment.oss.tls.cert")
+	assert.True(t, ok)
+	assert.Equal(t, "CERT", value, "values are set as given, not parsed")
+
+	assert.Error(t, modifier.SetValueAt(values, "name.first", "open"), "a scalar cannot become a map")
+	assert.Error(t, modifier.SetValueAt(values, "deployment.", "x"))
+}
+
+func TestHelmValuesModifier_DeleteValue(t *testing.T) {
+	modifier := NewHelmValuesModifier()
+	values := map[string]interface{}{
+		"deployment": map[string]interface{}{
+			"tls":   map[string]interface{}{"cert": "CERT"},
+			"other": true,
+		},
+	}
+
+	modifier.DeleteValue(values, "deployment.tls.cert")
+	assert.Equal(t, map[string]interface{}{"deployment": map[string]interface{}{"other": true}}, values, "emptied sections are removed")
+
+	modifier.DeleteValue(values, "missing.path")
+	modifier.DeleteValue(values, "deployment..other")
+	assert.Len(t, values, 1)
+}
@@ -11,6 +11,7 @@ type ChartInstallConfig struct {
 	DryRun      bool
 	Verbose     bool
 	Silent      bool
+	ArgoCDChart string // Local ArgoCD chart archive from an offline bundle; empty installs from the argo repository
 	// App-of-apps specific configuration
 	AppOfApps *models.AppOfAppsConfig
 }
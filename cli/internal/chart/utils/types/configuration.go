@@ -63,4 +63,13 @@ type ChartConfiguration struct {
 	Branch             *string                // nil means use existing, otherwise use this value
 	DockerRegistry     *DockerRegistryConfig  // nil means use existing, otherwise use this value
 	IngressConfig      *IngressConfig         // nil means use existing, otherwise use this value
+	ValueOverrides     []string               // key=value assignments applied last, like helm --set
+}
+
+// ScriptedConfiguration holds chart configuration given on the command line instead of the wizard
+type ScriptedConfiguration struct {
+	ValuesFile string      // Base values file, helm-values.yaml in the current directory when empty
+	Overrides  []string    // key=value assignments applied on top of the values
+	Ingress    IngressType // Empty keeps the ingress from the values
+	Branch     string      // Empty keeps global.repoBranch from the values
 }
@@ -22,6 +22,7 @@ type ChartInstaller interface {
 // ClusterLister provides cluster listing capabilities
 type ClusterLister interface {
 	ListClusters() ([]clusterDomain.ClusterInfo, error)
+	KubeContext(name string) (string, error)
 }
 
 // HelmProvider manages Helm chart operations
@@ -142,4 +143,41 @@ type InstallationRequest struct {
 	GitHubRepo   string
 	GitHubBranch string
 	CertDir      string
+	Report       *InstallationReport    // Optional, filled in for machine-readable output
+	Offline      *OfflineSource         // Optional, installs from an unpacked offline bundle instead of the network
+	Scripted     *ScriptedConfiguration // Optional, replaces the configuration wizard
+	AssumeYes    bool                   // Skip the confirmation prompt and pick the only cluster when none is given
+	Diff         bool                   // Preview the values and applications instead of installing
+}
+
+// OfflineSource points an installation at the contents of an unpacked offline bundle
+type OfflineSource struct {
+	ArgoCDChart    string // Local ArgoCD chart archive
+	Repository     string // Local git repository the app-of-apps chart is cloned from
+	Revision       string // Branch or tag of the repository
+	ClusterRepoURL string // URL of the same repository on the in-cluster git server, used by ArgoCD
+}
+
+// InstallationReportKind is the document kind of an installation report
+const InstallationReportKind = "ChartInstall"
+
+// Installation report statuses
+const (
+	InstallationStatusInstalled = "installed"
+	InstallationStatusPreviewed = "previewed"
+	InstallationStatusFailed    = "failed"
+)
+
+// InstallationReport is the machine-readable result of a chart installation
+type InstallationReport struct {
+	APIVersion   string                            `json:"apiVersion"`
+	Kind         string                            `json:"kind"`
+	Cluster      string                            `json:"cluster,omitempty"`
+	Repository   string                            `json:"repository"`
+	Branch       string                            `json:"branch"`
+	DryRun       bool                              `json:"dry_run"`
+	Status       string                            `json:"status"`
+	Error        string                            `json:"error,omitempty"`
+	Applications []clusterDomain.ApplicationStatus `json:"applications,omitempty"`
+	Preview      *models.InstallPreview            `json:"preview,omitempty"` // Set instead of installing with --diff
 }
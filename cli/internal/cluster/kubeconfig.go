@@ -0,0 +1,199 @@
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// kubeconfigSections are the named lists a kubeconfig merge combines
+var kubeconfigSections = []string{"clusters", "contexts", "users"}
+
+// GetKubeconfig returns the kubeconfig of a cluster as reported by its provider
+func (s *ClusterService) GetKubeconfig(name string) (string, error) {
+	clusterType, err := s.DetectClusterType(name)
+	if err != nil {
+		return "", err
+	}
+	provider, err := s.registry.GetProvider(clusterType)
+	if err != nil {
+		return "", err
+	}
+	return provider.GetKubeconfig(context.Background(), name)
+}
+
+// WriteKubeconfig writes the kubeconfig of a cluster to a file only the current user can read
+func (s *ClusterService) WriteKubeconfig(name, path string) error {
+	kubeconfig, err := s.GetKubeconfig(name)
+	if err != nil {
+		return err
+	}
+	return writeKubeconfigFile(path, []byte(kubeconfig))
+}
+
+// MergeKubeconfig merges the kubeconfig of a cluster into the default kubeconfig,
+// replacing entries with the same name, and optionally makes its context the current one
+// It returns the path of the merged kubeconfig and the context of the cluster
+func (s *ClusterService) MergeKubeconfig(name string, switchContext bool) (string, string, error) {
+	kubeconfig, err := s.GetKubeconfig(name)
+	if err != nil {
+		return "", "", err
+	}
+
+	path, err := DefaultKubeconfigPath()
+	if err != nil {
+		return "", "", err
+	}
+	existing, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return "", "", fmt.Errorf("failed to read kubeconfig %s: %w", path, err)
+	}
+
+	merged, contextName, err := mergeKubeconfig(existing, []byte(kubeconfig), switchContext)
+	if err != nil {
+		return "", "", err
+	}
+	if err := writeKubeconfigFile(path, merged); err != nil {
+		return "", "", err
+	}
+	return path, contextName, nil
+}
+
+// DefaultKubeconfigPath returns the kubeconfig kubectl writes to: the first $KUBECONFIG entry or ~/.kube/config
+func DefaultKubeconfigPath() (string, error) {
+	for _, path := range filepath.SplitList(os.Getenv("KUBECONFIG")) {
+		if path != "" {
+			return path, nil
+		}
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".kube", "config"), nil
+}
+
+// mergeKubeconfig merges the clusters, contexts and users of incoming into existing
+// Entries with the same name are replaced in place, new ones are appended. The current context
+// is switched to the incoming one when requested or when existing has none.
+func mergeKubeconfig(existing, incoming []byte, switchContext bool) ([]byte, string, error) {
+	base := map[string]interface{}{}
+	if len(bytes.TrimSpace(existing)) > 0 {
+		if err := yaml.Unmarshal(existing, &base); err != nil {
+			return nil, "", fmt.Errorf("failed to parse existing kubeconfig: %w", err)
+		}
+		if base == nil {
+			base = map[string]interface{}{}
+		}
+	}
+
+	var added map[string]interface{}
+	if err := yaml.Unmarshal(incoming, &added); err != nil {
+		return nil, "", fmt.Errorf("failed to parse cluster kubeconfig: %w", err)
+	}
+	if added == nil {
+		return nil, "", fmt.Errorf("cluster kubeconfig is empty")
+	}
+
+	for _, section := range kubeconfigSections {
+		merged, err := mergeNamedEntries(base[section], added[section])
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid %s in kubeconfig: %w", section, err)
+		}
+		base[section] = merged
+	}
+
+	if _, ok := base["apiVersion"]; !ok {
+		base["apiVersion"] = "v1"
+	}
+	if _, ok := base["kind"]; !ok {
+		base["kind"] = "Config"
+	}
+
+	contextName, _ := added["current-context"].(string)
+	current, _ := base["current-context"].(string)
+	if contextName != "" && (switchContext || current == "") {
+		base["current-context"] = contextName
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(base); err != nil {
+		return nil, "", fmt.Errorf("failed to encode kubeconfig: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to encode kubeconfig: %w", err)
+	}
+	return buf.Bytes(), contextName, nil
+}
+
+// mergeNamedEntries merges two kubeconfig lists of {name: ...} entries
+func mergeNamedEntries(existing, added interface{}) ([]interface{}, error) {
+	result, err := namedEntries(existing)
+	if err != nil {
+		return nil, err
+	}
+	additions, err := namedEntries(added)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range additions {
+		name := entryName(entry)
+		replaced := false
+		for i, current := range result {
+			if name != "" && entryName(current) == name {
+				result[i] = entry
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			result = append(result, entry)
+		}
+	}
+	return result, nil
+}
+
+// namedEntries returns a kubeconfig list section, treating a missing section as empty
+func namedEntries(section interface{}) ([]interface{}, error) {
+	if section == nil {
+		return []interface{}{}, nil
+	}
+	entries, ok := section.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected a list, got %T", section)
+	}
+	return append([]interface{}{}, entries...), nil
+}
+
+// entryName returns the name of a kubeconfig list entry
+func entryName(entry interface{}) string {
+	if fields, ok := entry.(map[string]interface{}); ok {
+		if name, ok := fields["name"].(string); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// writeKubeconfigFile writes a kubeconfig with owner-only permissions, creating its directory
+func writeKubeconfigFile(path string, data []byte) error {
+	if strings.TrimSpace(path) == "" {
+		return fmt.Errorf("kubeconfig path cannot be empty")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create directory for kubeconfig %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write kubeconfig %s: %w", path, err)
+	}
+	// WriteFile keeps the mode of an existing file, which may be readable by others
+	return os.Chmod(path, 0600)
+}
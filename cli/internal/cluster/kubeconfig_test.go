@@ -0,0 +1,130 @@
+package cluster
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// kubeconfigNames returns the entry names of a kubeconfig section
+func kubeconfigNames(t *testing.T, data []byte, section string) []string {
+	t.Helper()
+	var config map[string]interface{}
+	require.NoError(t, yaml.Unmarshal(data, &config))
+	names := []string{}
+	entries, _ := config[section].([]interface{})
+	for _, entry := range entries {
+		names = append(names, entryName(entry))
+	}
+	return names
+}
+
+func currentContext(t *testing.T, data []byte) string {
+	t.Helper()
+	var config map[string]interface{}
+	require.NoError(t, yaml.Unmarshal(data, &config))
+	current, _ := config["current-context"].(string)
+	return current
+}
+
+func TestMergeKubeconfig(t *testing.T) {
+	t.Run("into an empty kubeconfig", func(t *testing.T) {
+		merged, contextName, err := mergeKubeconfig(nil, []byte(fakeKubeconfig("k3d-dev")), false)
+
+		require.NoError(t, err)
+		assert.Equal(t, "k3d-dev", contextName)
+		assert.Equal(t, []string{"k3d-dev"}, kubeconfigNames(t, merged, "clusters"))
+		assert.Equal(t, []string{"admin@k3d-dev"}, kubeconfigNames(t, merged, "users"))
+		assert.Equal(t, "k3d-dev", currentContext(t, merged), "a kubeconfig without current context gets one")
+	})
+
+	t.Run("keeps the current context unless switching", func(t *testing.T) {
+		existing := []byte(fakeKubeconfig("work"))
+
+		merged, _, err := mergeKubeconfig(existing, []byte(fakeKubeconfig("k3d-dev")), false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"work", "k3d-dev"}, kubeconfigNames(t, merged, "contexts"))
+		assert.Equal(t, "work", currentContext(t, merged))
+
+		merged, _, err = mergeKubeconfig(existing, []byte(fakeKubeconfig("k3d-dev")), true)
+		require.NoError(t, err)
+		assert.Equal(t, "k3d-dev", currentContext(t, merged))
+	})
+
+	t.Run("replaces entries with the same name", func(t *testing.T) {
+		existing := []byte(fakeKubeconfig("k3d-dev"))
+		updated := []byte(fakeKubeconfig("k3d-dev") + "preferences: {}\n")
+
+		merged, _, err := mergeKubeconfig(existing, updated, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"k3d-dev"}, kubeconfigNames(t, merged, "clusters"))
+	})
+
+	t.Run("rejects invalid kubeconfigs", func(t *testing.T) {
+		_, _, err := mergeKubeconfig([]byte("clusters: {}"), []byte(fakeKubeconfig("k3d-dev")), false)
+		assert.ErrorContains(t, err, "invalid clusters in kubeconfig")
+
+		_, _, err = mergeKubeconfig(nil, []byte(""), false)
+		assert.EqualError(t, err, "cluster kubeconfig is empty")
+	})
+}
+
+func TestClusterService_Kubeconfig(t *testing.T) {
+	t.Run("get uses the provider of the cluster", func(t *testing.T) {
+		service, _, _ := newFakeRegistryService()
+
+		kubeconfig, err := service.GetKubeconfig("beta")
+
+		require.NoError(t, err)
+		assert.Contains(t, kubeconfig, "current-context: kind-beta")
+
+		_, err = service.GetKubeconfig("missing")
+		assert.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("write creates an owner-only file", func(t *testing.T) {
+		service, _, _ := newFakeRegistryService()
+		path := filepath.Join(t.TempDir(), "configs", "alpha.yaml")
+
+		require.NoError(t, service.WriteKubeconfig("alpha", path))
+
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	})
+
+	t.Run("merge updates $KUBECONFIG", func(t *testing.T) {
+		service, _, _ := newFakeRegistryService()
+		path := filepath.Join(t.TempDir(), "config")
+		t.Setenv("KUBECONFIG", path+string(os.PathListSeparator)+"/ignored")
+		require.NoError(t, os.WriteFile(path, []byte(fakeKubeconfig("work")), 0644))
+
+		mergedPath, contextName, err := service.MergeKubeconfig("alpha", true)
+
+		require.NoError(t, err)
+		assert.Equal(t, path, mergedPath)
+		assert.Equal(t, "k3d-alpha", contextName)
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"work", "k3d-alpha"}, kubeconfigNames(t, data, "clusters"))
+		assert.Equal(t, "k3d-alpha", currentContext(t, data))
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	})
+}
+
+func TestDefaultKubeconfigPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("KUBECONFIG", "")
+
+	path, err := DefaultKubeconfigPath()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, ".kube", "config"), path)
+}
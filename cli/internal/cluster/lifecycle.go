@@ -0,0 +1,175 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/pterm/pterm"
+)
+
+// readinessPollInterval is how often node and application readiness is re-checked while waiting
+var readinessPollInterval = 5 * time.Second
+
+// StartCluster starts the nodes of a stopped cluster
+func (s *ClusterService) StartCluster(name string, clusterType models.ClusterType) error {
+	ctx := context.Background()
+
+	spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Starting %s cluster '%s'...", clusterType, name))
+
+	provider, err := s.registry.GetProvider(clusterType)
+	if err != nil {
+		spinner.Fail(fmt.Sprintf("Failed to start cluster '%s'", name))
+		return err
+	}
+
+	if err := provider.Start(ctx, name); err != nil {
+		spinner.Fail(fmt.Sprintf("Failed to start cluster '%s'", name))
+		return err
+	}
+
+	spinner.Success(fmt.Sprintf("Cluster '%s' nodes started", name))
+	return nil
+}
+
+// StopCluster stops the nodes of a running cluster, keeping its containers and volumes
+func (s *ClusterService) StopCluster(name string, clusterType models.ClusterType) error {
+	ctx := context.Background()
+
+	spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Stopping %s cluster '%s'...", clusterType, name))
+
+	provider, err := s.registry.GetProvider(clusterType)
+	if err != nil {
+		spinner.Fail(fmt.Sprintf("Failed to stop cluster '%s'", name))
+		return err
+	}
+
+	if err := provider.Stop(ctx, name); err != nil {
+		spinner.Fail(fmt.Sprintf("Failed to stop cluster '%s'", name))
+		return err
+	}
+
+	spinner.Success(fmt.Sprintf("Cluster '%s' stopped", name))
+	return nil
+}
+
+// RestartCluster stops and starts a cluster
+func (s *ClusterService) RestartCluster(name string, clusterType models.ClusterType) error {
+	if err := s.StopCluster(name, clusterType); err != nil {
+		return err
+	}
+	return s.StartCluster(name, clusterType)
+}
+
+// WaitForClusterReady waits until every node reports Ready and all ArgoCD applications are Healthy
+// Clusters without ArgoCD only wait for their nodes
+func (s *ClusterService) WaitForClusterReady(name string, clusterType models.ClusterType, timeout time.Duration) error {
+	ctx := context.Background()
+	deadline := time.Now().Add(timeout)
+	info := models.ClusterInfo{Name: name, Type: clusterType}
+
+	if err := s.waitForNodesReady(ctx, info, deadline); err != nil {
+		return err
+	}
+
+	return s.waitForApplicationsHealthy(ctx, info, deadline)
+}
+
+// waitForNodesReady polls the API server until all nodes are Ready
+// Connection errors are expected while the API server is still coming up and are retried
+func (s *ClusterService) waitForNodesReady(ctx context.Context, info models.ClusterInfo, deadline time.Time) error {
+	spinner, _ := pterm.DefaultSpinner.Start("Waiting for nodes to become Ready...")
+
+	for {
+		result, err := s.executor.Execute(ctx, "kubectl", "--context", kubeContextName(info), "get", "nodes", "--no-headers")
+		ready, total := 0, 0
+		if err == nil {
+			ready, total = parseNodeReadiness(result.Stdout)
+			if total > 0 && ready == total {
+				spinner.Success(fmt.Sprintf("All %d nodes Ready", total))
+				return nil
+			}
+		}
+
+		if !time.Now().Before(deadline) {
+			spinner.Fail("Timed out waiting for nodes")
+			if err != nil {
+				return fmt.Errorf("timed out waiting for nodes of cluster %s: %w", info.Name, err)
+			}
+			return fmt.Errorf("timed out waiting for nodes of cluster %s: %d/%d Ready", info.Name, ready, total)
+		}
+
+		if total > 0 {
+			spinner.UpdateText(fmt.Sprintf("Waiting for nodes to become Ready (%d/%d)...", ready, total))
+		}
+		time.Sleep(readinessPollInterval)
+	}
+}
+
+// parseNodeReadiness counts Ready nodes in `kubectl get nodes --no-headers` output
+// Lines look like: "k3d-dev-server-0   Ready,SchedulingDisabled   control-plane,master   3d   v1.31.5+k3s1"
+func parseNodeReadiness(output string) (ready int, total int) {
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		total++
+		for _, condition := range strings.Split(fields[1], ",") {
+			if condition == "Ready" {
+				ready++
+				break
+			}
+		}
+	}
+	return ready, total
+}
+
+// waitForApplicationsHealthy polls ArgoCD until every application reports Healthy
+func (s *ClusterService) waitForApplicationsHealthy(ctx context.Context, info models.ClusterInfo, deadline time.Time) error {
+	if s.applications == nil {
+		return nil
+	}
+
+	spinner, _ := pterm.DefaultSpinner.Start("Waiting for ArgoCD applications to become Healthy...")
+
+	for {
+		apps, err := s.applications.ListApplications(ctx, kubeContextName(info))
+		if err != nil {
+			// Nodes are Ready at this point, so a listing failure means ArgoCD is not installed
+			spinner.Info("ArgoCD not installed, skipping application health check")
+			return nil
+		}
+		if len(apps) == 0 {
+			spinner.Info("No ArgoCD applications to wait for")
+			return nil
+		}
+
+		unhealthy := unhealthyApplications(apps)
+		if len(unhealthy) == 0 {
+			spinner.Success(fmt.Sprintf("All %d ArgoCD applications Healthy", len(apps)))
+			return nil
+		}
+
+		if !time.Now().Before(deadline) {
+			spinner.Fail("Timed out waiting for ArgoCD applications")
+			return fmt.Errorf("timed out waiting for ArgoCD applications in cluster %s: %s not Healthy", info.Name, strings.Join(unhealthy, ", "))
+		}
+
+		spinner.UpdateText(fmt.Sprintf("Waiting for ArgoCD applications to become Healthy (%d/%d)...", len(apps)-len(unhealthy), len(apps)))
+		time.Sleep(readinessPollInterval)
+	}
+}
+
+// unhealthyApplications returns the names of applications that are not Healthy
+func unhealthyApplications(apps []models.ApplicationStatus) []string {
+	var names []string
+	for _, app := range apps {
+		if app.Health != "Healthy" {
+			names = append(names, app.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,140 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testReadyNodesOutput = `k3d-dev-server-0   Ready   control-plane,master   3d   v1.31.5+k3s1
+k3d-dev-agent-0    Ready   <none>                 3d   v1.31.5+k3s1`
+
+// sequenceApplicationLister returns successive application snapshots, repeating the last one
+type sequenceApplicationLister struct {
+	snapshots [][]models.ApplicationStatus
+	calls     int
+}
+
+func (l *sequenceApplicationLister) ListApplications(ctx context.Context, kubeContext string) ([]models.ApplicationStatus, error) {
+	index := l.calls
+	if index >= len(l.snapshots) {
+		index = len(l.snapshots) - 1
+	}
+	l.calls++
+	return l.snapshots[index], nil
+}
+
+func useFastReadinessPolling(t *testing.T) {
+	previous := readinessPollInterval
+	readinessPollInterval = time.Millisecond
+	t.Cleanup(func() { readinessPollInterval = previous })
+}
+
+func TestClusterService_StartStopRestart(t *testing.T) {
+	t.Run("start and stop dispatch to the cluster provider", func(t *testing.T) {
+		service, k3dFake, kindFake := newFakeRegistryService()
+
+		require.NoError(t, service.StartCluster("alpha", models.ClusterTypeK3d))
+		require.NoError(t, service.StopCluster("beta", models.ClusterTypeKind))
+
+		assert.Equal(t, []string{"alpha"}, k3dFake.started)
+		assert.Empty(t, k3dFake.stopped)
+		assert.Equal(t, []string{"beta"}, kindFake.stopped)
+	})
+
+	t.Run("restart stops then starts", func(t *testing.T) {
+		service, k3dFake, _ := newFakeRegistryService()
+
+		require.NoError(t, service.RestartCluster("alpha", models.ClusterTypeK3d))
+
+		assert.Equal(t, []string{"alpha"}, k3dFake.stopped)
+		assert.Equal(t, []string{"alpha"}, k3dFake.started)
+	})
+
+	t.Run("unknown cluster type fails", func(t *testing.T) {
+		service, _, _ := newFakeRegistryService()
+
+		err := service.StopCluster("alpha", models.ClusterTypeGKE)
+
+		var providerErr models.ErrProviderNotFound
+		assert.ErrorAs(t, err, &providerErr)
+	})
+}
+
+func TestClusterService_WaitForClusterReady(t *testing.T) {
+	useFastReadinessPolling(t)
+
+	t.Run("returns once nodes are ready and there is no ArgoCD", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("get nodes", &executor.CommandResult{Stdout: testReadyNodesOutput})
+		service := NewClusterService(mockExec)
+		service.SetApplicationLister(&fakeApplicationLister{err: errors.New("the server doesn't have a resource type \"applications\"")})
+
+		err := service.WaitForClusterReady("dev", models.ClusterTypeK3d, time.Second)
+
+		require.NoError(t, err)
+		assert.True(t, mockExec.WasCommandExecuted("kubectl --context k3d-dev get nodes --no-headers"))
+	})
+
+	t.Run("waits for applications to become healthy", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("get nodes", &executor.CommandResult{Stdout: testReadyNodesOutput})
+		service := NewClusterService(mockExec)
+		lister := &sequenceApplicationLister{snapshots: [][]models.ApplicationStatus{
+			{{Name: "argocd-apps", Health: "Healthy"}, {Name: "openframe-api", Health: "Progressing"}},
+			{{Name: "argocd-apps", Health: "Healthy"}, {Name: "openframe-api", Health: "Healthy"}},
+		}}
+		service.SetApplicationLister(lister)
+
+		err := service.WaitForClusterReady("dev", models.ClusterTypeK3d, time.Second)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, lister.calls)
+	})
+
+	t.Run("times out while nodes are not ready", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("get nodes", &executor.CommandResult{Stdout: "k3d-dev-server-0   NotReady   control-plane   3d   v1.31.5+k3s1"})
+		service := NewClusterService(mockExec)
+
+		err := service.WaitForClusterReady("dev", models.ClusterTypeK3d, 10*time.Millisecond)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "timed out waiting for nodes of cluster dev: 0/1 Ready")
+	})
+
+	t.Run("times out with the unhealthy applications", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("get nodes", &executor.CommandResult{Stdout: testReadyNodesOutput})
+		service := NewClusterService(mockExec)
+		service.SetApplicationLister(&fakeApplicationLister{apps: []models.ApplicationStatus{
+			{Name: "argocd-apps", Health: "Healthy"},
+			{Name: "openframe-api", Health: "Degraded"},
+		}})
+
+		err := service.WaitForClusterReady("dev", models.ClusterTypeKind, 10*time.Millisecond)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "openframe-api not Healthy")
+	})
+}
+
+func TestParseNodeReadiness(t *testing.T) {
+	output := `k3d-dev-server-0   Ready,SchedulingDisabled   control-plane   3d   v1.31.5+k3s1
+k3d-dev-agent-0    NotReady                   <none>          3d   v1.31.5+k3s1
+k3d-dev-agent-1    Ready                      <none>          3d   v1.31.5+k3s1`
+
+	ready, total := parseNodeReadiness(output)
+	assert.Equal(t, 2, ready)
+	assert.Equal(t, 3, total)
+
+	ready, total = parseNodeReadiness("")
+	assert.Equal(t, 0, ready)
+	assert.Equal(t, 0, total)
+}
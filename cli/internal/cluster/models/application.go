@@ -0,0 +1,24 @@
+package models
+
+import "context"
+
+// ApplicationStatus is the sync and health state of a GitOps application deployed to a cluster
+type ApplicationStatus struct {
+	Name     string `json:"name"`
+	Sync     string `json:"sync"`
+	Health   string `json:"health"`
+	Revision string `json:"revision,omitempty"`
+	SyncWave int    `json:"sync_wave"`
+	Message  string `json:"message,omitempty"` // Last operation message
+}
+
+// IsDegraded reports whether the application is reported as degraded
+func (a ApplicationStatus) IsDegraded() bool {
+	return a.Health == "Degraded"
+}
+
+// ApplicationLister lists the GitOps applications deployed to a cluster
+type ApplicationLister interface {
+	// ListApplications returns the applications sorted by sync wave for the given kubeconfig context
+	ListApplications(ctx context.Context, kubeContext string) ([]ApplicationStatus, error)
+}
@@ -1,32 +1,154 @@
 package models
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // ClusterType represents different types of Kubernetes clusters
 type ClusterType string
 
 const (
-	ClusterTypeK3d ClusterType = "k3d"
-	ClusterTypeGKE ClusterType = "gke"
+	ClusterTypeK3d  ClusterType = "k3d"
+	ClusterTypeKind ClusterType = "kind"
+	ClusterTypeGKE  ClusterType = "gke"
+)
+
+// Cluster lifecycle states derived from the number of running nodes
+const (
+	ClusterStateRunning = "Running"
+	ClusterStateStopped = "Stopped"
+	ClusterStatePartial = "Partial"
+)
+
+// Shared local registry attached to clusters created with --registry
+const (
+	// LocalRegistryContainer is the docker container name k3d gives the local registry
+	LocalRegistryContainer = "k3d-openframe-registry.localhost"
+	// LocalRegistryHost is the registry address images are pushed to and pulled from,
+	// resolvable both from the host and from cluster nodes
+	LocalRegistryHost = LocalRegistryContainer + ":5050"
 )
 
 // ClusterConfig holds cluster configuration
 type ClusterConfig struct {
-	Name       string      `json:"name"`
-	Type       ClusterType `json:"type"`
-	NodeCount  int         `json:"node_count"`
-	K8sVersion string      `json:"k8s_version"`
+	Name            string       `json:"name"`
+	Type            ClusterType  `json:"type"`
+	Servers         int          `json:"servers,omitempty"` // Control plane nodes, 0 means a single server
+	NodeCount       int          `json:"node_count"`
+	K8sVersion      string       `json:"k8s_version"`
+	LocalRegistry   bool         `json:"local_registry,omitempty"`    // Attach the shared local registry and Docker Hub cache (k3d only)
+	Ports           ClusterPorts `json:"ports,omitempty"`             // Host ports to publish; zero ports are picked automatically
+	NoSwitchContext bool         `json:"no_switch_context,omitempty"` // Keep the current kubectl context instead of switching to the new cluster
+	Spec            *ClusterSpec `json:"spec,omitempty"`              // Optional declarative spec loaded with --config
 }
 
 // ClusterInfo represents information about a cluster
 type ClusterInfo struct {
-	Name       string      `json:"name"`
-	Type       ClusterType `json:"type"`
-	Status     string      `json:"status"`
-	NodeCount  int         `json:"node_count"`
-	K8sVersion string      `json:"k8s_version,omitempty"`
-	CreatedAt  time.Time   `json:"created_at,omitempty"`
-	Nodes      []NodeInfo  `json:"nodes,omitempty"`
+	Name       string       `json:"name"`
+	Type       ClusterType  `json:"type"`
+	Status     string       `json:"status"`
+	State      string       `json:"state,omitempty"`
+	NodeCount  int          `json:"node_count"`
+	K8sVersion string       `json:"k8s_version,omitempty"`
+	CreatedAt  time.Time    `json:"created_at,omitempty"`
+	Nodes      []NodeInfo   `json:"nodes,omitempty"`
+	Ports      ClusterPorts `json:"ports,omitempty"`
+	NodeImage  string       `json:"node_image,omitempty"` // Image nodes run k3s from after an in-place upgrade, from the local state store
+	Install    *InstallInfo `json:"install,omitempty"`    // Last chart install, from the local state store
+}
+
+// InstallInfo describes the charts installed on a cluster as remembered by the CLI
+type InstallInfo struct {
+	Repository  string    `json:"repository,omitempty"`
+	Branch      string    `json:"branch,omitempty"`
+	IngressMode string    `json:"ingress_mode,omitempty"`
+	ValuesFile  string    `json:"values_file,omitempty"`
+	InstalledAt time.Time `json:"installed_at,omitempty"`
+}
+
+// ClusterState derives the lifecycle state from running and total server/agent node counts
+func ClusterState(serversRunning, servers, agentsRunning, agents int) string {
+	running := serversRunning + agentsRunning
+	switch {
+	case running == 0:
+		return ClusterStateStopped
+	case serversRunning == servers && agentsRunning == agents:
+		return ClusterStateRunning
+	default:
+		return ClusterStatePartial
+	}
+}
+
+// IsStopped reports whether none of the cluster nodes are running
+func (c ClusterInfo) IsStopped() bool {
+	return c.State == ClusterStateStopped
+}
+
+// ClusterPorts holds the host ports a cluster publishes for the API server and ingress
+type ClusterPorts struct {
+	APIHost string `json:"api_host,omitempty"`
+	API     int    `json:"api,omitempty"`
+	HTTP    int    `json:"http,omitempty"`
+	HTTPS   int    `json:"https,omitempty"`
+}
+
+// IsComplete reports whether the API, HTTP and HTTPS ports are all set
+func (p ClusterPorts) IsComplete() bool {
+	return p.API != 0 && p.HTTP != 0 && p.HTTPS != 0
+}
+
+// WithDefaults fills the unset ports from another set of ports
+func (p ClusterPorts) WithDefaults(defaults ClusterPorts) ClusterPorts {
+	if p.API == 0 {
+		p.API = defaults.API
+	}
+	if p.HTTP == 0 {
+		p.HTTP = defaults.HTTP
+	}
+	if p.HTTPS == 0 {
+		p.HTTPS = defaults.HTTPS
+	}
+	return p
+}
+
+// APIEndpoint returns the API server URL, or an empty string when the port is unknown
+func (p ClusterPorts) APIEndpoint() string {
+	if p.API == 0 {
+		return ""
+	}
+	host := p.APIHost
+	if host == "" {
+		host = "0.0.0.0"
+	}
+	return fmt.Sprintf("https://%s:%d", host, p.API)
+}
+
+// HostPortMapping is one published host port and the container port it forwards to
+type HostPortMapping struct {
+	Name          string `json:"name"`
+	HostPort      int    `json:"host_port,omitempty"` // 0 when the port is unknown
+	ContainerPort int    `json:"container_port"`
+	URL           string `json:"url,omitempty"`
+}
+
+// Mappings lists the API, HTTP and HTTPS ports with the container ports they forward to
+func (p ClusterPorts) Mappings() []HostPortMapping {
+	mappings := []HostPortMapping{
+		{Name: "api", HostPort: p.API, ContainerPort: 6443, URL: p.APIEndpoint()},
+		{Name: "http", HostPort: p.HTTP, ContainerPort: 80},
+		{Name: "https", HostPort: p.HTTPS, ContainerPort: 443},
+	}
+	for i, scheme := range []string{"", "http", "https"} {
+		if scheme == "" || mappings[i].HostPort == 0 {
+			continue
+		}
+		mappings[i].URL = fmt.Sprintf("%s://localhost", scheme)
+		if mappings[i].HostPort != mappings[i].ContainerPort {
+			mappings[i].URL += fmt.Sprintf(":%d", mappings[i].HostPort)
+		}
+	}
+	return mappings
 }
 
 // NodeInfo represents information about a node in the cluster
@@ -36,6 +158,40 @@ type NodeInfo struct {
 	Role   string `json:"role"`
 }
 
+// IsServerRole reports whether a node role is a control plane role (k3d "server", kind "control-plane")
+func IsServerRole(role string) bool {
+	return role == "server" || role == "control-plane"
+}
+
+// RoleCounts returns the number of server (control plane) and agent (worker) nodes
+func (c ClusterInfo) RoleCounts() (servers, agents int) {
+	for _, node := range c.Nodes {
+		if IsServerRole(node.Role) {
+			servers++
+		} else {
+			agents++
+		}
+	}
+	return servers, agents
+}
+
+// IsHA reports whether the cluster runs more than one control plane node
+func (c ClusterInfo) IsHA() bool {
+	servers, _ := c.RoleCounts()
+	return servers > 1
+}
+
+// ValidateServerCount checks that a control plane size keeps embedded etcd quorum
+func ValidateServerCount(servers int) error {
+	if servers < 1 || servers > MaxSpecServers {
+		return fmt.Errorf("server count must be between 1 and %d: %d", MaxSpecServers, servers)
+	}
+	if servers%2 == 0 {
+		return fmt.Errorf("server count must be odd to keep etcd quorum: %d", servers)
+	}
+	return nil
+}
+
 // ProviderOptions contains provider-specific options
 type ProviderOptions struct {
 	K3d     *K3dOptions `json:"k3d,omitempty"`
@@ -53,4 +209,3 @@ type GKEOptions struct {
 	Zone    string `json:"zone"`
 	Project string `json:"project"`
 }
-
@@ -10,6 +10,7 @@ import (
 func TestClusterType(t *testing.T) {
 	t.Run("cluster type constants", func(t *testing.T) {
 		assert.Equal(t, ClusterType("k3d"), ClusterTypeK3d)
+		assert.Equal(t, ClusterType("kind"), ClusterTypeKind)
 		assert.Equal(t, ClusterType("gke"), ClusterTypeGKE)
 	})
 	
@@ -297,4 +298,72 @@ func TestJSONSerialization(t *testing.T) {
 		assert.NotNil(t, options.K3d)
 		assert.True(t, options.Verbose)
 	})
-}
\ No newline at end of file
+}
+func TestClusterPorts_APIEndpoint(t *testing.T) {
+	assert.Equal(t, "", ClusterPorts{}.APIEndpoint())
+	assert.Equal(t, "https://0.0.0.0:6551", ClusterPorts{API: 6551}.APIEndpoint())
+	assert.Equal(t, "https://127.0.0.1:6550", ClusterPorts{APIHost: "127.0.0.1", API: 6550}.APIEndpoint())
+}
+
+func TestClusterPorts_WithDefaults(t *testing.T) {
+	defaults := ClusterPorts{API: 6550, HTTP: 80, HTTPS: 443}
+	assert.Equal(t, defaults, ClusterPorts{}.WithDefaults(defaults))
+	assert.Equal(t, ClusterPorts{API: 7000, HTTP: 80, HTTPS: 443}, ClusterPorts{API: 7000}.WithDefaults(defaults))
+	assert.True(t, defaults.IsComplete())
+	assert.False(t, ClusterPorts{API: 7000}.IsComplete())
+}
+
+func TestClusterPorts_Mappings(t *testing.T) {
+	mappings := ClusterPorts{API: 6550, HTTP: 80, HTTPS: 8443}.Mappings()
+	assert.Equal(t, []HostPortMapping{
+		{Name: "api", HostPort: 6550, ContainerPort: 6443, URL: "https://0.0.0.0:6550"},
+		{Name: "http", HostPort: 80, ContainerPort: 80, URL: "http://localhost"},
+		{Name: "https", HostPort: 8443, ContainerPort: 443, URL: "https://localhost:8443"},
+	}, mappings)
+
+	for _, mapping := range (ClusterPorts{}).Mappings() {
+		assert.Empty(t, mapping.URL, "unknown ports have no URL")
+	}
+}
+
+func TestClusterState(t *testing.T) {
+	assert.Equal(t, ClusterStateRunning, ClusterState(1, 1, 3, 3))
+	assert.Equal(t, ClusterStateStopped, ClusterState(0, 1, 0, 3))
+	assert.Equal(t, ClusterStatePartial, ClusterState(1, 1, 1, 3))
+	assert.Equal(t, ClusterStatePartial, ClusterState(0, 1, 3, 3))
+	assert.Equal(t, ClusterStateRunning, ClusterState(1, 1, 0, 0))
+
+	assert.True(t, ClusterInfo{State: ClusterStateStopped}.IsStopped())
+	assert.False(t, ClusterInfo{State: ClusterStatePartial}.IsStopped())
+}
+
+func TestClusterInfo_RoleCounts(t *testing.T) {
+	k3dHA := ClusterInfo{Nodes: []NodeInfo{
+		{Name: "k3d-dev-server-0", Role: "server"},
+		{Name: "k3d-dev-server-1", Role: "server"},
+		{Name: "k3d-dev-server-2", Role: "server"},
+		{Name: "k3d-dev-agent-0", Role: "agent"},
+	}}
+	servers, agents := k3dHA.RoleCounts()
+	assert.Equal(t, 3, servers)
+	assert.Equal(t, 1, agents)
+	assert.True(t, k3dHA.IsHA())
+
+	kind := ClusterInfo{Nodes: []NodeInfo{
+		{Name: "dev-control-plane", Role: "control-plane"},
+		{Name: "dev-worker", Role: "worker"},
+	}}
+	servers, agents = kind.RoleCounts()
+	assert.Equal(t, 1, servers)
+	assert.Equal(t, 1, agents)
+	assert.False(t, kind.IsHA())
+}
+
+func TestValidateServerCount(t *testing.T) {
+	for _, servers := range []int{1, 3, 5, 7} {
+		assert.NoError(t, ValidateServerCount(servers), "servers=%d", servers)
+	}
+	assert.EqualError(t, ValidateServerCount(0), "server count must be between 1 and 7: 0")
+	assert.EqualError(t, ValidateServerCount(9), "server count must be between 1 and 7: 9")
+	assert.EqualError(t, ValidateServerCount(2), "server count must be odd to keep etcd quorum: 2")
+}
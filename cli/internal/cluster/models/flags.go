@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 	
 	"github.com/spf13/cobra"
 	"github.com/flamingo/openframe/internal/shared/flags"
@@ -15,10 +16,27 @@ type GlobalFlags = flags.CommonFlags
 // CreateFlags contains flags specific to create command
 type CreateFlags struct {
 	GlobalFlags
-	ClusterType string
-	NodeCount   int
-	K8sVersion  string
-	SkipWizard  bool
+	ClusterType     string
+	Servers         int // Control plane nodes; more than one runs an embedded etcd HA control plane
+	NodeCount       int
+	K8sVersion      string
+	SkipWizard      bool
+	ConfigFile      string // Path to a declarative cluster spec file
+	Registry        bool   // Attach the shared local registry and Docker Hub pull-through cache
+	APIPort         int    // Host port of the API server, 0 picks one automatically
+	HTTPPort        int    // Host port of the HTTP ingress, 0 picks one automatically
+	HTTPSPort       int    // Host port of the HTTPS ingress, 0 picks one automatically
+	NoSwitchContext bool   // Keep the current kubectl context instead of switching to the new cluster
+	SkipPreflight   bool   // Create the cluster even when the Docker resource checks fail
+}
+
+// KubeconfigFlags contains flags specific to kubeconfig command
+// Without --file, --merge or --use the kubeconfig is printed to stdout
+type KubeconfigFlags struct {
+	GlobalFlags
+	File  string // Write the kubeconfig to this file
+	Merge bool   // Merge the kubeconfig into the default kubeconfig
+	Use   bool   // Merge the kubeconfig and switch the current context to the cluster
 }
 
 // ListFlags contains flags specific to list command
@@ -40,6 +58,49 @@ type DeleteFlags struct {
 	Force bool  // Delete-specific force flag
 }
 
+// StartFlags contains flags specific to start and restart commands
+type StartFlags struct {
+	GlobalFlags
+	NoWait  bool          // Return as soon as the nodes are started
+	Timeout time.Duration // How long to wait for nodes and applications to become ready
+}
+
+// StopFlags contains flags specific to stop command
+type StopFlags struct {
+	GlobalFlags
+}
+
+// SnapshotFlags contains flags specific to snapshot command
+type SnapshotFlags struct {
+	GlobalFlags
+	Name      string // Snapshot name, defaults to <cluster>-<timestamp>
+	Directory string // Directory the snapshot archive is written to
+}
+
+// RestoreFlags contains flags specific to restore command
+type RestoreFlags struct {
+	GlobalFlags
+	From    string        // Snapshot name or archive path
+	NoWait  bool          // Return as soon as the nodes are started
+	Timeout time.Duration // How long to wait for nodes and applications to become ready
+}
+
+// UpgradeFlags contains flags specific to upgrade command
+type UpgradeFlags struct {
+	GlobalFlags
+	Version string        // Target k3s version, e.g. v1.32.2-k3s1
+	Force   bool          // Upgrade even when deployed charts do not support the target version
+	DryRun  bool          // Only run the compatibility check
+	Timeout time.Duration // How long to wait for each node to become Ready
+}
+
+// ScaleFlags contains flags specific to scale command
+type ScaleFlags struct {
+	GlobalFlags
+	Agents  int           // Target number of agent nodes, -1 when not given
+	Timeout time.Duration // How long to wait for each node to join or drain
+}
+
 // CleanupFlags contains flags specific to cleanup command
 type CleanupFlags struct {
 	GlobalFlags
@@ -56,10 +117,25 @@ func AddGlobalFlags(cmd *cobra.Command, global *GlobalFlags) {
 
 // AddCreateFlags adds create-specific flags to a command
 func AddCreateFlags(cmd *cobra.Command, flags *CreateFlags) {
-	cmd.Flags().StringVarP(&flags.ClusterType, "type", "t", "", "Cluster type (k3d, gke)")
+	cmd.Flags().StringVarP(&flags.ClusterType, "type", "t", "", "Cluster type (k3d, kind, gke)")
+	cmd.Flags().IntVar(&flags.Servers, "servers", 1, "Number of server (control plane) nodes; 3, 5 or 7 for an HA control plane")
 	cmd.Flags().IntVarP(&flags.NodeCount, "nodes", "n", 3, "Number of worker nodes (default 3)")
 	cmd.Flags().StringVar(&flags.K8sVersion, "version", "", "Kubernetes version")
 	cmd.Flags().BoolVar(&flags.SkipWizard, "skip-wizard", false, "Skip interactive wizard")
+	cmd.Flags().StringVar(&flags.ConfigFile, "config", "", "Path to an OpenFrame cluster spec file (YAML); flags override file values")
+	cmd.Flags().BoolVar(&flags.Registry, "registry", false, "Create or reuse a local image registry and Docker Hub pull-through cache (k3d only)")
+	cmd.Flags().IntVar(&flags.APIPort, "api-port", 0, "Host port for the Kubernetes API server (default: first free port from 6550)")
+	cmd.Flags().IntVar(&flags.HTTPPort, "http-port", 0, "Host port for HTTP ingress (default: first free port from 80)")
+	cmd.Flags().IntVar(&flags.HTTPSPort, "https-port", 0, "Host port for HTTPS ingress (default: first free port from 443)")
+	cmd.Flags().BoolVar(&flags.NoSwitchContext, "no-switch-context", false, "Keep the current kubectl context instead of switching to the new cluster")
+	cmd.Flags().BoolVar(&flags.SkipPreflight, "skip-preflight", false, "Create the cluster even when the Docker memory, CPU, disk or limit checks fail")
+}
+
+// AddKubeconfigFlags adds kubeconfig-specific flags to a command
+func AddKubeconfigFlags(cmd *cobra.Command, flags *KubeconfigFlags) {
+	cmd.Flags().StringVar(&flags.File, "file", "", "Write the kubeconfig to a file instead of printing it")
+	cmd.Flags().BoolVar(&flags.Merge, "merge", false, "Merge the kubeconfig into $KUBECONFIG or ~/.kube/config")
+	cmd.Flags().BoolVar(&flags.Use, "use", false, "Merge the kubeconfig and switch the current context to the cluster")
 }
 
 // AddListFlags adds list-specific flags to a command
@@ -78,6 +154,39 @@ func AddDeleteFlags(cmd *cobra.Command, flags *DeleteFlags) {
 	cmd.Flags().BoolVarP(&flags.Force, "force", "f", false, "Skip confirmation prompt")
 }
 
+// AddStartFlags adds start/restart-specific flags to a command
+func AddStartFlags(cmd *cobra.Command, flags *StartFlags) {
+	cmd.Flags().BoolVar(&flags.NoWait, "no-wait", false, "Don't wait for nodes and ArgoCD applications to become ready")
+	cmd.Flags().DurationVar(&flags.Timeout, "timeout", 10*time.Minute, "Maximum time to wait for nodes and ArgoCD applications")
+}
+
+// AddSnapshotFlags adds snapshot-specific flags to a command
+func AddSnapshotFlags(cmd *cobra.Command, flags *SnapshotFlags) {
+	cmd.Flags().StringVar(&flags.Name, "name", "", "Snapshot name (default <cluster>-<timestamp>)")
+	cmd.Flags().StringVar(&flags.Directory, "dir", "", "Directory to write the snapshot to (default ~/.config/openframe/snapshots)")
+}
+
+// AddRestoreFlags adds restore-specific flags to a command
+func AddRestoreFlags(cmd *cobra.Command, flags *RestoreFlags) {
+	cmd.Flags().StringVar(&flags.From, "from", "", "Snapshot name or path to a snapshot archive (required)")
+	cmd.Flags().BoolVar(&flags.NoWait, "no-wait", false, "Don't wait for nodes and ArgoCD applications to become ready")
+	cmd.Flags().DurationVar(&flags.Timeout, "timeout", 10*time.Minute, "Maximum time to wait for nodes and ArgoCD applications")
+}
+
+// AddUpgradeFlags adds upgrade-specific flags to a command
+func AddUpgradeFlags(cmd *cobra.Command, flags *UpgradeFlags) {
+	cmd.Flags().StringVar(&flags.Version, "version", "", "Target k3s version, e.g. v1.32.2-k3s1 (required)")
+	cmd.Flags().BoolVar(&flags.Force, "force", false, "Upgrade even when deployed charts do not declare support for the target version")
+	cmd.Flags().BoolVar(&flags.DryRun, "dry-run", false, "Only run the compatibility check and show which nodes would be upgraded")
+	cmd.Flags().DurationVar(&flags.Timeout, "timeout", 5*time.Minute, "Maximum time to wait for each node to become Ready")
+}
+
+// AddScaleFlags adds scale-specific flags to a command
+func AddScaleFlags(cmd *cobra.Command, flags *ScaleFlags) {
+	cmd.Flags().IntVar(&flags.Agents, "agents", -1, "Number of agent (worker) nodes the cluster should have (required)")
+	cmd.Flags().DurationVar(&flags.Timeout, "timeout", 5*time.Minute, "Maximum time to wait for each node to become Ready or to drain")
+}
+
 // AddCleanupFlags adds cleanup-specific flags to a command
 func AddCleanupFlags(cmd *cobra.Command, flags *CleanupFlags) {
 	cmd.Flags().BoolVarP(&flags.Force, "force", "f", false, "Enable aggressive cleanup (remove all images, volumes, networks)")
@@ -137,6 +246,42 @@ func ValidateCreateFlags(flags *CreateFlags) error {
 		return fmt.Errorf("node count must be at least 1: %d", flags.NodeCount)
 	}
 	
+	// Zero means the flag was not registered, which keeps the single-server default
+	if flags.Servers != 0 {
+		if err := ValidateServerCount(flags.Servers); err != nil {
+			return err
+		}
+	}
+	
+	if flags.Registry && flags.ClusterType != "" && ClusterType(flags.ClusterType) != ClusterTypeK3d {
+		return fmt.Errorf("--registry is only supported for k3d clusters")
+	}
+	
+	return ValidateHostPorts(ClusterPorts{API: flags.APIPort, HTTP: flags.HTTPPort, HTTPS: flags.HTTPSPort})
+}
+
+// ValidateHostPorts checks requested host ports; zero ports are picked automatically
+func ValidateHostPorts(ports ClusterPorts) error {
+	seen := make(map[int]string)
+	for _, port := range []struct {
+		flag  string
+		value int
+	}{
+		{"--api-port", ports.API},
+		{"--http-port", ports.HTTP},
+		{"--https-port", ports.HTTPS},
+	} {
+		if port.value == 0 {
+			continue
+		}
+		if port.value < 1 || port.value > 65535 {
+			return fmt.Errorf("%s must be between 1 and 65535: %d", port.flag, port.value)
+		}
+		if other, ok := seen[port.value]; ok {
+			return fmt.Errorf("%s and %s cannot use the same port: %d", other, port.flag, port.value)
+		}
+		seen[port.value] = port.flag
+	}
 	return nil
 }
 
@@ -155,6 +300,94 @@ func ValidateDeleteFlags(flags *DeleteFlags) error {
 	return ValidateGlobalFlags(&flags.GlobalFlags)
 }
 
+// ValidateStartFlags validates start/restart flag combinations
+func ValidateStartFlags(flags *StartFlags) error {
+	if err := ValidateGlobalFlags(&flags.GlobalFlags); err != nil {
+		return err
+	}
+	if !flags.NoWait && flags.Timeout <= 0 {
+		return fmt.Errorf("timeout must be greater than zero: %s", flags.Timeout)
+	}
+	return nil
+}
+
+// ValidateStopFlags validates stop flag combinations
+func ValidateStopFlags(flags *StopFlags) error {
+	return ValidateGlobalFlags(&flags.GlobalFlags)
+}
+
+// ValidateKubeconfigFlags validates kubeconfig flag combinations
+func ValidateKubeconfigFlags(flags *KubeconfigFlags) error {
+	if err := ValidateGlobalFlags(&flags.GlobalFlags); err != nil {
+		return err
+	}
+	if flags.File != "" && (flags.Merge || flags.Use) {
+		return fmt.Errorf("--file cannot be combined with --merge or --use")
+	}
+	return nil
+}
+
+// PrintsKubeconfig reports whether the kubeconfig goes to stdout
+func (f *KubeconfigFlags) PrintsKubeconfig() bool {
+	return f.File == "" && !f.Merge && !f.Use
+}
+
+// ValidateSnapshotFlags validates snapshot flag combinations
+func ValidateSnapshotFlags(flags *SnapshotFlags) error {
+	if err := ValidateGlobalFlags(&flags.GlobalFlags); err != nil {
+		return err
+	}
+	if strings.ContainsAny(flags.Name, `/\`) {
+		return fmt.Errorf("snapshot name must not contain path separators: %s", flags.Name)
+	}
+	return nil
+}
+
+// ValidateRestoreFlags validates restore flag combinations
+func ValidateRestoreFlags(flags *RestoreFlags) error {
+	if err := ValidateGlobalFlags(&flags.GlobalFlags); err != nil {
+		return err
+	}
+	if strings.TrimSpace(flags.From) == "" {
+		return fmt.Errorf("--from is required: pass a snapshot name or archive path")
+	}
+	if !flags.NoWait && flags.Timeout <= 0 {
+		return fmt.Errorf("timeout must be greater than zero: %s", flags.Timeout)
+	}
+	return nil
+}
+
+// ValidateUpgradeFlags validates upgrade flag combinations
+func ValidateUpgradeFlags(flags *UpgradeFlags) error {
+	if err := ValidateGlobalFlags(&flags.GlobalFlags); err != nil {
+		return err
+	}
+	if strings.TrimSpace(flags.Version) == "" {
+		return fmt.Errorf("--version is required: pass a k3s release such as v1.32.2-k3s1")
+	}
+	if err := ValidateK3sVersion(flags.Version); err != nil {
+		return err
+	}
+	if flags.Timeout <= 0 {
+		return fmt.Errorf("timeout must be greater than zero: %s", flags.Timeout)
+	}
+	return nil
+}
+
+// ValidateScaleFlags validates scale flag combinations
+func ValidateScaleFlags(flags *ScaleFlags) error {
+	if err := ValidateGlobalFlags(&flags.GlobalFlags); err != nil {
+		return err
+	}
+	if flags.Agents < 0 {
+		return fmt.Errorf("--agents is required: pass the number of agent nodes the cluster should have")
+	}
+	if flags.Timeout <= 0 {
+		return fmt.Errorf("timeout must be greater than zero: %s", flags.Timeout)
+	}
+	return nil
+}
+
 // ValidateCleanupFlags validates cleanup flag combinations
 func ValidateCleanupFlags(flags *CleanupFlags) error {
 	return ValidateGlobalFlags(&flags.GlobalFlags)
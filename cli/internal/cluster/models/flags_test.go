@@ -2,6 +2,7 @@ package models
 
 import (
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -176,6 +177,28 @@ func TestAddCreateFlags(t *testing.T) {
 		wizardFlag := cmd.Flags().Lookup("skip-wizard")
 		assert.NotNil(t, wizardFlag)
 		assert.Equal(t, "false", wizardFlag.DefValue)
+		
+		serversFlag := cmd.Flags().Lookup("servers")
+		assert.NotNil(t, serversFlag)
+		assert.Equal(t, "1", serversFlag.DefValue)
+		
+		registryFlag := cmd.Flags().Lookup("registry")
+		assert.NotNil(t, registryFlag)
+		assert.Equal(t, "false", registryFlag.DefValue)
+		
+		noSwitchFlag := cmd.Flags().Lookup("no-switch-context")
+		assert.NotNil(t, noSwitchFlag)
+		assert.Equal(t, "false", noSwitchFlag.DefValue)
+		
+		preflightFlag := cmd.Flags().Lookup("skip-preflight")
+		assert.NotNil(t, preflightFlag)
+		assert.Equal(t, "false", preflightFlag.DefValue)
+		
+		for _, name := range []string{"api-port", "http-port", "https-port"} {
+			portFlag := cmd.Flags().Lookup(name)
+			assert.NotNil(t, portFlag, name)
+			assert.Equal(t, "0", portFlag.DefValue, name)
+		}
 	})
 }
 
@@ -288,6 +311,82 @@ func TestFlagValidation(t *testing.T) {
 		assert.Contains(t, err.Error(), "node count must be at least 1")
 	})
 	
+	t.Run("rejects an even number of servers", func(t *testing.T) {
+		flags := &CreateFlags{
+			ClusterType: "k3d",
+			Servers:     2,
+			NodeCount:   3,
+		}
+		
+		err := ValidateCreateFlags(flags)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "must be odd")
+	})
+	
+	t.Run("rejects registry for non-k3d clusters", func(t *testing.T) {
+		flags := &CreateFlags{
+			ClusterType: "kind",
+			NodeCount:   3,
+			Registry:    true,
+		}
+		
+		err := ValidateCreateFlags(flags)
+		assert.EqualError(t, err, "--registry is only supported for k3d clusters")
+	})
+	
+	t.Run("validates host ports", func(t *testing.T) {
+		flags := &CreateFlags{NodeCount: 3, APIPort: 6551, HTTPSPort: 9443}
+		assert.NoError(t, ValidateCreateFlags(flags))
+		
+		flags.HTTPPort = 70000
+		assert.EqualError(t, ValidateCreateFlags(flags), "--http-port must be between 1 and 65535: 70000")
+		
+		flags.HTTPPort = 9443
+		assert.EqualError(t, ValidateCreateFlags(flags), "--http-port and --https-port cannot use the same port: 9443")
+		
+		assert.EqualError(t, ValidateHostPorts(ClusterPorts{API: -1}), "--api-port must be between 1 and 65535: -1")
+	})
+	
+	t.Run("validates kubeconfig flags", func(t *testing.T) {
+		flags := &KubeconfigFlags{}
+		assert.NoError(t, ValidateKubeconfigFlags(flags))
+		assert.True(t, flags.PrintsKubeconfig())
+		
+		flags.Use = true
+		assert.NoError(t, ValidateKubeconfigFlags(flags))
+		assert.False(t, flags.PrintsKubeconfig())
+		
+		flags.File = "dev.yaml"
+		assert.EqualError(t, ValidateKubeconfigFlags(flags), "--file cannot be combined with --merge or --use")
+	})
+	
+	t.Run("validates upgrade flags", func(t *testing.T) {
+		flags := &UpgradeFlags{Version: "v1.32.2-k3s1", Timeout: time.Minute}
+		assert.NoError(t, ValidateUpgradeFlags(flags))
+		
+		flags.Version = ""
+		assert.EqualError(t, ValidateUpgradeFlags(flags), "--version is required: pass a k3s release such as v1.32.2-k3s1")
+		
+		flags.Version = "1.32"
+		assert.EqualError(t, ValidateUpgradeFlags(flags), `invalid k3s version "1.32": expected a release tag such as v1.32.2-k3s1`)
+		
+		flags.Version = "v1.32.2-k3s1"
+		flags.Timeout = 0
+		assert.EqualError(t, ValidateUpgradeFlags(flags), "timeout must be greater than zero: 0s")
+	})
+	
+	t.Run("validates scale flags", func(t *testing.T) {
+		flags := &ScaleFlags{Agents: 0, Timeout: time.Minute}
+		assert.NoError(t, ValidateScaleFlags(flags))
+		
+		flags.Agents = -1
+		assert.EqualError(t, ValidateScaleFlags(flags), "--agents is required: pass the number of agent nodes the cluster should have")
+		
+		flags.Agents = 2
+		flags.Timeout = -time.Second
+		assert.EqualError(t, ValidateScaleFlags(flags), "timeout must be greater than zero: -1s")
+	})
+	
 	t.Run("validates list flags", func(t *testing.T) {
 		flags := &ListFlags{Quiet: true}
 		
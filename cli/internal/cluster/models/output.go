@@ -0,0 +1,35 @@
+package models
+
+// Document kinds emitted with --output json|yaml
+const (
+	ClusterListKind   = "ClusterList"
+	ClusterStatusKind = "ClusterStatus"
+	ClusterPortsKind  = "ClusterPorts"
+)
+
+// ClusterListOutput is the machine-readable document for `cluster list`
+type ClusterListOutput struct {
+	APIVersion string        `json:"apiVersion"`
+	Kind       string        `json:"kind"`
+	Clusters   []ClusterInfo `json:"clusters"`
+}
+
+// ClusterStatusOutput is the machine-readable document for `cluster status`
+type ClusterStatusOutput struct {
+	APIVersion           string                `json:"apiVersion"`
+	Kind                 string                `json:"kind"`
+	Cluster              ClusterInfo           `json:"cluster"`
+	Resources            *ClusterResourceUsage `json:"resources,omitempty"`
+	Applications         []ApplicationStatus   `json:"applications,omitempty"`
+	ApplicationsError    string                `json:"applications_error,omitempty"`
+	DegradedApplications []string              `json:"degraded_applications,omitempty"`
+}
+
+// ClusterPortsOutput is the machine-readable document for `cluster ports`
+type ClusterPortsOutput struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Cluster    string            `json:"cluster"`
+	Type       ClusterType       `json:"type"`
+	Ports      []HostPortMapping `json:"ports"`
+}
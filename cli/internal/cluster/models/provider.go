@@ -14,6 +14,9 @@ type ClusterProvider interface {
 	// Start starts a stopped cluster
 	Start(ctx context.Context, name string) error
 	
+	// Stop stops a running cluster without removing it
+	Stop(ctx context.Context, name string) error
+	
 	// List returns all clusters managed by this provider
 	List(ctx context.Context) ([]ClusterInfo, error)
 	
@@ -39,6 +42,9 @@ type ClusterService interface {
 	// StartCluster starts a stopped cluster
 	StartCluster(ctx context.Context, name string, clusterType ClusterType) error
 	
+	// StopCluster stops a running cluster without removing it
+	StopCluster(ctx context.Context, name string, clusterType ClusterType) error
+	
 	// ListClusters returns all available clusters
 	ListClusters(ctx context.Context) ([]ClusterInfo, error)
 	
@@ -0,0 +1,39 @@
+package models
+
+// Resource usage sources
+const (
+	ResourceSourceMetricsAPI  = "metrics-api"
+	ResourceSourceDockerStats = "docker-stats"
+)
+
+// NodeResourceUsage is the CPU and memory consumption of a single cluster node
+type NodeResourceUsage struct {
+	Name          string `json:"name"`
+	CPU           string `json:"cpu"`
+	CPUPercent    string `json:"cpu_percent"`
+	Memory        string `json:"memory"`
+	MemoryPercent string `json:"memory_percent"`
+}
+
+// NamespacePodCount is the number of pods in a namespace
+type NamespacePodCount struct {
+	Namespace string `json:"namespace"`
+	Running   int    `json:"running"`
+	Total     int    `json:"total"`
+}
+
+// ClusterResourceUsage is a point-in-time view of cluster resource consumption
+type ClusterResourceUsage struct {
+	Source     string              `json:"source,omitempty"` // Where node metrics came from
+	Nodes      []NodeResourceUsage `json:"nodes,omitempty"`
+	Namespaces []NamespacePodCount `json:"namespaces,omitempty"`
+}
+
+// PodTotals returns the running and total pod counts across all namespaces
+func (u ClusterResourceUsage) PodTotals() (running int, total int) {
+	for _, namespace := range u.Namespaces {
+		running += namespace.Running
+		total += namespace.Total
+	}
+	return running, total
+}
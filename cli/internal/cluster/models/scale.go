@@ -0,0 +1,10 @@
+package models
+
+// ScaleResult reports the agent nodes added to or removed from a cluster
+type ScaleResult struct {
+	Cluster    string   `json:"cluster"`
+	FromAgents int      `json:"from_agents"`
+	ToAgents   int      `json:"to_agents"`
+	Added      []string `json:"added,omitempty"`
+	Removed    []string `json:"removed,omitempty"`
+}
@@ -0,0 +1,143 @@
+package models
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Cluster snapshot manifest identifiers
+const (
+	SnapshotAPIVersion   = "openframe.io/v1alpha1"
+	SnapshotKind         = "ClusterSnapshot"
+	SnapshotManifestFile = "manifest.yaml"
+	SnapshotNodesDir     = "nodes"
+)
+
+// SnapshotDataPaths are the node data paths, relative to the k3s data directory, a snapshot may archive
+var SnapshotDataPaths = []string{"storage", "server/db"}
+
+// SnapshotManifest describes the contents of a cluster snapshot archive
+type SnapshotManifest struct {
+	APIVersion        string                `yaml:"apiVersion" json:"apiVersion"`
+	Kind              string                `yaml:"kind" json:"kind"`
+	Name              string                `yaml:"name" json:"name"`
+	CreatedAt         time.Time             `yaml:"createdAt" json:"createdAt"`
+	Cluster           SnapshotCluster       `yaml:"cluster" json:"cluster"`
+	Nodes             []SnapshotNode        `yaml:"nodes" json:"nodes"`
+	HelmReleases      []SnapshotHelmRelease `yaml:"helmReleases,omitempty" json:"helmReleases,omitempty"`
+	Applications      []SnapshotApplication `yaml:"applications,omitempty" json:"applications,omitempty"`
+	AppOfAppsRevision string                `yaml:"appOfAppsRevision,omitempty" json:"appOfAppsRevision,omitempty"`
+}
+
+// SnapshotCluster identifies the cluster a snapshot was taken from
+type SnapshotCluster struct {
+	Name string      `yaml:"name" json:"name"`
+	Type ClusterType `yaml:"type" json:"type"`
+}
+
+// SnapshotNode records the archived data directories of one cluster node
+type SnapshotNode struct {
+	Name    string   `yaml:"name" json:"name"`
+	Role    string   `yaml:"role" json:"role"`
+	Archive string   `yaml:"archive" json:"archive"`
+	Paths   []string `yaml:"paths" json:"paths"`
+}
+
+// SnapshotHelmRelease records an installed helm release and where its values were saved
+type SnapshotHelmRelease struct {
+	Name       string `yaml:"name" json:"name"`
+	Namespace  string `yaml:"namespace" json:"namespace"`
+	Chart      string `yaml:"chart" json:"chart"`
+	AppVersion string `yaml:"appVersion,omitempty" json:"appVersion,omitempty"`
+	Revision   string `yaml:"revision" json:"revision"`
+	ValuesFile string `yaml:"valuesFile,omitempty" json:"valuesFile,omitempty"`
+}
+
+// SnapshotApplication records the revision an ArgoCD application was synced to
+type SnapshotApplication struct {
+	Name     string `yaml:"name" json:"name"`
+	Revision string `yaml:"revision,omitempty" json:"revision,omitempty"`
+	Health   string `yaml:"health,omitempty" json:"health,omitempty"`
+}
+
+// Validate checks that a manifest can be restored
+func (m *SnapshotManifest) Validate() error {
+	if m.APIVersion != SnapshotAPIVersion {
+		return NewInvalidConfigError("apiVersion", m.APIVersion, fmt.Sprintf("must be %s", SnapshotAPIVersion))
+	}
+	if m.Kind != SnapshotKind {
+		return NewInvalidConfigError("kind", m.Kind, fmt.Sprintf("must be %s", SnapshotKind))
+	}
+	if m.Cluster.Name == "" {
+		return NewInvalidConfigError("cluster.name", m.Cluster.Name, "must not be empty")
+	}
+	if len(m.Nodes) == 0 {
+		return NewInvalidConfigError("nodes", 0, "snapshot contains no nodes")
+	}
+	for i, node := range m.Nodes {
+		if node.Name == "" || node.Archive == "" {
+			return NewInvalidConfigError(fmt.Sprintf("nodes[%d]", i), node.Name, "name and archive are required")
+		}
+		// The archive and paths end up in a shell script run against the node volumes
+		if dir, file := path.Split(node.Archive); dir != SnapshotNodesDir+"/" || !isPlainFileName(file) {
+			return NewInvalidConfigError(fmt.Sprintf("nodes[%d].archive", i), node.Archive,
+				fmt.Sprintf("must be a file name in the %s directory of the snapshot", SnapshotNodesDir))
+		}
+		for j, dataPath := range node.Paths {
+			if !isSnapshotDataPath(dataPath) {
+				return NewInvalidConfigError(fmt.Sprintf("nodes[%d].paths[%d]", i, j), dataPath,
+					fmt.Sprintf("must be one of %s", strings.Join(SnapshotDataPaths, ", ")))
+			}
+		}
+	}
+	return nil
+}
+
+// isPlainFileName reports whether name is a single path element
+func isPlainFileName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
+
+// isSnapshotDataPath reports whether p is a clean relative path naming one of the known node data paths
+func isSnapshotDataPath(p string) bool {
+	if p == "" || path.IsAbs(p) || path.Clean(p) != p || strings.Contains(p, "..") {
+		return false
+	}
+	for _, known := range SnapshotDataPaths {
+		if p == known {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseSnapshotManifest decodes and validates a snapshot manifest
+func ParseSnapshotManifest(data []byte) (*SnapshotManifest, error) {
+	var manifest SnapshotManifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid snapshot manifest: %w", err)
+	}
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+// ToYAML renders the manifest as a YAML document
+func (m *SnapshotManifest) ToYAML() ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(m); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
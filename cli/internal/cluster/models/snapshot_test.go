@@ -0,0 +1,63 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSnapshotManifest(t *testing.T) {
+	t.Run("round trips a manifest", func(t *testing.T) {
+		manifest := &SnapshotManifest{
+			APIVersion: SnapshotAPIVersion,
+			Kind:       SnapshotKind,
+			Name:       "seeded",
+			Cluster:    SnapshotCluster{Name: "dev", Type: ClusterTypeK3d},
+			Nodes:      []SnapshotNode{{Name: "k3d-dev-server-0", Role: "server", Archive: "nodes/k3d-dev-server-0.tar.gz", Paths: []string{"storage"}}},
+		}
+
+		data, err := manifest.ToYAML()
+		require.NoError(t, err)
+
+		parsed, err := ParseSnapshotManifest(data)
+		require.NoError(t, err)
+		assert.Equal(t, manifest.Nodes, parsed.Nodes)
+		assert.Equal(t, "dev", parsed.Cluster.Name)
+	})
+
+	t.Run("rejects foreign documents", func(t *testing.T) {
+		_, err := ParseSnapshotManifest([]byte("apiVersion: openframe.io/v1alpha1\nkind: ClusterSpec\n"))
+
+		var configErr ErrInvalidClusterConfig
+		require.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "kind", configErr.Field)
+	})
+
+	t.Run("rejects manifests without nodes", func(t *testing.T) {
+		_, err := ParseSnapshotManifest([]byte("apiVersion: openframe.io/v1alpha1\nkind: ClusterSnapshot\ncluster:\n  name: dev\n"))
+
+		assert.ErrorContains(t, err, "snapshot contains no nodes")
+	})
+
+	t.Run("rejects archives and paths outside the snapshot", func(t *testing.T) {
+		for _, node := range []SnapshotNode{
+			{Name: "k3d-dev-server-0", Archive: "../k3d-dev-server-0.tar.gz", Paths: []string{"storage"}},
+			{Name: "k3d-dev-server-0", Archive: "nodes/../../etc/passwd", Paths: []string{"storage"}},
+			{Name: "k3d-dev-server-0", Archive: "k3d-dev-server-0.tar.gz", Paths: []string{"storage"}},
+			{Name: "k3d-dev-server-0", Archive: "nodes/k3d-dev-server-0.tar.gz", Paths: []string{"../../../"}},
+			{Name: "k3d-dev-server-0", Archive: "nodes/k3d-dev-server-0.tar.gz", Paths: []string{"/etc"}},
+			{Name: "k3d-dev-server-0", Archive: "nodes/k3d-dev-server-0.tar.gz", Paths: []string{"storage; reboot"}},
+		} {
+			manifest := &SnapshotManifest{
+				APIVersion: SnapshotAPIVersion,
+				Kind:       SnapshotKind,
+				Cluster:    SnapshotCluster{Name: "dev"},
+				Nodes:      []SnapshotNode{node},
+			}
+
+			var configErr ErrInvalidClusterConfig
+			assert.ErrorAs(t, manifest.Validate(), &configErr, "archive %q paths %q", node.Archive, node.Paths)
+		}
+	})
+}
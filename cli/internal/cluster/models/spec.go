@@ -0,0 +1,307 @@
+package models
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Cluster spec file identifiers
+const (
+	ClusterSpecAPIVersion = "openframe.io/v1alpha1"
+	ClusterSpecKind       = "ClusterSpec"
+)
+
+// Cluster spec limits
+const (
+	MaxSpecServers = 7
+	MaxSpecAgents  = 10
+)
+
+// nodeFilterPattern matches k3d node filters such as "server:*", "agent:0,1", "loadbalancer" or "all"
+var nodeFilterPattern = regexp.MustCompile(`^(all|loadbalancer|(server|agent|servers|agents)(:(\*|\d+([,-]\d+)*|first|last))?)(:[a-z]+)?$`)
+
+// ClusterSpec is the declarative, versioned OpenFrame cluster definition
+// It is loaded from YAML with `openframe cluster create --config <file>`
+type ClusterSpec struct {
+	APIVersion string              `yaml:"apiVersion" json:"apiVersion"`
+	Kind       string              `yaml:"kind" json:"kind"`
+	Metadata   ClusterSpecMetadata `yaml:"metadata" json:"metadata"`
+	Spec       ClusterSpecBody     `yaml:"spec" json:"spec"`
+}
+
+// ClusterSpecMetadata identifies the cluster described by a spec
+type ClusterSpecMetadata struct {
+	Name string `yaml:"name" json:"name"`
+}
+
+// ClusterSpecBody describes the cluster topology and k3d runtime options
+type ClusterSpecBody struct {
+	Type         ClusterType     `yaml:"type,omitempty" json:"type,omitempty"`
+	Servers      int             `yaml:"servers,omitempty" json:"servers,omitempty"`
+	Agents       int             `yaml:"agents,omitempty" json:"agents,omitempty"`
+	K8sVersion   string          `yaml:"k8sVersion,omitempty" json:"k8sVersion,omitempty"`
+	Image        string          `yaml:"image,omitempty" json:"image,omitempty"`
+	Ports        []PortSpec      `yaml:"ports,omitempty" json:"ports,omitempty"`
+	K3sExtraArgs []ExtraArgSpec  `yaml:"k3sExtraArgs,omitempty" json:"k3sExtraArgs,omitempty"`
+	Volumes      []VolumeSpec    `yaml:"volumes,omitempty" json:"volumes,omitempty"`
+	Registries   *RegistriesSpec `yaml:"registries,omitempty" json:"registries,omitempty"`
+}
+
+// PortSpec maps a host port to a container port on the selected nodes
+type PortSpec struct {
+	HostPort      int      `yaml:"hostPort" json:"hostPort"`
+	ContainerPort int      `yaml:"containerPort" json:"containerPort"`
+	NodeFilters   []string `yaml:"nodeFilters,omitempty" json:"nodeFilters,omitempty"`
+}
+
+// ExtraArgSpec is an additional k3s server/agent argument
+type ExtraArgSpec struct {
+	Arg         string   `yaml:"arg" json:"arg"`
+	NodeFilters []string `yaml:"nodeFilters,omitempty" json:"nodeFilters,omitempty"`
+}
+
+// VolumeSpec mounts a host path or named volume into the selected nodes
+type VolumeSpec struct {
+	Volume      string   `yaml:"volume" json:"volume"`
+	NodeFilters []string `yaml:"nodeFilters,omitempty" json:"nodeFilters,omitempty"`
+}
+
+// RegistriesSpec configures the container registries used by the cluster nodes
+type RegistriesSpec struct {
+	Local  bool     `yaml:"local,omitempty" json:"local,omitempty"` // Shared local registry and Docker Hub pull-through cache
+	Use    []string `yaml:"use,omitempty" json:"use,omitempty"`
+	Config string   `yaml:"config,omitempty" json:"config,omitempty"`
+}
+
+// NewDefaultClusterSpec returns a spec populated with the same defaults as quick create
+func NewDefaultClusterSpec(name string) *ClusterSpec {
+	return &ClusterSpec{
+		APIVersion: ClusterSpecAPIVersion,
+		Kind:       ClusterSpecKind,
+		Metadata:   ClusterSpecMetadata{Name: name},
+		Spec: ClusterSpecBody{
+			Type:    ClusterTypeK3d,
+			Servers: 1,
+			Agents:  3,
+		},
+	}
+}
+
+// LoadClusterSpec reads, decodes and validates a cluster spec file
+func LoadClusterSpec(path string) (*ClusterSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cluster spec %s: %w", path, err)
+	}
+
+	spec, err := ParseClusterSpec(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cluster spec %s: %w", path, err)
+	}
+
+	return spec, nil
+}
+
+// ParseClusterSpec decodes a cluster spec document, rejecting unknown fields, and validates it
+func ParseClusterSpec(data []byte) (*ClusterSpec, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var spec ClusterSpec
+	if err := decoder.Decode(&spec); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("cluster spec is empty")
+		}
+		return nil, err
+	}
+
+	spec.applyDefaults()
+
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &spec, nil
+}
+
+// applyDefaults fills optional fields that were omitted from the document
+func (s *ClusterSpec) applyDefaults() {
+	if s.Spec.Type == "" {
+		s.Spec.Type = ClusterTypeK3d
+	}
+	if s.Spec.Servers == 0 {
+		s.Spec.Servers = 1
+	}
+}
+
+// Validate checks the spec against the v1alpha1 schema rules
+func (s *ClusterSpec) Validate() error {
+	if s.APIVersion != ClusterSpecAPIVersion {
+		return NewInvalidConfigError("apiVersion", s.APIVersion, fmt.Sprintf("must be %s", ClusterSpecAPIVersion))
+	}
+	if s.Kind != ClusterSpecKind {
+		return NewInvalidConfigError("kind", s.Kind, fmt.Sprintf("must be %s", ClusterSpecKind))
+	}
+	if s.Metadata.Name != "" {
+		if err := ValidateClusterName(s.Metadata.Name); err != nil {
+			return NewInvalidConfigError("metadata.name", s.Metadata.Name, err.Error())
+		}
+	}
+
+	body := s.Spec
+	switch body.Type {
+	case ClusterTypeK3d, ClusterTypeKind:
+	default:
+		return NewInvalidConfigError("spec.type", body.Type, "must be k3d or kind")
+	}
+	if body.Servers < 1 || body.Servers > MaxSpecServers {
+		return NewInvalidConfigError("spec.servers", body.Servers, fmt.Sprintf("must be between 1 and %d", MaxSpecServers))
+	}
+	if body.Servers%2 == 0 {
+		return NewInvalidConfigError("spec.servers", body.Servers, "must be odd to keep etcd quorum")
+	}
+	if body.Agents < 0 || body.Agents > MaxSpecAgents {
+		return NewInvalidConfigError("spec.agents", body.Agents, fmt.Sprintf("must be between 0 and %d", MaxSpecAgents))
+	}
+	if body.Image != "" && strings.ContainsAny(body.Image, " \t") {
+		return NewInvalidConfigError("spec.image", body.Image, "must not contain whitespace")
+	}
+
+	for i, port := range body.Ports {
+		field := fmt.Sprintf("spec.ports[%d]", i)
+		if port.HostPort < 1 || port.HostPort > 65535 {
+			return NewInvalidConfigError(field+".hostPort", port.HostPort, "must be between 1 and 65535")
+		}
+		if port.ContainerPort < 1 || port.ContainerPort > 65535 {
+			return NewInvalidConfigError(field+".containerPort", port.ContainerPort, "must be between 1 and 65535")
+		}
+		if err := validateNodeFilters(field+".nodeFilters", port.NodeFilters); err != nil {
+			return err
+		}
+	}
+
+	for i, arg := range body.K3sExtraArgs {
+		field := fmt.Sprintf("spec.k3sExtraArgs[%d]", i)
+		if !strings.HasPrefix(arg.Arg, "--") {
+			return NewInvalidConfigError(field+".arg", arg.Arg, "must start with --")
+		}
+		if err := validateNodeFilters(field+".nodeFilters", arg.NodeFilters); err != nil {
+			return err
+		}
+	}
+
+	for i, volume := range body.Volumes {
+		field := fmt.Sprintf("spec.volumes[%d]", i)
+		if !strings.Contains(volume.Volume, ":") {
+			return NewInvalidConfigError(field+".volume", volume.Volume, "must be in SOURCE:DEST format")
+		}
+		if err := validateNodeFilters(field+".nodeFilters", volume.NodeFilters); err != nil {
+			return err
+		}
+	}
+
+	if body.Registries != nil {
+		if body.Registries.Config != "" {
+			var registriesConfig map[string]interface{}
+			if err := yaml.Unmarshal([]byte(body.Registries.Config), &registriesConfig); err != nil {
+				return NewInvalidConfigError("spec.registries.config", "<registries.yaml>", fmt.Sprintf("must be valid YAML: %v", err))
+			}
+		}
+	}
+
+	if body.Type == ClusterTypeKind {
+		if len(body.K3sExtraArgs) > 0 {
+			return NewInvalidConfigError("spec.k3sExtraArgs", len(body.K3sExtraArgs), "only supported for k3d clusters")
+		}
+		if len(body.Volumes) > 0 {
+			return NewInvalidConfigError("spec.volumes", len(body.Volumes), "only supported for k3d clusters")
+		}
+		if body.Registries != nil {
+			return NewInvalidConfigError("spec.registries", "set", "only supported for k3d clusters")
+		}
+	}
+
+	return nil
+}
+
+// validateNodeFilters checks that every node filter uses the k3d filter syntax
+func validateNodeFilters(field string, filters []string) error {
+	for _, filter := range filters {
+		if !nodeFilterPattern.MatchString(filter) {
+			return NewInvalidConfigError(field, filter, "must be a node filter such as server:*, agent:0 or loadbalancer")
+		}
+	}
+	return nil
+}
+
+// ToClusterConfig converts the spec into a domain cluster configuration
+func (s *ClusterSpec) ToClusterConfig() ClusterConfig {
+	nodeCount := s.Spec.Agents
+	if nodeCount < 1 {
+		nodeCount = 1
+	}
+	return ClusterConfig{
+		Name:          s.Metadata.Name,
+		Type:          s.Spec.Type,
+		Servers:       s.Spec.Servers,
+		NodeCount:     nodeCount,
+		K8sVersion:    s.Spec.K8sVersion,
+		LocalRegistry: s.Spec.Registries != nil && s.Spec.Registries.Local,
+		Spec:          s,
+	}
+}
+
+// ApplyConfig overrides spec values with an explicitly configured cluster config
+// so that command-line flags win over the file
+func (s *ClusterSpec) ApplyConfig(config ClusterConfig) {
+	if config.Name != "" {
+		s.Metadata.Name = config.Name
+	}
+	if config.Type != "" {
+		s.Spec.Type = config.Type
+	}
+	if config.Servers > 0 {
+		s.Spec.Servers = config.Servers
+	}
+	if config.NodeCount > 0 {
+		s.Spec.Agents = config.NodeCount
+	}
+	if config.K8sVersion != "" {
+		s.Spec.K8sVersion = config.K8sVersion
+		// An explicit version replaces a pinned image from the file
+		s.Spec.Image = ""
+	}
+	if config.LocalRegistry {
+		if s.Spec.Registries == nil {
+			s.Spec.Registries = &RegistriesSpec{}
+		}
+		s.Spec.Registries.Local = true
+	}
+}
+
+// ToYAML renders the spec as a YAML document
+func (s *ClusterSpec) ToYAML() (string, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(s); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// String formats a port mapping in k3d HOST:CONTAINER notation
+func (p PortSpec) String() string {
+	return strconv.Itoa(p.HostPort) + ":" + strconv.Itoa(p.ContainerPort)
+}
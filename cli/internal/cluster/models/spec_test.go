@@ -0,0 +1,224 @@
+package models
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const validSpecYAML = `apiVersion: openframe.io/v1alpha1
+kind: ClusterSpec
+metadata:
+  name: dev
+spec:
+  type: k3d
+  servers: 3
+  agents: 2
+  k8sVersion: v1.31.5-k3s1
+  ports:
+    - hostPort: 5432
+      containerPort: 30432
+      nodeFilters: ["server:0"]
+  k3sExtraArgs:
+    - arg: --disable=metrics-server
+      nodeFilters: ["server:*"]
+  volumes:
+    - volume: /tmp/openframe:/data
+      nodeFilters: ["all"]
+  registries:
+    use: ["k3d-registry.localhost:5000"]
+    config: |
+      mirrors:
+        docker.io:
+          endpoint:
+            - http://k3d-registry.localhost:5000
+`
+
+func TestParseClusterSpec(t *testing.T) {
+	t.Run("parses a full spec", func(t *testing.T) {
+		spec, err := ParseClusterSpec([]byte(validSpecYAML))
+
+		require.NoError(t, err)
+		assert.Equal(t, "dev", spec.Metadata.Name)
+		assert.Equal(t, ClusterTypeK3d, spec.Spec.Type)
+		assert.Equal(t, 3, spec.Spec.Servers)
+		assert.Equal(t, 2, spec.Spec.Agents)
+		require.Len(t, spec.Spec.Ports, 1)
+		assert.Equal(t, "5432:30432", spec.Spec.Ports[0].String())
+		require.NotNil(t, spec.Spec.Registries)
+		assert.Contains(t, spec.Spec.Registries.Config, "mirrors:")
+	})
+
+	t.Run("applies defaults for omitted fields", func(t *testing.T) {
+		spec, err := ParseClusterSpec([]byte("apiVersion: openframe.io/v1alpha1\nkind: ClusterSpec\n"))
+
+		require.NoError(t, err)
+		assert.Equal(t, ClusterTypeK3d, spec.Spec.Type)
+		assert.Equal(t, 1, spec.Spec.Servers)
+	})
+
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{
+			name:    "empty document",
+			yaml:    "",
+			wantErr: "cluster spec is empty",
+		},
+		{
+			name:    "unknown field",
+			yaml:    "apiVersion: openframe.io/v1alpha1\nkind: ClusterSpec\nspec:\n  workers: 3\n",
+			wantErr: "field workers not found",
+		},
+		{
+			name:    "wrong apiVersion",
+			yaml:    "apiVersion: openframe.io/v2\nkind: ClusterSpec\n",
+			wantErr: "apiVersion",
+		},
+		{
+			name:    "wrong kind",
+			yaml:    "apiVersion: openframe.io/v1alpha1\nkind: Cluster\n",
+			wantErr: "must be ClusterSpec",
+		},
+		{
+			name:    "unsupported type",
+			yaml:    "apiVersion: openframe.io/v1alpha1\nkind: ClusterSpec\nspec:\n  type: gke\n",
+			wantErr: "spec.type",
+		},
+		{
+			name:    "too many servers",
+			yaml:    "apiVersion: openframe.io/v1alpha1\nkind: ClusterSpec\nspec:\n  servers: 8\n",
+			wantErr: "spec.servers",
+		},
+		{
+			name:    "even number of servers",
+			yaml:    "apiVersion: openframe.io/v1alpha1\nkind: ClusterSpec\nspec:\n  servers: 2\n",
+			wantErr: "must be odd to keep etcd quorum",
+		},
+		{
+			name:    "invalid host port",
+			yaml:    "apiVersion: openframe.io/v1alpha1\nkind: ClusterSpec\nspec:\n  ports:\n    - hostPort: 70000\n      containerPort: 80\n",
+			wantErr: "spec.ports[0].hostPort",
+		},
+		{
+			name:    "invalid node filter",
+			yaml:    "apiVersion: openframe.io/v1alpha1\nkind: ClusterSpec\nspec:\n  k3sExtraArgs:\n    - arg: --foo\n      nodeFilters: [\"master:0\"]\n",
+			wantErr: "spec.k3sExtraArgs[0].nodeFilters",
+		},
+		{
+			name:    "extra arg without dashes",
+			yaml:    "apiVersion: openframe.io/v1alpha1\nkind: ClusterSpec\nspec:\n  k3sExtraArgs:\n    - arg: disable=traefik\n",
+			wantErr: "must start with --",
+		},
+		{
+			name:    "volume without destination",
+			yaml:    "apiVersion: openframe.io/v1alpha1\nkind: ClusterSpec\nspec:\n  volumes:\n    - volume: /tmp/data\n",
+			wantErr: "SOURCE:DEST",
+		},
+		{
+			name:    "invalid registries config",
+			yaml:    "apiVersion: openframe.io/v1alpha1\nkind: ClusterSpec\nspec:\n  registries:\n    config: \"mirrors: [\"\n",
+			wantErr: "spec.registries.config",
+		},
+		{
+			name:    "k3s args on kind",
+			yaml:    "apiVersion: openframe.io/v1alpha1\nkind: ClusterSpec\nspec:\n  type: kind\n  k3sExtraArgs:\n    - arg: --disable=traefik\n",
+			wantErr: "only supported for k3d clusters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run("rejects "+tt.name, func(t *testing.T) {
+			_, err := ParseClusterSpec([]byte(tt.yaml))
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestLoadClusterSpec(t *testing.T) {
+	t.Run("loads spec from file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "cluster.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(validSpecYAML), 0644))
+
+		spec, err := LoadClusterSpec(path)
+
+		require.NoError(t, err)
+		assert.Equal(t, "dev", spec.Metadata.Name)
+	})
+
+	t.Run("returns error for missing file", func(t *testing.T) {
+		_, err := LoadClusterSpec(filepath.Join(t.TempDir(), "missing.yaml"))
+		assert.ErrorContains(t, err, "failed to read cluster spec")
+	})
+}
+
+func TestClusterSpec_ApplyConfig(t *testing.T) {
+	t.Run("flags override file values", func(t *testing.T) {
+		spec, err := ParseClusterSpec([]byte(validSpecYAML))
+		require.NoError(t, err)
+		spec.Spec.Image = "rancher/k3s:v1.30.0-k3s1"
+
+		spec.ApplyConfig(ClusterConfig{Name: "override", NodeCount: 5, K8sVersion: "v1.32.1-k3s1"})
+
+		assert.Equal(t, "override", spec.Metadata.Name)
+		assert.Equal(t, 5, spec.Spec.Agents)
+		assert.Equal(t, "v1.32.1-k3s1", spec.Spec.K8sVersion)
+		assert.Empty(t, spec.Spec.Image)
+		assert.Equal(t, 3, spec.Spec.Servers)
+
+		spec.ApplyConfig(ClusterConfig{Servers: 5})
+		assert.Equal(t, 5, spec.Spec.Servers)
+		assert.Equal(t, 5, spec.ToClusterConfig().Servers)
+	})
+
+	t.Run("empty overrides keep file values", func(t *testing.T) {
+		spec, err := ParseClusterSpec([]byte(validSpecYAML))
+		require.NoError(t, err)
+
+		spec.ApplyConfig(ClusterConfig{})
+
+		assert.Equal(t, "dev", spec.Metadata.Name)
+		assert.Equal(t, 2, spec.Spec.Agents)
+		assert.Equal(t, "v1.31.5-k3s1", spec.Spec.K8sVersion)
+		assert.False(t, spec.ToClusterConfig().LocalRegistry)
+	})
+
+	t.Run("registry flag enables the local registry", func(t *testing.T) {
+		spec := NewDefaultClusterSpec("dev")
+
+		spec.ApplyConfig(ClusterConfig{LocalRegistry: true})
+
+		require.NotNil(t, spec.Spec.Registries)
+		assert.True(t, spec.Spec.Registries.Local)
+		assert.True(t, spec.ToClusterConfig().LocalRegistry)
+	})
+}
+
+func TestClusterSpec_ToClusterConfig(t *testing.T) {
+	spec := NewDefaultClusterSpec("dev")
+	spec.Spec.Agents = 0
+
+	config := spec.ToClusterConfig()
+
+	assert.Equal(t, "dev", config.Name)
+	assert.Equal(t, ClusterTypeK3d, config.Type)
+	assert.Equal(t, 1, config.NodeCount)
+	assert.Same(t, spec, config.Spec)
+}
+
+func TestClusterSpec_ToYAML(t *testing.T) {
+	spec, err := ParseClusterSpec([]byte(validSpecYAML))
+	require.NoError(t, err)
+
+	rendered, err := spec.ToYAML()
+	require.NoError(t, err)
+
+	roundTrip, err := ParseClusterSpec([]byte(rendered))
+	require.NoError(t, err)
+	assert.Equal(t, spec, roundTrip)
+}
@@ -0,0 +1,97 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// k3sVersionPattern matches k3s release tags such as v1.32.2-k3s1
+var k3sVersionPattern = regexp.MustCompile(`^v\d+\.\d+\.\d+-k3s\d+$`)
+
+// Node upgrade states reported by `cluster upgrade`
+const (
+	NodeUpgradePending  = "pending"  // Not reached because an earlier node failed, or a dry run
+	NodeUpgradeUpgraded = "upgraded" // Running the target version and Ready
+	NodeUpgradeSkipped  = "skipped"  // Already running the target version
+	NodeUpgradeFailed   = "failed"
+)
+
+// UpgradePlan is the outcome of the compatibility check and, after an upgrade, the result per node
+type UpgradePlan struct {
+	Cluster     string               `json:"cluster"`
+	FromVersion string               `json:"from_version"`
+	ToVersion   string               `json:"to_version"`
+	Image       string               `json:"image"`
+	Nodes       []NodeUpgradeResult  `json:"nodes"`
+	Charts      []ChartCompatibility `json:"charts,omitempty"`
+}
+
+// NodeUpgradeResult is the upgrade state of one node
+type NodeUpgradeResult struct {
+	Name           string        `json:"name"`
+	Role           string        `json:"role"`
+	FromVersion    string        `json:"from_version"`
+	ToVersion      string        `json:"to_version"`
+	ContainerImage string        `json:"container_image,omitempty"` // Image the node container was created from, kept by an in-place upgrade
+	Status         string        `json:"status"`
+	Duration       time.Duration `json:"duration,omitempty"`
+	Error          string        `json:"error,omitempty"`
+}
+
+// ChartCompatibility records whether a deployed helm chart supports the target Kubernetes version
+type ChartCompatibility struct {
+	Release     string `json:"release"`
+	Namespace   string `json:"namespace"`
+	Chart       string `json:"chart"`
+	KubeVersion string `json:"kube_version,omitempty"` // The chart's kubeVersion constraint, empty when it declares none
+	Compatible  bool   `json:"compatible"`
+}
+
+// IncompatibleCharts returns the charts that do not support the target version
+func (p *UpgradePlan) IncompatibleCharts() []ChartCompatibility {
+	var charts []ChartCompatibility
+	for _, chart := range p.Charts {
+		if !chart.Compatible {
+			charts = append(charts, chart)
+		}
+	}
+	return charts
+}
+
+// StaleImageNodes returns the upgraded nodes whose container still references another image
+// than the one their k3s binary now comes from
+func (p *UpgradePlan) StaleImageNodes() []NodeUpgradeResult {
+	var nodes []NodeUpgradeResult
+	for _, node := range p.Nodes {
+		if node.Status == NodeUpgradeUpgraded && node.ContainerImage != "" && node.ContainerImage != p.Image {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// PendingNodes returns the number of nodes that still need to be upgraded
+func (p *UpgradePlan) PendingNodes() int {
+	count := 0
+	for _, node := range p.Nodes {
+		if node.Status == NodeUpgradePending {
+			count++
+		}
+	}
+	return count
+}
+
+// ValidateK3sVersion checks that a version is a k3s release tag
+func ValidateK3sVersion(version string) error {
+	if !k3sVersionPattern.MatchString(version) {
+		return fmt.Errorf("invalid k3s version %q: expected a release tag such as v1.32.2-k3s1", version)
+	}
+	return nil
+}
+
+// KubeletVersion converts a k3s release tag to the version nodes report, v1.32.2-k3s1 -> v1.32.2+k3s1
+func KubeletVersion(version string) string {
+	return strings.Replace(version, "-k3s", "+k3s", 1)
+}
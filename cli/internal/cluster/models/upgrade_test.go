@@ -0,0 +1,44 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateK3sVersion(t *testing.T) {
+	assert.NoError(t, ValidateK3sVersion("v1.32.2-k3s1"))
+	assert.NoError(t, ValidateK3sVersion("v1.31.5-k3s12"))
+	assert.Error(t, ValidateK3sVersion("v1.32.2"))
+	assert.Error(t, ValidateK3sVersion("v1.32.2+k3s1"))
+	assert.Error(t, ValidateK3sVersion("latest"))
+}
+
+func TestKubeletVersion(t *testing.T) {
+	assert.Equal(t, "v1.32.2+k3s1", KubeletVersion("v1.32.2-k3s1"))
+}
+
+func TestUpgradePlan(t *testing.T) {
+	plan := &UpgradePlan{
+		Image: "rancher/k3s:v1.32.2-k3s1",
+		Nodes: []NodeUpgradeResult{
+			{Name: "k3d-dev-server-0", Status: NodeUpgradeUpgraded, ContainerImage: "rancher/k3s:v1.31.5-k3s1"},
+			{Name: "k3d-dev-agent-0", Status: NodeUpgradePending},
+			{Name: "k3d-dev-agent-1", Status: NodeUpgradePending},
+		},
+		Charts: []ChartCompatibility{
+			{Release: "argo-cd", Compatible: true},
+			{Release: "legacy", KubeVersion: "<1.32.0-0", Compatible: false},
+		},
+	}
+
+	assert.Equal(t, 2, plan.PendingNodes())
+	incompatible := plan.IncompatibleCharts()
+	if assert.Len(t, incompatible, 1) {
+		assert.Equal(t, "legacy", incompatible[0].Release)
+	}
+	stale := plan.StaleImageNodes()
+	if assert.Len(t, stale, 1) {
+		assert.Equal(t, "k3d-dev-server-0", stale[0].Name)
+	}
+}
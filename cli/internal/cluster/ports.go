@@ -0,0 +1,61 @@
+package cluster
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/shared/output"
+	"github.com/flamingo/openframe/internal/shared/ui"
+	"github.com/pterm/pterm"
+)
+
+// GetClusterPorts returns the host port mapping of a cluster
+// Ports the provider cannot report are taken from the lease in the state store
+func (s *ClusterService) GetClusterPorts(name string) (models.ClusterInfo, []models.HostPortMapping, error) {
+	status, err := s.GetClusterStatus(name)
+	if err != nil {
+		return models.ClusterInfo{}, nil, err
+	}
+	return status, status.Ports.Mappings(), nil
+}
+
+// ShowClusterPorts prints the host port mapping of a cluster as a table
+func (s *ClusterService) ShowClusterPorts(name string) error {
+	status, mappings, err := s.GetClusterPorts(name)
+	if err != nil {
+		return err
+	}
+
+	pterm.Info.Printf("Ports of %s cluster '%s':\n", status.Type, pterm.Cyan(status.Name))
+	tableData := pterm.TableData{{"PORT", "HOST", "CONTAINER", "URL"}}
+	for _, mapping := range mappings {
+		hostPort := "-"
+		if mapping.HostPort != 0 {
+			hostPort = strconv.Itoa(mapping.HostPort)
+		}
+		tableData = append(tableData, []string{
+			mapping.Name,
+			hostPort,
+			strconv.Itoa(mapping.ContainerPort),
+			valueOrDash(mapping.URL),
+		})
+	}
+	ui.RenderTableWithFallback(tableData, true)
+	return nil
+}
+
+// WriteClusterPorts writes the host port mapping of a cluster as a machine-readable document
+func (s *ClusterService) WriteClusterPorts(w io.Writer, format output.Format, name string) error {
+	status, mappings, err := s.GetClusterPorts(name)
+	if err != nil {
+		return err
+	}
+	return output.Write(w, format, models.ClusterPortsOutput{
+		APIVersion: output.DocumentAPIVersion,
+		Kind:       models.ClusterPortsKind,
+		Cluster:    status.Name,
+		Type:       status.Type,
+		Ports:      mappings,
+	})
+}
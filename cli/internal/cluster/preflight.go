@@ -0,0 +1,17 @@
+package cluster
+
+import (
+	"context"
+
+	"github.com/flamingo/openframe/internal/cluster/preflight"
+	"github.com/pterm/pterm"
+)
+
+// RunPreflightChecks checks the memory, CPUs, disk and kernel limits of the Docker daemon
+// and shows the results as a table. It returns an error when a check failed.
+func (s *ClusterService) RunPreflightChecks() error {
+	pterm.Info.Println("Running preflight checks...")
+	report := preflight.NewChecker(s.executor).Run(context.Background())
+	preflight.ShowReport(report)
+	return report.Err()
+}
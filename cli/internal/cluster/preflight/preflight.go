@@ -0,0 +1,332 @@
+package preflight
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/flamingo/openframe/internal/chart/prerequisites/memory"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/ui"
+	"github.com/pterm/pterm"
+)
+
+// Thresholds of the preflight checks. Values below a minimum fail, values below a
+// recommendation only warn.
+const (
+	MinimumDockerMemoryMB       = 8192
+	RecommendedDockerMemoryMB   = memory.RecommendedMemoryMB
+	MinimumDockerCPUs           = 2
+	RecommendedDockerCPUs       = 4
+	MinimumFreeDiskGB           = 10
+	RecommendedFreeDiskGB       = 30
+	RecommendedInotifyInstances = 512
+	RecommendedInotifyWatches   = 524288
+	RecommendedOpenFiles        = 65536
+)
+
+// probeImage runs the helper container that reads disk and kernel limits as the nodes see them
+const probeImage = "alpine:3.20"
+
+// probeScript prints the free space of the container root filesystem, which lives in the
+// Docker root dir, followed by the inotify limits and the open files limit, one per line
+const probeScript = "df -Pk / | tail -n 1; cat /proc/sys/fs/inotify/max_user_instances /proc/sys/fs/inotify/max_user_watches; ulimit -Hn"
+
+// Status is the outcome of a single preflight check
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+)
+
+// Result is the outcome of a single preflight check
+type Result struct {
+	Name        string `json:"name"`
+	Status      Status `json:"status"`
+	Value       string `json:"value"`
+	Remediation string `json:"remediation,omitempty"` // How to fix a warning or failure
+}
+
+// Report holds the results of all preflight checks in the order they ran
+type Report struct {
+	Results []Result `json:"results"`
+}
+
+// Failures returns the checks that failed
+func (r *Report) Failures() []Result {
+	var failed []Result
+	for _, result := range r.Results {
+		if result.Status == StatusFail {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Err returns an error naming the failed checks, or nil when nothing failed
+func (r *Report) Err() error {
+	failed := r.Failures()
+	if len(failed) == 0 {
+		return nil
+	}
+	names := make([]string, len(failed))
+	for i, result := range failed {
+		names[i] = result.Name
+	}
+	return fmt.Errorf("preflight checks failed: %s", strings.Join(names, ", "))
+}
+
+// dockerInfo is the part of `docker info` the checks use
+type dockerInfo struct {
+	MemTotal        int64  `json:"MemTotal"`
+	NCPU            int    `json:"NCPU"`
+	DockerRootDir   string `json:"DockerRootDir"`
+	OperatingSystem string `json:"OperatingSystem"`
+}
+
+// isDockerDesktop reports whether resources are set in Docker Desktop rather than on the host
+func (i dockerInfo) isDockerDesktop() bool {
+	return strings.Contains(i.OperatingSystem, "Docker Desktop")
+}
+
+// Checker checks the resources of the Docker daemon that will run the cluster nodes
+// Host RAM is checked by the prerequisites; on Docker Desktop and similar setups the
+// limits of the daemon's VM are what the cluster actually gets.
+type Checker struct {
+	executor executor.CommandExecutor
+}
+
+// NewChecker creates a preflight checker
+func NewChecker(exec executor.CommandExecutor) *Checker {
+	return &Checker{executor: exec}
+}
+
+// Run runs all checks; it never fails itself, problems are reported as results
+func (c *Checker) Run(ctx context.Context) *Report {
+	report := &Report{}
+
+	info, err := c.dockerInfo(ctx)
+	if err != nil {
+		report.Results = append(report.Results, Result{
+			Name:        "Docker daemon",
+			Status:      StatusFail,
+			Value:       "not reachable",
+			Remediation: "Start Docker and check that `docker info` works",
+		})
+		return report
+	}
+
+	report.Results = append(report.Results, checkMemory(info), checkCPUs(info))
+
+	probe, err := c.probe(ctx)
+	if err != nil {
+		for _, name := range []string{"Docker disk", "inotify limits", "Open files limit"} {
+			report.Results = append(report.Results, Result{
+				Name:        name,
+				Status:      StatusWarn,
+				Value:       "could not be checked",
+				Remediation: fmt.Sprintf("Check that the %s image can be pulled: %v", probeImage, err),
+			})
+		}
+		return report
+	}
+
+	report.Results = append(report.Results,
+		checkDisk(info, probe.freeDiskKB),
+		checkInotify(info, probe.inotifyInstances, probe.inotifyWatches),
+		checkOpenFiles(probe.openFiles),
+	)
+	return report
+}
+
+// dockerInfo reads the resources the Docker daemon reports
+func (c *Checker) dockerInfo(ctx context.Context) (dockerInfo, error) {
+	var info dockerInfo
+	result, err := c.executor.Execute(ctx, "docker", "info", "--format", "{{json .}}")
+	if err != nil {
+		return info, err
+	}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(result.Stdout)), &info); err != nil {
+		return info, fmt.Errorf("failed to parse docker info: %w", err)
+	}
+	return info, nil
+}
+
+// probeResult holds the limits read inside the helper container
+type probeResult struct {
+	freeDiskKB       int64
+	inotifyInstances int64
+	inotifyWatches   int64
+	openFiles        int64
+}
+
+// probe reads disk and kernel limits from inside a container, since on Docker Desktop
+// they belong to the VM and not to the host
+func (c *Checker) probe(ctx context.Context) (probeResult, error) {
+	result, err := c.executor.Execute(ctx, "docker", "run", "--rm", probeImage, "sh", "-c", probeScript)
+	if err != nil {
+		return probeResult{}, err
+	}
+	return parseProbe(result.Stdout)
+}
+
+// parseProbe parses the output of probeScript
+func parseProbe(output string) (probeResult, error) {
+	var lines []string
+	for _, line := range strings.Split(output, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) != 4 {
+		return probeResult{}, fmt.Errorf("unexpected probe output %q", output)
+	}
+
+	// df -P: Filesystem 1024-blocks Used Available Capacity Mounted-on
+	fields := strings.Fields(lines[0])
+	if len(fields) < 6 {
+		return probeResult{}, fmt.Errorf("unexpected df output %q", lines[0])
+	}
+
+	var probe probeResult
+	var err error
+	if probe.freeDiskKB, err = strconv.ParseInt(fields[3], 10, 64); err != nil {
+		return probeResult{}, fmt.Errorf("unexpected df output %q", lines[0])
+	}
+	if probe.inotifyInstances, err = strconv.ParseInt(lines[1], 10, 64); err != nil {
+		return probeResult{}, fmt.Errorf("unexpected inotify instances %q", lines[1])
+	}
+	if probe.inotifyWatches, err = strconv.ParseInt(lines[2], 10, 64); err != nil {
+		return probeResult{}, fmt.Errorf("unexpected inotify watches %q", lines[2])
+	}
+	if lines[3] == "unlimited" {
+		probe.openFiles = -1
+	} else if probe.openFiles, err = strconv.ParseInt(lines[3], 10, 64); err != nil {
+		return probeResult{}, fmt.Errorf("unexpected open files limit %q", lines[3])
+	}
+	return probe, nil
+}
+
+func checkMemory(info dockerInfo) Result {
+	memoryMB := info.MemTotal / 1024 / 1024
+	result := Result{Name: "Docker memory", Status: StatusPass, Value: fmt.Sprintf("%.1f GB", float64(memoryMB)/1024)}
+
+	switch {
+	case memoryMB < MinimumDockerMemoryMB:
+		result.Status = StatusFail
+	case memoryMB < RecommendedDockerMemoryMB:
+		result.Status = StatusWarn
+	default:
+		return result
+	}
+
+	if info.isDockerDesktop() {
+		result.Remediation = fmt.Sprintf("Raise the memory limit to at least %d GB in Docker Desktop > Settings > Resources", RecommendedDockerMemoryMB/1024)
+	} else {
+		result.Remediation = fmt.Sprintf("Give the Docker host at least %d GB of memory", RecommendedDockerMemoryMB/1024)
+	}
+	return result
+}
+
+func checkCPUs(info dockerInfo) Result {
+	result := Result{Name: "Docker CPUs", Status: StatusPass, Value: strconv.Itoa(info.NCPU)}
+
+	switch {
+	case info.NCPU < MinimumDockerCPUs:
+		result.Status = StatusFail
+	case info.NCPU < RecommendedDockerCPUs:
+		result.Status = StatusWarn
+	default:
+		return result
+	}
+
+	if info.isDockerDesktop() {
+		result.Remediation = fmt.Sprintf("Raise the CPU limit to at least %d in Docker Desktop > Settings > Resources", RecommendedDockerCPUs)
+	} else {
+		result.Remediation = fmt.Sprintf("Give the Docker host at least %d CPUs", RecommendedDockerCPUs)
+	}
+	return result
+}
+
+func checkDisk(info dockerInfo, freeKB int64) Result {
+	freeGB := freeKB / 1024 / 1024
+	result := Result{Name: "Docker disk", Status: StatusPass, Value: fmt.Sprintf("%d GB free", freeGB)}
+	if info.DockerRootDir != "" {
+		result.Value += " in " + info.DockerRootDir
+	}
+
+	switch {
+	case freeGB < MinimumFreeDiskGB:
+		result.Status = StatusFail
+	case freeGB < RecommendedFreeDiskGB:
+		result.Status = StatusWarn
+	default:
+		return result
+	}
+
+	result.Remediation = fmt.Sprintf("Free up at least %d GB, e.g. with `docker system prune`", RecommendedFreeDiskGB)
+	if info.isDockerDesktop() {
+		result.Remediation += ", or raise the disk image size in Docker Desktop > Settings > Resources"
+	}
+	return result
+}
+
+func checkInotify(info dockerInfo, instances, watches int64) Result {
+	result := Result{
+		Name:   "inotify limits",
+		Status: StatusPass,
+		Value:  fmt.Sprintf("%d instances, %d watches", instances, watches),
+	}
+	if instances >= RecommendedInotifyInstances && watches >= RecommendedInotifyWatches {
+		return result
+	}
+
+	// Pods fail with "too many open files" when the limits are low
+	result.Status = StatusWarn
+	sysctl := fmt.Sprintf("sysctl -w fs.inotify.max_user_instances=%d fs.inotify.max_user_watches=%d",
+		RecommendedInotifyInstances, RecommendedInotifyWatches)
+	if info.isDockerDesktop() {
+		result.Remediation = "Run `docker run --rm --privileged " + probeImage + " " + sysctl + "`"
+	} else {
+		result.Remediation = "Run `sudo " + sysctl + "`"
+	}
+	return result
+}
+
+func checkOpenFiles(limit int64) Result {
+	if limit < 0 {
+		return Result{Name: "Open files limit", Status: StatusPass, Value: "unlimited"}
+	}
+
+	result := Result{Name: "Open files limit", Status: StatusPass, Value: strconv.FormatInt(limit, 10)}
+	if limit < RecommendedOpenFiles {
+		result.Status = StatusWarn
+		result.Remediation = fmt.Sprintf("Raise the nofile limit of the Docker daemon to at least %d (LimitNOFILE in its systemd unit)", RecommendedOpenFiles)
+	}
+	return result
+}
+
+// ShowReport renders the results as a single table
+func ShowReport(report *Report) {
+	tableData := pterm.TableData{{"CHECK", "STATUS", "VALUE", "REMEDIATION"}}
+	for _, result := range report.Results {
+		tableData = append(tableData, []string{result.Name, statusText(result.Status), result.Value, result.Remediation})
+	}
+	ui.RenderTableWithFallback(tableData, true)
+}
+
+// statusText colours a check status for the table
+func statusText(status Status) string {
+	switch status {
+	case StatusPass:
+		return pterm.Green(string(status))
+	case StatusWarn:
+		return pterm.Yellow(string(status))
+	default:
+		return pterm.Red(string(status))
+	}
+}
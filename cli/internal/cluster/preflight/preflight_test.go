@@ -0,0 +1,121 @@
+package preflight
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	desktopDockerInfo = `{"MemTotal":16729960448,"NCPU":8,"DockerRootDir":"/var/lib/docker","OperatingSystem":"Docker Desktop"}`
+	smallDockerInfo   = `{"MemTotal":4294967296,"NCPU":2,"DockerRootDir":"/var/lib/docker","OperatingSystem":"Ubuntu 24.04 LTS"}`
+	healthyProbe      = "overlay 61255492 10485760 52428800 17% /\n8192\n1048576\n1048576\n"
+	limitedProbe      = "overlay 61255492 52428800 5242880 90% /\n128\n8192\n1024\n"
+)
+
+func newTestChecker(info string, probe *executor.CommandResult) *Checker {
+	exec := executor.NewMockCommandExecutor()
+	exec.SetResponse("docker info", &executor.CommandResult{Stdout: info})
+	exec.SetResponse("docker run", probe)
+	return NewChecker(exec)
+}
+
+func resultsByName(report *Report) map[string]Result {
+	results := make(map[string]Result)
+	for _, result := range report.Results {
+		results[result.Name] = result
+	}
+	return results
+}
+
+func TestChecker_Run(t *testing.T) {
+	t.Run("passes a well resourced Docker Desktop", func(t *testing.T) {
+		report := newTestChecker(desktopDockerInfo, &executor.CommandResult{Stdout: healthyProbe}).Run(context.Background())
+
+		require.Len(t, report.Results, 5)
+		for _, result := range report.Results {
+			assert.Equal(t, StatusPass, result.Status, result.Name)
+			assert.Empty(t, result.Remediation, result.Name)
+		}
+		assert.NoError(t, report.Err())
+
+		results := resultsByName(report)
+		assert.Equal(t, "15.6 GB", results["Docker memory"].Value)
+		assert.Equal(t, "8", results["Docker CPUs"].Value)
+		assert.Equal(t, "50 GB free in /var/lib/docker", results["Docker disk"].Value)
+		assert.Equal(t, "8192 instances, 1048576 watches", results["inotify limits"].Value)
+	})
+
+	t.Run("reports low limits with remediation", func(t *testing.T) {
+		report := newTestChecker(smallDockerInfo, &executor.CommandResult{Stdout: limitedProbe}).Run(context.Background())
+		results := resultsByName(report)
+
+		assert.Equal(t, StatusFail, results["Docker memory"].Status)
+		assert.Contains(t, results["Docker memory"].Remediation, "Docker host")
+		assert.Equal(t, StatusWarn, results["Docker CPUs"].Status)
+		assert.Equal(t, StatusFail, results["Docker disk"].Status)
+		assert.Contains(t, results["Docker disk"].Remediation, "docker system prune")
+		assert.Equal(t, StatusWarn, results["inotify limits"].Status)
+		assert.Contains(t, results["inotify limits"].Remediation, "sudo sysctl -w fs.inotify.max_user_instances=512")
+		assert.Equal(t, StatusWarn, results["Open files limit"].Status)
+
+		assert.EqualError(t, report.Err(), "preflight checks failed: Docker memory, Docker disk")
+	})
+
+	t.Run("fails when Docker is not reachable", func(t *testing.T) {
+		exec := executor.NewMockCommandExecutor()
+		exec.SetResponse("docker info", &executor.CommandResult{ExitCode: 1, Stderr: "Cannot connect to the Docker daemon"})
+		report := NewChecker(exec).Run(context.Background())
+
+		require.Len(t, report.Results, 1)
+		assert.Equal(t, "Docker daemon", report.Results[0].Name)
+		assert.Equal(t, StatusFail, report.Results[0].Status)
+		assert.False(t, exec.WasCommandExecuted("docker run"))
+	})
+
+	t.Run("warns when the probe container cannot run", func(t *testing.T) {
+		report := newTestChecker(desktopDockerInfo, &executor.CommandResult{ExitCode: 125}).Run(context.Background())
+		results := resultsByName(report)
+
+		require.Len(t, report.Results, 5)
+		for _, name := range []string{"Docker disk", "inotify limits", "Open files limit"} {
+			assert.Equal(t, StatusWarn, results[name].Status, name)
+			assert.Equal(t, "could not be checked", results[name].Value, name)
+		}
+		assert.NoError(t, report.Err())
+	})
+}
+
+func TestParseProbe(t *testing.T) {
+	probe, err := parseProbe(healthyProbe)
+	require.NoError(t, err)
+	assert.Equal(t, probeResult{freeDiskKB: 52428800, inotifyInstances: 8192, inotifyWatches: 1048576, openFiles: 1048576}, probe)
+
+	probe, err = parseProbe(strings.Replace(healthyProbe, "\n1048576\n1048576\n", "\n1048576\nunlimited\n", 1))
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), probe.openFiles)
+
+	_, err = parseProbe("mock output")
+	assert.Error(t, err)
+
+	_, err = parseProbe("overlay 1 2 lots 4% /\n1\n2\n3\n")
+	assert.Error(t, err)
+}
+
+func TestCheckInotify_DockerDesktop(t *testing.T) {
+	result := checkInotify(dockerInfo{OperatingSystem: "Docker Desktop"}, 128, 8192)
+
+	assert.Equal(t, StatusWarn, result.Status)
+	assert.Contains(t, result.Remediation, "docker run --rm --privileged")
+}
+
+func TestCheckOpenFiles_Unlimited(t *testing.T) {
+	result := checkOpenFiles(-1)
+
+	assert.Equal(t, StatusPass, result.Status)
+	assert.Equal(t, "unlimited", result.Value)
+}
@@ -1,11 +1,14 @@
 package prerequisites
 
 import (
+	"fmt"
 	"strings"
 	
 	"github.com/flamingo/openframe/internal/cluster/prerequisites/docker"
 	"github.com/flamingo/openframe/internal/cluster/prerequisites/k3d"
+	"github.com/flamingo/openframe/internal/cluster/prerequisites/kind"
 	"github.com/flamingo/openframe/internal/cluster/prerequisites/kubectl"
+	"github.com/flamingo/openframe/internal/shared/version"
 )
 
 type PrerequisiteChecker struct {
@@ -17,6 +20,7 @@ type Requirement struct {
 	Command     string
 	IsInstalled func() bool
 	InstallHelp func() string
+	Status      func() version.ToolStatus // Version check of the tool; nil for requirements that are not tools
 }
 
 func NewPrerequisiteChecker() *PrerequisiteChecker {
@@ -25,25 +29,47 @@ func NewPrerequisiteChecker() *PrerequisiteChecker {
 			{
 				Name:        "Docker",
 				Command:     "docker",
-				IsInstalled: func() bool { return docker.IsDockerRunning() },
+				IsInstalled: func() bool { return docker.IsDockerRunning() && docker.NewDockerInstaller().IsSupported() },
 				InstallHelp: func() string { 
 					if !docker.NewDockerInstaller().IsInstalled() {
 						return docker.NewDockerInstaller().GetInstallHelp()
 					}
+					if status := docker.NewDockerInstaller().Status(); status.Unsupported() {
+						return fmt.Sprintf("Docker: %s. %s", status, strings.TrimPrefix(docker.NewDockerInstaller().GetInstallHelp(), "Docker: "))
+					}
 					return "Docker is installed but not running. Please start Docker Desktop or the Docker daemon."
 				},
+				Status: func() version.ToolStatus { return docker.NewDockerInstaller().Status() },
 			},
 			{
 				Name:        "kubectl",
 				Command:     "kubectl",
-				IsInstalled: func() bool { return kubectl.NewKubectlInstaller().IsInstalled() },
+				IsInstalled: func() bool { return kubectl.NewKubectlInstaller().IsSupported() },
 				InstallHelp: func() string { return kubectl.NewKubectlInstaller().GetInstallHelp() },
+				Status:      func() version.ToolStatus { return kubectl.NewKubectlInstaller().Status() },
 			},
 			{
 				Name:        "k3d",
 				Command:     "k3d",
-				IsInstalled: func() bool { return k3d.NewK3dInstaller().IsInstalled() },
+				IsInstalled: func() bool { return k3d.NewK3dInstaller().IsSupported() },
 				InstallHelp: func() string { return k3d.NewK3dInstaller().GetInstallHelp() },
+				Status:      func() version.ToolStatus { return k3d.NewK3dInstaller().Status() },
+			},
+		},
+	}
+}
+
+// NewKindPrerequisiteChecker checks the tools needed in addition to the common ones
+// to create kind clusters
+func NewKindPrerequisiteChecker() *PrerequisiteChecker {
+	return &PrerequisiteChecker{
+		requirements: []Requirement{
+			{
+				Name:        "kind",
+				Command:     "kind",
+				IsInstalled: func() bool { return kind.NewKindInstaller().IsSupported() },
+				InstallHelp: func() string { return kind.NewKindInstaller().GetInstallHelp() },
+				Status:      func() version.ToolStatus { return kind.NewKindInstaller().Status() },
 			},
 		},
 	}
@@ -78,8 +104,31 @@ func (pc *PrerequisiteChecker) GetInstallInstructions(missingTools []string) []s
 	return instructions
 }
 
+// Requirements returns the prerequisites checked by CheckAll, in check order
+func (pc *PrerequisiteChecker) Requirements() []Requirement {
+	return pc.requirements
+}
+
+// Outdated reports whether a tool returned by CheckAll is installed but too old or too new,
+// so that callers can offer to replace it with the pinned version instead of an install
+func (pc *PrerequisiteChecker) Outdated(tool string) (version.ToolStatus, bool) {
+	for _, req := range pc.requirements {
+		if strings.EqualFold(req.Name, tool) && req.Status != nil {
+			status := req.Status()
+			return status, status.Unsupported()
+		}
+	}
+	return version.ToolStatus{}, false
+}
+
 
 func CheckPrerequisites() error {
 	installer := NewInstaller()
 	return installer.CheckAndInstall()
+}
+
+// CheckKindPrerequisites checks and offers to install kind before a kind cluster is created
+func CheckKindPrerequisites() error {
+	installer := &Installer{checker: NewKindPrerequisiteChecker()}
+	return installer.CheckAndInstall()
 }
\ No newline at end of file
@@ -7,6 +7,7 @@ import (
 	"github.com/flamingo/openframe/internal/cluster/prerequisites/docker"
 	"github.com/flamingo/openframe/internal/cluster/prerequisites/k3d"
 	"github.com/flamingo/openframe/internal/cluster/prerequisites/kubectl"
+	"github.com/flamingo/openframe/internal/shared/version"
 )
 
 func TestNewPrerequisiteChecker(t *testing.T) {
@@ -33,6 +34,20 @@ func TestCommandExists(t *testing.T) {
 	_ = dockerInstaller.IsInstalled()
 }
 
+func TestRequirements(t *testing.T) {
+	requirements := NewPrerequisiteChecker().Requirements()
+
+	expectedNames := []string{"Docker", "kubectl", "k3d"}
+	if len(requirements) != len(expectedNames) {
+		t.Fatalf("Expected %d requirements, got %d", len(expectedNames), len(requirements))
+	}
+	for i, req := range requirements {
+		if req.Name != expectedNames[i] {
+			t.Errorf("Expected requirement %d to be %s, got %s", i, expectedNames[i], req.Name)
+		}
+	}
+}
+
 func TestInstallHelp(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -139,4 +154,42 @@ func TestGetInstallInstructions(t *testing.T) {
 			t.Error("Instruction should not be empty")
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestOutdated(t *testing.T) {
+	checker := NewPrerequisiteChecker()
+	
+	checker.requirements[1].Status = func() version.ToolStatus {
+		return version.ToolStatus{State: version.ToolMissing}
+	}
+	checker.requirements[2].Status = func() version.ToolStatus {
+		return version.ToolStatus{State: version.ToolTooOld, Version: version.MustParse("5.4.6"), Supported: ">= 5.6.0"}
+	}
+	
+	if _, outdated := checker.Outdated("kubectl"); outdated {
+		t.Error("Expected a missing tool not to be reported as outdated")
+	}
+	
+	status, outdated := checker.Outdated("K3D")
+	if !outdated {
+		t.Fatal("Expected k3d to be reported as outdated")
+	}
+	if status.String() != "v5.4.6 installed but too old, requires >= 5.6.0" {
+		t.Errorf("Unexpected status: %s", status)
+	}
+	
+	if _, outdated := checker.Outdated("unknown"); outdated {
+		t.Error("Expected an unknown tool not to be reported as outdated")
+	}
+}
+
+func TestNewKindPrerequisiteChecker(t *testing.T) {
+	requirements := NewKindPrerequisiteChecker().Requirements()
+
+	if len(requirements) != 1 || requirements[0].Command != "kind" {
+		t.Fatalf("Expected only the kind requirement, got %v", requirements)
+	}
+	if help := NewKindPrerequisiteChecker().GetInstallInstructions([]string{"kind"}); len(help) != 1 || help[0] == "" {
+		t.Errorf("Expected install instructions for kind, got %v", help)
+	}
+}
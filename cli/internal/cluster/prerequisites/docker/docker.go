@@ -6,9 +6,17 @@ import (
 	"os/exec"
 	"runtime"
 	"time"
+
+	"github.com/flamingo/openframe/internal/shared/version"
 )
 
-type DockerInstaller struct{}
+type DockerInstaller struct {
+	version.Prerequisite
+}
+
+// tool declares the supported Docker client versions; Docker is installed from the
+// distribution packages, so there is no pinned version
+var tool = version.Tool{Command: "docker", VersionArgs: []string{"--version"}, Supported: ">= 20.10.0"}
 
 func commandExists(cmd string) bool {
 	_, err := exec.LookPath(cmd)
@@ -49,7 +57,7 @@ func dockerInstallHelp() string {
 }
 
 func NewDockerInstaller() *DockerInstaller {
-	return &DockerInstaller{}
+	return &DockerInstaller{Prerequisite: version.NewPrerequisite(tool, isDockerInstalled)}
 }
 
 func (d *DockerInstaller) IsInstalled() bool {
@@ -79,7 +87,7 @@ func (d *DockerInstaller) installMacOS() error {
 	}
 
 	fmt.Println("Installing Docker Desktop via Homebrew...")
-	cmd := exec.Command("brew", "install", "--cask", "docker")
+	cmd := version.BrewInstallOrUpgrade("docker", "--cask", "docker")
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	
@@ -3,6 +3,8 @@ package docker
 import (
 	"runtime"
 	"testing"
+
+	"github.com/flamingo/openframe/internal/shared/version"
 )
 
 func TestNewDockerInstaller(t *testing.T) {
@@ -79,4 +81,19 @@ func containsSubstring(str, substr string) bool {
 			   }
 			   return false
 		   }()
-}
\ No newline at end of file
+}
+
+func TestDockerInstaller_Tool(t *testing.T) {
+	tool := NewDockerInstaller().Tool()
+
+	if tool.Command != "docker" {
+		t.Errorf("Expected tool command to be docker, got %s", tool.Command)
+	}
+	constraint, err := version.ParseConstraint(tool.Supported)
+	if err != nil {
+		t.Fatalf("Supported range should parse: %v", err)
+	}
+	if constraint.Check(version.MustParse("1.0.0")) {
+		t.Errorf("Version 1.0.0 should be outside the supported range %s", tool.Supported)
+	}
+}
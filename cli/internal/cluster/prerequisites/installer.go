@@ -9,6 +9,7 @@ import (
 
 	"github.com/flamingo/openframe/internal/cluster/prerequisites/docker"
 	"github.com/flamingo/openframe/internal/cluster/prerequisites/k3d"
+	"github.com/flamingo/openframe/internal/cluster/prerequisites/kind"
 	"github.com/flamingo/openframe/internal/cluster/prerequisites/kubectl"
 	"github.com/flamingo/openframe/internal/shared/errors"
 	"github.com/flamingo/openframe/internal/shared/ui"
@@ -77,17 +78,21 @@ func (i *Installer) installSpecificTools(tools []string) error {
 	for _, tool := range tools {
 		switch strings.ToLower(tool) {
 		case "docker":
-			if !docker.NewDockerInstaller().IsInstalled() {
+			if !docker.NewDockerInstaller().IsSupported() {
 				stillMissing = append(stillMissing, "Docker")
 			}
 		case "kubectl":
-			if !kubectl.NewKubectlInstaller().IsInstalled() {
+			if !kubectl.NewKubectlInstaller().IsSupported() {
 				stillMissing = append(stillMissing, "kubectl")
 			}
 		case "k3d":
-			if !k3d.NewK3dInstaller().IsInstalled() {
+			if !k3d.NewK3dInstaller().IsSupported() {
 				stillMissing = append(stillMissing, "k3d")
 			}
+		case "kind":
+			if !kind.NewKindInstaller().IsSupported() {
+				stillMissing = append(stillMissing, "kind")
+			}
 		}
 	}
 
@@ -111,6 +116,9 @@ func (i *Installer) installTool(tool string) error {
 	case "k3d":
 		installer := k3d.NewK3dInstaller()
 		return installer.Install()
+	case "kind":
+		installer := kind.NewKindInstaller()
+		return installer.Install()
 	default:
 		return fmt.Errorf("unknown tool: %s", tool)
 	}
@@ -137,11 +145,19 @@ func (i *Installer) CheckAndInstall() error {
 		return nil
 	}
 
-	// Separate into truly missing tools vs Docker not running
-	var missingTools []string
+	// Separate into truly missing tools, tools in unsupported versions and Docker not running
+	var missingTools, outdatedTools []string
 	var dockerNotRunning bool
 	
 	for _, tool := range missing {
+		if _, outdated := i.checker.Outdated(tool); outdated {
+			// Installing again upgrades the tool to its pinned version
+			outdatedTools = append(outdatedTools, tool)
+			if strings.EqualFold(tool, "docker") && !docker.IsDockerRunning() {
+				dockerNotRunning = true
+			}
+			continue
+		}
 		switch strings.ToLower(tool) {
 		case "docker":
 			if docker.NewDockerInstaller().IsInstalled() {
@@ -157,17 +173,21 @@ func (i *Installer) CheckAndInstall() error {
 		}
 	}
 
-	// PHASE 2: Install missing tools FIRST
-	if len(missingTools) > 0 {
-		pterm.Warning.Printf("Missing Prerequisites: %s\n", strings.Join(missingTools, ", "))
+	// PHASE 2: Install missing and upgrade outdated tools FIRST
+	if len(missingTools) > 0 || len(outdatedTools) > 0 {
+		i.showMissingAndOutdated(missingTools, outdatedTools)
 
-		confirmed, err := ui.ConfirmActionInteractive("Would you like me to install them automatically?", true)
+		prompt := "Would you like me to install them automatically?"
+		if len(outdatedTools) > 0 {
+			prompt = "Would you like me to install or upgrade them automatically?"
+		}
+		confirmed, err := ui.ConfirmActionInteractive(prompt, true)
 		if err := errors.WrapConfirmationError(err, "failed to get user confirmation"); err != nil {
 			return err
 		}
 
 		if confirmed {
-			if err := i.installSpecificTools(missingTools); err != nil {
+			if err := i.installSpecificTools(append(missingTools, outdatedTools...)); err != nil {
 				return err
 			}
 			pterm.Success.Println("All missing tools installed successfully!")
@@ -209,15 +229,25 @@ func (i *Installer) CheckAndInstall() error {
 	return nil
 }
 
+// showMissingAndOutdated lists tools that are not installed separately from tools in unsupported versions
+func (i *Installer) showMissingAndOutdated(missing, outdated []string) {
+	if len(missing) > 0 {
+		pterm.Warning.Printf("Missing Prerequisites: %s\n", strings.Join(missing, ", "))
+	}
+	for _, tool := range outdated {
+		status, _ := i.checker.Outdated(tool)
+		pterm.Warning.Printf("Unsupported Prerequisite: %s %s\n", tool, status)
+	}
+}
+
 func (i *Installer) showManualInstructions() {
 	fmt.Println()
 	pterm.Info.Println("Installation skipped. Here are manual installation instructions:")
 
-	// Get instructions for all prerequisites
-	allInstructions := []string{
-		docker.NewDockerInstaller().GetInstallHelp(),
-		kubectl.NewKubectlInstaller().GetInstallHelp(),
-		k3d.NewK3dInstaller().GetInstallHelp(),
+	// Get instructions for all prerequisites of the checker
+	var allInstructions []string
+	for _, req := range i.checker.requirements {
+		allInstructions = append(allInstructions, req.InstallHelp())
 	}
 
 	tableData := pterm.TableData{{"Tool", "Installation Instructions"}}
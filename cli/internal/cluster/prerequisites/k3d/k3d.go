@@ -4,9 +4,26 @@ import (
 	"fmt"
 	"os/exec"
 	"runtime"
+
+	"github.com/flamingo/openframe/internal/shared/localbin"
+	"github.com/flamingo/openframe/internal/shared/version"
 )
 
-type K3dInstaller struct{}
+type K3dInstaller struct {
+	version.Prerequisite
+}
+
+// tool declares the supported k3d versions; older releases generate cluster
+// configs that current k3s images ignore without an error, and the provider
+// writes k3d.io/v1alpha5 configs that only k3d 5 reads
+var tool = version.Tool{Command: "k3d", VersionArgs: []string{"version"}, Supported: ">= 5.6.0 < 6.0.0", Pinned: "5.7.4"}
+
+// localBinary is the pinned k3d release installed with --local-tools
+var localBinary = localbin.Binary{
+	Name:    "k3d",
+	Version: tool.Pinned,
+	URL:     "https://github.com/k3d-io/k3d/releases/download/v{version}/k3d-{os}-{arch}",
+}
 
 func commandExists(cmd string) bool {
 	_, err := exec.LookPath(cmd)
@@ -36,7 +53,7 @@ func k3dInstallHelp() string {
 }
 
 func NewK3dInstaller() *K3dInstaller {
-	return &K3dInstaller{}
+	return &K3dInstaller{Prerequisite: version.NewPrerequisite(tool, isK3dInstalled)}
 }
 
 func (k *K3dInstaller) IsInstalled() bool {
@@ -47,7 +64,16 @@ func (k *K3dInstaller) GetInstallHelp() string {
 	return k3dInstallHelp()
 }
 
+// LocalBinary returns the pinned k3d release installed with --local-tools
+func (k *K3dInstaller) LocalBinary() localbin.Binary {
+	return localBinary
+}
+
 func (k *K3dInstaller) Install() error {
+	if localbin.Enabled() {
+		return localbin.Install(localBinary)
+	}
+
 	switch runtime.GOOS {
 	case "darwin":
 		return k.installMacOS()
@@ -65,7 +91,7 @@ func (k *K3dInstaller) installMacOS() error {
 		return fmt.Errorf("Homebrew is required for automatic k3d installation on macOS. Please install brew first: https://brew.sh")
 	}
 
-	cmd := exec.Command("brew", "install", "k3d")
+	cmd := version.BrewInstallOrUpgrade("k3d", "k3d")
 
 	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("failed to install k3d: %w", err)
@@ -122,8 +148,8 @@ func (k *K3dInstaller) installArch() error {
 }
 
 func (k *K3dInstaller) installScript() error {
-	// Use the official k3d install script
-	installCmd := "curl -s https://raw.githubusercontent.com/k3d-io/k3d/main/install.sh | bash"
+	// Use the official k3d install script, pinned to the supported release
+	installCmd := fmt.Sprintf("curl -s https://raw.githubusercontent.com/k3d-io/k3d/main/install.sh | TAG=%s bash", tool.PinnedTag())
 
 	if err := k.runShellCommand(installCmd); err != nil {
 		return fmt.Errorf("failed to install k3d via script: %w", err)
@@ -142,12 +168,8 @@ func (k *K3dInstaller) installBinary() error {
 		return fmt.Errorf("unsupported architecture: %s", arch)
 	}
 
-	// Get latest release version
-	versionCmd := "curl -s https://api.github.com/repos/k3d-io/k3d/releases/latest | grep '\"tag_name\":' | sed -E 's/.*\"([^\"]+)\".*/\\1/'"
-
 	commands := []string{
-		fmt.Sprintf("VERSION=$(%s)", versionCmd),
-		fmt.Sprintf("curl -Lo k3d https://github.com/k3d-io/k3d/releases/download/${VERSION}/k3d-linux-%s", arch),
+		fmt.Sprintf("curl -Lo k3d https://github.com/k3d-io/k3d/releases/download/%s/k3d-linux-%s", tool.PinnedTag(), arch),
 		"chmod +x k3d",
 		"sudo mv k3d /usr/local/bin/",
 	}
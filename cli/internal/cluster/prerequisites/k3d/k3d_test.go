@@ -3,6 +3,8 @@ package k3d
 import (
 	"runtime"
 	"testing"
+
+	"github.com/flamingo/openframe/internal/shared/version"
 )
 
 func TestNewK3dInstaller(t *testing.T) {
@@ -124,4 +126,31 @@ func containsSubstring(str, substr string) bool {
 			   }
 			   return false
 		   }()
-}
\ No newline at end of file
+}
+
+func TestK3dInstaller_Tool(t *testing.T) {
+	tool := NewK3dInstaller().Tool()
+
+	if tool.Command != "k3d" {
+		t.Errorf("Expected tool command to be k3d, got %s", tool.Command)
+	}
+	constraint, err := version.ParseConstraint(tool.Supported)
+	if err != nil {
+		t.Fatalf("Supported range should parse: %v", err)
+	}
+	if !constraint.Check(version.MustParse(tool.Pinned)) {
+		t.Errorf("Pinned version %s should be within the supported range %s", tool.Pinned, tool.Supported)
+	}
+	if status := tool.Evaluate("k3d version v6.0.0"); status.State != version.ToolTooNew {
+		t.Errorf("Expected v6.0.0 to be too new, got %s", status.State)
+	}
+}
+
+func TestLocalBinary(t *testing.T) {
+	if localBinary.Version != tool.Pinned {
+		t.Errorf("Local binary version %s should be the pinned version %s", localBinary.Version, tool.Pinned)
+	}
+	if err := localBinary.Validate(); err != nil {
+		t.Errorf("Local binary pins an invalid checksum: %v", err)
+	}
+}
@@ -0,0 +1,128 @@
+package kind
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+
+	"github.com/flamingo/openframe/internal/shared/localbin"
+	"github.com/flamingo/openframe/internal/shared/version"
+)
+
+type KindInstaller struct {
+	version.Prerequisite
+}
+
+// tool declares the supported kind versions; the kind provider passes the node image
+// explicitly, and older releases cannot run the kindest/node images it defaults to
+var tool = version.Tool{Command: "kind", VersionArgs: []string{"version"}, Supported: ">= 0.20.0", Pinned: "0.26.0"}
+
+// localBinary is the pinned kind release installed with --local-tools
+var localBinary = localbin.Binary{
+	Name:    "kind",
+	Version: tool.Pinned,
+	URL:     "https://github.com/kubernetes-sigs/kind/releases/download/v{version}/kind-{os}-{arch}",
+}
+
+func commandExists(cmd string) bool {
+	_, err := exec.LookPath(cmd)
+	return err == nil
+}
+
+func isKindInstalled() bool {
+	if !commandExists("kind") {
+		return false
+	}
+	cmd := exec.Command("kind", "version")
+	err := cmd.Run()
+	return err == nil
+}
+
+func kindInstallHelp() string {
+	switch runtime.GOOS {
+	case "darwin":
+		return "kind: Run 'brew install kind' or download from https://kind.sigs.k8s.io/docs/user/quick-start/#installation"
+	case "linux":
+		return "kind: Run 'curl -Lo ./kind https://kind.sigs.k8s.io/dl/" + tool.PinnedTag() + "/kind-linux-amd64 && chmod +x ./kind' or download from https://kind.sigs.k8s.io/docs/user/quick-start/#installation"
+	case "windows":
+		return "kind: Download from https://github.com/kubernetes-sigs/kind/releases or use chocolatey 'choco install kind'"
+	default:
+		return "kind: Please install kind from https://kind.sigs.k8s.io/docs/user/quick-start/#installation"
+	}
+}
+
+func NewKindInstaller() *KindInstaller {
+	return &KindInstaller{Prerequisite: version.NewPrerequisite(tool, isKindInstalled)}
+}
+
+func (k *KindInstaller) IsInstalled() bool {
+	return isKindInstalled()
+}
+
+func (k *KindInstaller) GetInstallHelp() string {
+	return kindInstallHelp()
+}
+
+// LocalBinary returns the pinned kind release installed with --local-tools
+func (k *KindInstaller) LocalBinary() localbin.Binary {
+	return localBinary
+}
+
+func (k *KindInstaller) Install() error {
+	if localbin.Enabled() {
+		return localbin.Install(localBinary)
+	}
+
+	switch runtime.GOOS {
+	case "darwin":
+		return k.installMacOS()
+	case "linux":
+		return k.installBinary()
+	case "windows":
+		return fmt.Errorf("automatic kind installation on Windows not supported. Please install from https://kind.sigs.k8s.io/docs/user/quick-start/#installation")
+	default:
+		return fmt.Errorf("automatic kind installation not supported on %s", runtime.GOOS)
+	}
+}
+
+func (k *KindInstaller) installMacOS() error {
+	if !commandExists("brew") {
+		return fmt.Errorf("Homebrew is required for automatic kind installation on macOS. Please install brew first: https://brew.sh")
+	}
+
+	cmd := version.BrewInstallOrUpgrade("kind", "kind")
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to install kind: %w", err)
+	}
+
+	return nil
+}
+
+// installBinary installs the pinned release, since kind has no official Linux packages
+func (k *KindInstaller) installBinary() error {
+	arch := runtime.GOARCH
+	if arch != "amd64" && arch != "arm64" {
+		return fmt.Errorf("unsupported architecture: %s", arch)
+	}
+
+	commands := []string{
+		fmt.Sprintf("curl -Lo kind https://github.com/kubernetes-sigs/kind/releases/download/%s/kind-linux-%s", tool.PinnedTag(), arch),
+		"chmod +x kind",
+		"sudo mv kind /usr/local/bin/",
+	}
+
+	for _, cmd := range commands {
+		if err := k.runShellCommand(cmd); err != nil {
+			return fmt.Errorf("failed to run command '%s': %w", cmd, err)
+		}
+	}
+
+	return nil
+}
+
+func (k *KindInstaller) runShellCommand(command string) error {
+	cmd := exec.Command("bash", "-c", command)
+	// Completely silence output during installation
+	return cmd.Run()
+}
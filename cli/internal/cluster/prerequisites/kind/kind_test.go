@@ -0,0 +1,74 @@
+package kind
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/flamingo/openframe/internal/shared/version"
+)
+
+func TestNewKindInstaller(t *testing.T) {
+	installer := NewKindInstaller()
+
+	if installer == nil {
+		t.Error("Expected kind installer to be created")
+	}
+}
+
+func TestKindInstaller_GetInstallHelp(t *testing.T) {
+	help := NewKindInstaller().GetInstallHelp()
+
+	if !strings.HasPrefix(help, "kind: ") {
+		t.Errorf("Install help should start with the tool name: %s", help)
+	}
+
+	switch runtime.GOOS {
+	case "darwin":
+		if !strings.Contains(help, "brew") {
+			t.Errorf("macOS help should contain brew reference: %s", help)
+		}
+	case "linux":
+		if !strings.Contains(help, "curl") || !strings.Contains(help, tool.PinnedTag()) {
+			t.Errorf("Linux help should download the pinned release with curl: %s", help)
+		}
+	}
+}
+
+func TestKindInstaller_Install(t *testing.T) {
+	if runtime.GOOS != "windows" {
+		t.Skip("Installation is only exercised where it fails without side effects")
+	}
+
+	err := NewKindInstaller().Install()
+	if err == nil || !strings.Contains(err.Error(), "Please install from https://kind.sigs.k8s.io") {
+		t.Errorf("Expected manual installation error, got: %v", err)
+	}
+}
+
+func TestKindInstaller_Tool(t *testing.T) {
+	tool := NewKindInstaller().Tool()
+
+	if tool.Command != "kind" {
+		t.Errorf("Expected tool command to be kind, got %s", tool.Command)
+	}
+	constraint, err := version.ParseConstraint(tool.Supported)
+	if err != nil {
+		t.Fatalf("Supported range should parse: %v", err)
+	}
+	if !constraint.Check(version.MustParse(tool.Pinned)) {
+		t.Errorf("Pinned version %s should be within the supported range %s", tool.Pinned, tool.Supported)
+	}
+	if status := tool.Evaluate("kind v0.19.0 go1.20.4 linux/amd64"); status.State != version.ToolTooOld {
+		t.Errorf("Expected kind v0.19.0 to be too old, got %s", status.State)
+	}
+}
+
+func TestLocalBinary(t *testing.T) {
+	if localBinary.Version != tool.Pinned {
+		t.Errorf("Local binary version %s should be the pinned version %s", localBinary.Version, tool.Pinned)
+	}
+	if err := localBinary.Validate(); err != nil {
+		t.Errorf("Local binary pins an invalid checksum: %v", err)
+	}
+}
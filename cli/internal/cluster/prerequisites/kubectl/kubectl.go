@@ -5,9 +5,24 @@ import (
 	"os"
 	"os/exec"
 	"runtime"
+
+	"github.com/flamingo/openframe/internal/shared/localbin"
+	"github.com/flamingo/openframe/internal/shared/version"
 )
 
-type KubectlInstaller struct{}
+type KubectlInstaller struct {
+	version.Prerequisite
+}
+
+// tool declares the supported kubectl versions
+var tool = version.Tool{Command: "kubectl", VersionArgs: []string{"version", "--client"}, Supported: ">= 1.27.0", Pinned: "1.31.4"}
+
+// localBinary is the pinned kubectl release installed with --local-tools
+var localBinary = localbin.Binary{
+	Name:    "kubectl",
+	Version: tool.Pinned,
+	URL:     "https://dl.k8s.io/release/v{version}/bin/{os}/{arch}/kubectl",
+}
 
 func commandExists(cmd string) bool {
 	_, err := exec.LookPath(cmd)
@@ -37,7 +52,7 @@ func kubectlInstallHelp() string {
 }
 
 func NewKubectlInstaller() *KubectlInstaller {
-	return &KubectlInstaller{}
+	return &KubectlInstaller{Prerequisite: version.NewPrerequisite(tool, isKubectlInstalled)}
 }
 
 func (k *KubectlInstaller) IsInstalled() bool {
@@ -48,7 +63,16 @@ func (k *KubectlInstaller) GetInstallHelp() string {
 	return kubectlInstallHelp()
 }
 
+// LocalBinary returns the pinned kubectl release installed with --local-tools
+func (k *KubectlInstaller) LocalBinary() localbin.Binary {
+	return localBinary
+}
+
 func (k *KubectlInstaller) Install() error {
+	if localbin.Enabled() {
+		return localbin.Install(localBinary)
+	}
+
 	switch runtime.GOOS {
 	case "darwin":
 		return k.installMacOS()
@@ -67,7 +91,7 @@ func (k *KubectlInstaller) installMacOS() error {
 	}
 
 	fmt.Println("Installing kubectl via Homebrew...")
-	cmd := exec.Command("brew", "install", "kubectl")
+	cmd := version.BrewInstallOrUpgrade("kubectl", "kubectl")
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	
@@ -206,7 +230,7 @@ func (k *KubectlInstaller) installBinary() error {
 	}
 
 	commands := []string{
-		fmt.Sprintf("curl -LO \"https://dl.k8s.io/release/$(curl -L -s https://dl.k8s.io/release/stable.txt)/bin/linux/%s/kubectl\"", arch),
+		fmt.Sprintf("curl -LO \"https://dl.k8s.io/release/%s/bin/linux/%s/kubectl\"", tool.PinnedTag(), arch),
 		"sudo install -o root -g root -m 0755 kubectl /usr/local/bin/kubectl",
 		"rm kubectl",
 	}
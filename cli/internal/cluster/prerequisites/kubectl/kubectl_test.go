@@ -3,6 +3,8 @@ package kubectl
 import (
 	"runtime"
 	"testing"
+
+	"github.com/flamingo/openframe/internal/shared/version"
 )
 
 func TestNewKubectlInstaller(t *testing.T) {
@@ -89,4 +91,28 @@ func containsSubstring(str, substr string) bool {
 			   }
 			   return false
 		   }()
-}
\ No newline at end of file
+}
+
+func TestKubectlInstaller_Tool(t *testing.T) {
+	tool := NewKubectlInstaller().Tool()
+
+	if tool.Command != "kubectl" {
+		t.Errorf("Expected tool command to be kubectl, got %s", tool.Command)
+	}
+	constraint, err := version.ParseConstraint(tool.Supported)
+	if err != nil {
+		t.Fatalf("Supported range should parse: %v", err)
+	}
+	if !constraint.Check(version.MustParse(tool.Pinned)) {
+		t.Errorf("Pinned version %s should be within the supported range %s", tool.Pinned, tool.Supported)
+	}
+}
+
+func TestLocalBinary(t *testing.T) {
+	if localBinary.Version != tool.Pinned {
+		t.Errorf("Local binary version %s should be the pinned version %s", localBinary.Version, tool.Pinned)
+	}
+	if err := localBinary.Validate(); err != nil {
+		t.Errorf("Local binary pins an invalid checksum: %v", err)
+	}
+}
@@ -0,0 +1,209 @@
+package k3d
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"gopkg.in/yaml.v3"
+)
+
+// k3dSimpleConfig mirrors the subset of the k3d.io/v1alpha5 Simple config used by OpenFrame
+type k3dSimpleConfig struct {
+	APIVersion string            `yaml:"apiVersion"`
+	Kind       string            `yaml:"kind"`
+	Metadata   k3dConfigMetadata `yaml:"metadata"`
+	Servers    int               `yaml:"servers"`
+	Agents     int               `yaml:"agents"`
+	Image      string            `yaml:"image"`
+	KubeAPI    k3dKubeAPI        `yaml:"kubeAPI"`
+	Volumes    []k3dVolume       `yaml:"volumes,omitempty"`
+	Ports      []k3dPort         `yaml:"ports,omitempty"`
+	Registries *k3dRegistries    `yaml:"registries,omitempty"`
+	Options    k3dConfigOptions  `yaml:"options"`
+}
+
+type k3dConfigMetadata struct {
+	Name string `yaml:"name"`
+}
+
+type k3dKubeAPI struct {
+	Host     string `yaml:"host"`
+	HostIP   string `yaml:"hostIP"`
+	HostPort string `yaml:"hostPort"`
+}
+
+type k3dVolume struct {
+	Volume      string   `yaml:"volume"`
+	NodeFilters []string `yaml:"nodeFilters,omitempty"`
+}
+
+type k3dPort struct {
+	Port        string   `yaml:"port"`
+	NodeFilters []string `yaml:"nodeFilters"`
+}
+
+type k3dRegistries struct {
+	Use    []string `yaml:"use,omitempty"`
+	Config string   `yaml:"config,omitempty"`
+}
+
+type k3dConfigOptions struct {
+	K3s        k3dK3sOptions         `yaml:"k3s"`
+	Kubeconfig *k3dKubeconfigOptions `yaml:"kubeconfig,omitempty"`
+}
+
+type k3dKubeconfigOptions struct {
+	UpdateDefaultKubeconfig bool `yaml:"updateDefaultKubeconfig"`
+	SwitchCurrentContext    bool `yaml:"switchCurrentContext"`
+}
+
+type k3dK3sOptions struct {
+	ExtraArgs []k3dExtraArg `yaml:"extraArgs"`
+}
+
+type k3dExtraArg struct {
+	Arg         string   `yaml:"arg"`
+	NodeFilters []string `yaml:"nodeFilters"`
+}
+
+// defaultK3sExtraArgs are always applied: traefik is replaced by the OpenFrame ingress
+// and kubelet eviction is disabled so local disk pressure does not evict platform pods
+var defaultK3sExtraArgs = []k3dExtraArg{
+	{Arg: "--disable=traefik", NodeFilters: []string{"server:*"}},
+	{Arg: "--kubelet-arg=eviction-hard=", NodeFilters: []string{"all"}},
+	{Arg: "--kubelet-arg=eviction-soft=", NodeFilters: []string{"all"}},
+}
+
+// k3dPorts holds the host ports published for the Kubernetes API and the ingress load balancer
+type k3dPorts struct {
+	API   int
+	HTTP  int
+	HTTPS int
+}
+
+// resolveImage returns the k3s image for a cluster config, honouring a pinned spec image first
+func resolveImage(config models.ClusterConfig) string {
+	if config.Spec != nil && config.Spec.Spec.Image != "" {
+		return config.Spec.Spec.Image
+	}
+	if config.K8sVersion != "" {
+		return "rancher/k3s:" + config.K8sVersion
+	}
+	return defaultK3sImage
+}
+
+// buildK3dConfig builds the k3d Simple config for a cluster
+// With more than one server k3d initialises the first one with --cluster-init,
+// so the control plane runs on embedded etcd instead of sqlite
+func buildK3dConfig(config models.ClusterConfig, ports k3dPorts) k3dSimpleConfig {
+	servers := 1
+	if config.Servers > 0 {
+		servers = config.Servers
+	}
+	agents := config.NodeCount
+	if agents < 1 {
+		agents = 1
+	}
+
+	k3dConfig := k3dSimpleConfig{
+		APIVersion: "k3d.io/v1alpha5",
+		Kind:       "Simple",
+		Metadata:   k3dConfigMetadata{Name: config.Name},
+		Servers:    servers,
+		Agents:     agents,
+		Image:      resolveImage(config),
+		KubeAPI: k3dKubeAPI{
+			Host:     "127.0.0.1",
+			HostIP:   "127.0.0.1",
+			HostPort: strconv.Itoa(ports.API),
+		},
+		Ports: []k3dPort{
+			{Port: strconv.Itoa(ports.HTTP) + ":80", NodeFilters: []string{"loadbalancer"}},
+			{Port: strconv.Itoa(ports.HTTPS) + ":443", NodeFilters: []string{"loadbalancer"}},
+		},
+		Options: k3dConfigOptions{
+			K3s: k3dK3sOptions{
+				ExtraArgs: append([]k3dExtraArg(nil), defaultK3sExtraArgs...),
+			},
+		},
+	}
+
+	// k3d switches the current context by default; the kubeconfig is still merged
+	if config.NoSwitchContext {
+		k3dConfig.Options.Kubeconfig = &k3dKubeconfigOptions{UpdateDefaultKubeconfig: true, SwitchCurrentContext: false}
+	}
+
+	if config.Spec == nil {
+		if localRegistriesEnabled(config) {
+			applyLocalRegistries(&k3dConfig)
+		}
+		return k3dConfig
+	}
+
+	spec := config.Spec.Spec
+	if spec.Servers > 0 {
+		k3dConfig.Servers = spec.Servers
+	}
+	k3dConfig.Agents = spec.Agents
+
+	for _, port := range spec.Ports {
+		nodeFilters := port.NodeFilters
+		if len(nodeFilters) == 0 {
+			nodeFilters = []string{"loadbalancer"}
+		}
+		k3dConfig.Ports = append(k3dConfig.Ports, k3dPort{Port: port.String(), NodeFilters: nodeFilters})
+	}
+
+	for _, arg := range spec.K3sExtraArgs {
+		if hasExtraArg(k3dConfig.Options.K3s.ExtraArgs, arg.Arg) {
+			continue
+		}
+		nodeFilters := arg.NodeFilters
+		if len(nodeFilters) == 0 {
+			nodeFilters = []string{"all"}
+		}
+		k3dConfig.Options.K3s.ExtraArgs = append(k3dConfig.Options.K3s.ExtraArgs, k3dExtraArg{Arg: arg.Arg, NodeFilters: nodeFilters})
+	}
+
+	for _, volume := range spec.Volumes {
+		k3dConfig.Volumes = append(k3dConfig.Volumes, k3dVolume{Volume: volume.Volume, NodeFilters: volume.NodeFilters})
+	}
+
+	if spec.Registries != nil {
+		k3dConfig.Registries = &k3dRegistries{
+			Use:    spec.Registries.Use,
+			Config: spec.Registries.Config,
+		}
+	}
+
+	if localRegistriesEnabled(config) {
+		applyLocalRegistries(&k3dConfig)
+	}
+
+	return k3dConfig
+}
+
+// hasExtraArg checks whether an extra arg is already present
+func hasExtraArg(args []k3dExtraArg, arg string) bool {
+	for _, existing := range args {
+		if existing.Arg == arg {
+			return true
+		}
+	}
+	return false
+}
+
+// renderK3dConfig renders a k3d Simple config as YAML
+func renderK3dConfig(k3dConfig k3dSimpleConfig) (string, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(k3dConfig); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,100 @@
+package k3d
+
+import (
+	"testing"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPorts = k3dPorts{API: 6550, HTTP: 80, HTTPS: 443}
+
+func TestBuildK3dConfig(t *testing.T) {
+	t.Run("builds defaults without spec", func(t *testing.T) {
+		config := buildK3dConfig(models.ClusterConfig{Name: "dev", NodeCount: 3}, testPorts)
+
+		assert.Equal(t, "dev", config.Metadata.Name)
+		assert.Equal(t, 1, config.Servers)
+		assert.Equal(t, 3, config.Agents)
+		assert.Equal(t, defaultK3sImage, config.Image)
+		assert.Equal(t, "6550", config.KubeAPI.HostPort)
+		assert.Len(t, config.Ports, 2)
+		assert.Equal(t, defaultK3sExtraArgs, config.Options.K3s.ExtraArgs)
+		assert.Nil(t, config.Registries)
+	})
+
+	t.Run("keeps the current context when asked", func(t *testing.T) {
+		config := buildK3dConfig(models.ClusterConfig{Name: "dev", NodeCount: 1}, testPorts)
+		assert.Nil(t, config.Options.Kubeconfig, "k3d defaults apply")
+
+		config = buildK3dConfig(models.ClusterConfig{Name: "dev", NodeCount: 1, NoSwitchContext: true}, testPorts)
+		require.NotNil(t, config.Options.Kubeconfig)
+		assert.True(t, config.Options.Kubeconfig.UpdateDefaultKubeconfig)
+		assert.False(t, config.Options.Kubeconfig.SwitchCurrentContext)
+
+		rendered, err := renderK3dConfig(config)
+		require.NoError(t, err)
+		assert.Contains(t, rendered, "switchCurrentContext: false")
+	})
+
+	t.Run("uses server count for HA control planes", func(t *testing.T) {
+		config := buildK3dConfig(models.ClusterConfig{Name: "dev", Servers: 3, NodeCount: 2}, testPorts)
+		assert.Equal(t, 3, config.Servers)
+		assert.Equal(t, 2, config.Agents)
+	})
+
+	t.Run("uses version image", func(t *testing.T) {
+		config := buildK3dConfig(models.ClusterConfig{Name: "dev", NodeCount: 1, K8sVersion: "v1.30.4-k3s1"}, testPorts)
+		assert.Equal(t, "rancher/k3s:v1.30.4-k3s1", config.Image)
+	})
+
+	t.Run("applies spec topology and options", func(t *testing.T) {
+		spec := models.NewDefaultClusterSpec("dev")
+		spec.Spec.Servers = 3
+		spec.Spec.Agents = 0
+		spec.Spec.Image = "rancher/k3s:v1.29.1-k3s1"
+		spec.Spec.Ports = []models.PortSpec{{HostPort: 5432, ContainerPort: 30432}}
+		spec.Spec.K3sExtraArgs = []models.ExtraArgSpec{
+			{Arg: "--disable=traefik"},
+			{Arg: "--disable=metrics-server", NodeFilters: []string{"server:*"}},
+			{Arg: "--node-label=tier=dev"},
+		}
+		spec.Spec.Volumes = []models.VolumeSpec{{Volume: "/tmp/data:/data"}}
+		spec.Spec.Registries = &models.RegistriesSpec{Use: []string{"k3d-registry.localhost:5000"}}
+
+		config := buildK3dConfig(spec.ToClusterConfig(), testPorts)
+
+		assert.Equal(t, 3, config.Servers)
+		assert.Equal(t, 0, config.Agents)
+		assert.Equal(t, "rancher/k3s:v1.29.1-k3s1", config.Image)
+		require.Len(t, config.Ports, 3)
+		assert.Equal(t, k3dPort{Port: "5432:30432", NodeFilters: []string{"loadbalancer"}}, config.Ports[2])
+
+		// --disable=traefik is a default and must not be duplicated
+		args := config.Options.K3s.ExtraArgs
+		require.Len(t, args, len(defaultK3sExtraArgs)+2)
+		assert.Equal(t, k3dExtraArg{Arg: "--disable=metrics-server", NodeFilters: []string{"server:*"}}, args[3])
+		assert.Equal(t, k3dExtraArg{Arg: "--node-label=tier=dev", NodeFilters: []string{"all"}}, args[4])
+
+		require.Len(t, config.Volumes, 1)
+		assert.Equal(t, "/tmp/data:/data", config.Volumes[0].Volume)
+		require.NotNil(t, config.Registries)
+		assert.Equal(t, []string{"k3d-registry.localhost:5000"}, config.Registries.Use)
+	})
+}
+
+func TestRenderK3dConfig(t *testing.T) {
+	rendered, err := renderK3dConfig(buildK3dConfig(models.ClusterConfig{Name: "dev", NodeCount: 2}, testPorts))
+
+	require.NoError(t, err)
+	assert.Contains(t, rendered, "apiVersion: k3d.io/v1alpha5")
+	assert.Contains(t, rendered, "kind: Simple")
+	assert.Contains(t, rendered, "name: dev")
+	assert.Contains(t, rendered, "agents: 2")
+	assert.Contains(t, rendered, "hostPort: \"6550\"")
+	assert.Contains(t, rendered, "port: 80:80")
+	assert.Contains(t, rendered, "arg: --disable=traefik")
+	assert.NotContains(t, rendered, "registries:")
+	assert.NotContains(t, rendered, "volumes:")
+}
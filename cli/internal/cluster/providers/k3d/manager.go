@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"net"
 	"os"
-	"runtime"
 	"strconv"
 	"strings"
 	"time"
@@ -28,6 +27,9 @@ const (
 	timestampSuffixLen = 6
 )
 
+// DefaultK3sImage is the node image of clusters created without a Kubernetes version or spec image
+const DefaultK3sImage = defaultK3sImage
+
 // ClusterManager interface for managing clusters
 type ClusterManager interface {
 	DetectClusterType(ctx context.Context, name string) (models.ClusterType, error)
@@ -70,6 +72,12 @@ func (m *K3dManager) CreateCluster(ctx context.Context, config models.ClusterCon
 		return models.NewProviderNotFoundError(config.Type)
 	}
 
+	if localRegistriesEnabled(config) {
+		if err := m.EnsureLocalRegistries(ctx); err != nil {
+			return models.NewClusterOperationError("create", config.Name, err)
+		}
+	}
+
 	configFile, err := m.createK3dConfigFile(config)
 	if err != nil {
 		return models.NewClusterOperationError("create", config.Name, fmt.Errorf("failed to create config file: %w", err))
@@ -91,6 +99,10 @@ func (m *K3dManager) CreateCluster(ctx context.Context, config models.ClusterCon
 		return models.NewClusterOperationError("create", config.Name, fmt.Errorf("failed to create cluster %s: %w", config.Name, err))
 	}
 
+	if config.NoSwitchContext {
+		return nil
+	}
+
 	// Set kubectl context to the newly created cluster
 	contextName := fmt.Sprintf("k3d-%s", config.Name)
 	if _, err := m.executor.Execute(ctx, "kubectl", "config", "use-context", contextName); err != nil {
@@ -144,6 +156,28 @@ func (m *K3dManager) StartCluster(ctx context.Context, name string, clusterType
 	return nil
 }
 
+// StopCluster stops a K3D cluster, keeping its containers and volumes
+func (m *K3dManager) StopCluster(ctx context.Context, name string, clusterType models.ClusterType) error {
+	if name == "" {
+		return models.NewInvalidConfigError("name", name, "cluster name cannot be empty")
+	}
+
+	if clusterType != models.ClusterTypeK3d {
+		return models.NewProviderNotFoundError(clusterType)
+	}
+
+	args := []string{"cluster", "stop", name}
+	if m.verbose {
+		args = append(args, "--verbose")
+	}
+
+	if _, err := m.executor.Execute(ctx, "k3d", args...); err != nil {
+		return models.NewClusterOperationError("stop", name, fmt.Errorf("failed to stop cluster %s: %w", name, err))
+	}
+
+	return nil
+}
+
 // ListClusters returns all K3D clusters
 func (m *K3dManager) ListClusters(ctx context.Context) ([]models.ClusterInfo, error) {
 	args := []string{"cluster", "list", "--output", "json"}
@@ -174,9 +208,11 @@ func (m *K3dManager) ListClusters(ctx context.Context) ([]models.ClusterInfo, er
 			Name:      k3dCluster.Name,
 			Type:      models.ClusterTypeK3d,
 			Status:    fmt.Sprintf("%d/%d", k3dCluster.ServersRunning, k3dCluster.ServersCount),
+			State:     models.ClusterState(k3dCluster.ServersRunning, k3dCluster.ServersCount, k3dCluster.AgentsRunning, k3dCluster.AgentsCount),
 			NodeCount: k3dCluster.AgentsCount + k3dCluster.ServersCount,
 			CreatedAt: createdAt,
-			Nodes:     []models.NodeInfo{},
+			Nodes:     clusterNodes(k3dCluster.Nodes),
+			Ports:     clusterPorts(k3dCluster.Nodes),
 		})
 	}
 
@@ -253,62 +289,19 @@ func (m *K3dManager) validateClusterConfig(config models.ClusterConfig) error {
 
 // createK3dConfigFile creates a k3d config file
 func (m *K3dManager) createK3dConfigFile(config models.ClusterConfig) (string, error) {
-	image := defaultK3sImage
-	if runtime.GOARCH == "arm64" {
-		image = defaultK3sImage
-	}
-	if config.K8sVersion != "" {
-		image = "rancher/k3s:" + config.K8sVersion
-	}
-
-	servers := 1
-	agents := config.NodeCount
-	if agents < 1 {
-		agents = 1
+	ports, err := m.resolvePorts(config.Ports)
+	if err != nil {
+		return "", err
 	}
 
-	configContent := fmt.Sprintf(`apiVersion: k3d.io/v1alpha5
-kind: Simple
-metadata:
-  name: %s
-servers: %d
-agents: %d
-image: %s`, config.Name, servers, agents, image)
-
-	// Always use dynamic ports to avoid conflicts, regardless of cluster name
-	ports, err := m.findAvailablePorts(3)
-	if err != nil || len(ports) < 3 {
-		return "", fmt.Errorf("failed to allocate available ports: %w", err)
+	configContent, err := renderK3dConfig(buildK3dConfig(config, k3dPorts{
+		API:   ports.API,
+		HTTP:  ports.HTTP,
+		HTTPS: ports.HTTPS,
+	}))
+	if err != nil {
+		return "", fmt.Errorf("failed to render k3d config: %w", err)
 	}
-	
-	apiPort := strconv.Itoa(ports[0])
-	httpPort := strconv.Itoa(ports[1])
-	httpsPort := strconv.Itoa(ports[2])
-
-	configContent += fmt.Sprintf(`
-kubeAPI:
-  host: "127.0.0.1"
-  hostIP: "127.0.0.1"
-  hostPort: "%s"
-options:
-  k3s:
-    extraArgs:
-      - arg: --disable=traefik
-        nodeFilters:
-          - server:*
-      - arg: --kubelet-arg=eviction-hard=
-        nodeFilters:
-          - all
-      - arg: --kubelet-arg=eviction-soft=
-        nodeFilters:
-          - all
-ports:
-  - port: %s:80
-    nodeFilters:
-      - loadbalancer
-  - port: %s:443
-    nodeFilters:
-      - loadbalancer`, apiPort, httpPort, httpsPort)
 
 	tmpFile, err := os.CreateTemp("", "k3d-config-*.yaml")
 	if err != nil {
@@ -342,6 +335,19 @@ func (m *K3dManager) isTestCluster(name string) bool {
 		strings.ContainsAny(name[len(name)-timestampSuffixLen:], "0123456789")
 }
 
+// resolvePorts uses the ports leased by the cluster service, probing for free ports only for the missing ones
+func (m *K3dManager) resolvePorts(requested models.ClusterPorts) (models.ClusterPorts, error) {
+	if requested.IsComplete() {
+		return requested, nil
+	}
+	// Always use dynamic ports to avoid conflicts, regardless of cluster name
+	ports, err := m.findAvailablePorts(3)
+	if err != nil || len(ports) < 3 {
+		return models.ClusterPorts{}, fmt.Errorf("failed to allocate available ports: %w", err)
+	}
+	return requested.WithDefaults(models.ClusterPorts{API: ports[0], HTTP: ports[1], HTTPS: ports[2]}), nil
+}
+
 // findAvailablePorts finds the specified number of available TCP ports using intelligent approach
 func (m *K3dManager) findAvailablePorts(count int) ([]int, error) {
 	// Get ports used by existing k3d clusters
@@ -441,6 +447,57 @@ func (m *K3dManager) isPortAvailable(port int) bool {
 	return true
 }
 
+// clusterNodes converts k3d server and agent nodes into domain node information
+func clusterNodes(nodes []k3dNode) []models.NodeInfo {
+	result := []models.NodeInfo{}
+	for _, node := range nodes {
+		if node.Role != "server" && node.Role != "agent" {
+			continue
+		}
+		status := node.State.Status
+		if status == "" {
+			status = "unknown"
+		}
+		result = append(result, models.NodeInfo{
+			Name:   node.Name,
+			Status: status,
+			Role:   node.Role,
+		})
+	}
+	return result
+}
+
+// clusterPorts extracts the published API and ingress host ports from the k3d runtime data
+// The API port is stored in the server runtime labels, ingress ports are mapped on the load balancer
+func clusterPorts(nodes []k3dNode) models.ClusterPorts {
+	var ports models.ClusterPorts
+	for _, node := range nodes {
+		switch node.Role {
+		case "server":
+			if ports.API == 0 {
+				if port, err := strconv.Atoi(node.RuntimeLabels["k3d.server.api.port"]); err == nil {
+					ports.API = port
+					ports.APIHost = node.RuntimeLabels["k3d.server.api.host"]
+				}
+			}
+		case "loadbalancer":
+			ports.HTTP = hostPort(node.PortMappings, "80/tcp")
+			ports.HTTPS = hostPort(node.PortMappings, "443/tcp")
+		}
+	}
+	return ports
+}
+
+// hostPort returns the first host port mapped to a container port, or 0 if it is not published
+func hostPort(mappings map[string][]PortMapping, containerPort string) int {
+	for _, mapping := range mappings[containerPort] {
+		if port, err := strconv.Atoi(mapping.HostPort); err == nil {
+			return port
+		}
+	}
+	return 0
+}
+
 // k3dClusterInfo represents the JSON structure returned by k3d cluster list
 type k3dClusterInfo struct {
 	Name           string    `json:"name"`
@@ -459,6 +516,13 @@ type k3dNode struct {
 	Created        time.Time                 `json:"created"`
 	RuntimeLabels  map[string]string         `json:"runtimeLabels,omitempty"`
 	PortMappings   map[string][]PortMapping  `json:"portMappings,omitempty"`
+	State          k3dNodeState              `json:"State"`
+}
+
+// k3dNodeState represents the container state of a k3d node
+type k3dNodeState struct {
+	Running bool   `json:"Running"`
+	Status  string `json:"Status"`
 }
 
 // PortMapping represents a port mapping for k3d nodes
@@ -110,6 +110,19 @@ func TestK3dManager_CreateCluster(t *testing.T) {
 				m.On("Execute", mock.Anything, "kubectl", mock.Anything).Return(&execPkg.CommandResult{Stdout: "Switched to context \"k3d-test-cluster\"."}, nil)
 			},
 		},
+		{
+			name: "cluster creation without switching context",
+			config: models.ClusterConfig{
+				Name:            "test-cluster",
+				Type:            models.ClusterTypeK3d,
+				NodeCount:       1,
+				NoSwitchContext: true,
+			},
+			setupMock: func(m *MockExecutor) {
+				// kubectl must not be called, so no expectation is registered for it
+				m.On("Execute", mock.Anything, "k3d", mock.Anything).Return(&execPkg.CommandResult{Stdout: "success"}, nil)
+			},
+		},
 		{
 			name: "empty cluster name",
 			config: models.ClusterConfig{
@@ -173,6 +186,33 @@ func TestK3dManager_CreateCluster(t *testing.T) {
 	}
 }
 
+func TestK3dManager_resolvePorts(t *testing.T) {
+	t.Run("leased ports are used without probing", func(t *testing.T) {
+		executor := &MockExecutor{}
+		manager := NewK3dManager(executor, false)
+		leased := models.ClusterPorts{API: 16550, HTTP: 18080, HTTPS: 18443}
+
+		ports, err := manager.resolvePorts(leased)
+
+		assert.NoError(t, err)
+		assert.Equal(t, leased, ports)
+		executor.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("missing ports are probed", func(t *testing.T) {
+		executor := &MockExecutor{}
+		executor.On("Execute", mock.Anything, "k3d", []string{"cluster", "list", "--output", "json"}).Return(&execPkg.CommandResult{Stdout: "[]"}, nil)
+		manager := NewK3dManager(executor, false)
+
+		ports, err := manager.resolvePorts(models.ClusterPorts{HTTPS: 18443})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 18443, ports.HTTPS)
+		assert.NotZero(t, ports.API)
+		assert.NotZero(t, ports.HTTP)
+	})
+}
+
 func TestK3dManager_CreateCluster_VerboseMode(t *testing.T) {
 	executor := &MockExecutor{}
 	executor.On("Execute", mock.Anything, "k3d", mock.Anything).Return(&execPkg.CommandResult{Stdout: "success"}, nil)
@@ -314,6 +354,67 @@ func TestK3dManager_StartCluster(t *testing.T) {
 	}
 }
 
+func TestK3dManager_StopCluster(t *testing.T) {
+	tests := []struct {
+		name          string
+		clusterName   string
+		clusterType   models.ClusterType
+		setupMock     func(*MockExecutor)
+		expectedError string
+	}{
+		{
+			name:        "successful cluster stop",
+			clusterName: "test-cluster",
+			clusterType: models.ClusterTypeK3d,
+			setupMock: func(m *MockExecutor) {
+				m.On("Execute", mock.Anything, "k3d", []string{"cluster", "stop", "test-cluster"}).Return(&execPkg.CommandResult{Stdout: "success"}, nil)
+			},
+		},
+		{
+			name:          "empty cluster name",
+			clusterName:   "",
+			clusterType:   models.ClusterTypeK3d,
+			expectedError: "cluster name cannot be empty",
+		},
+		{
+			name:          "invalid cluster type",
+			clusterName:   "test-cluster",
+			clusterType:   models.ClusterTypeGKE,
+			expectedError: "no provider available for cluster type 'gke'",
+		},
+		{
+			name:        "k3d command fails",
+			clusterName: "test-cluster",
+			clusterType: models.ClusterTypeK3d,
+			setupMock: func(m *MockExecutor) {
+				m.On("Execute", mock.Anything, "k3d", mock.Anything).Return(nil, errors.New("k3d error"))
+			},
+			expectedError: "failed to stop cluster test-cluster",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executor := &MockExecutor{}
+			if tt.setupMock != nil {
+				tt.setupMock(executor)
+			}
+
+			manager := NewK3dManager(executor, false)
+			err := manager.StopCluster(context.Background(), tt.clusterName, tt.clusterType)
+
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			executor.AssertExpectations(t)
+		})
+	}
+}
+
 func TestK3dManager_ListClusters(t *testing.T) {
 	t.Run("successful cluster listing", func(t *testing.T) {
 		executor := &MockExecutor{}
@@ -347,11 +448,13 @@ func TestK3dManager_ListClusters(t *testing.T) {
 		assert.Equal(t, "cluster1", clusters[0].Name)
 		assert.Equal(t, models.ClusterTypeK3d, clusters[0].Type)
 		assert.Equal(t, "1/1", clusters[0].Status)
+		assert.Equal(t, models.ClusterStateRunning, clusters[0].State)
 		assert.Equal(t, 3, clusters[0].NodeCount) // 1 server + 2 agents
 
 		assert.Equal(t, "cluster2", clusters[1].Name)
 		assert.Equal(t, models.ClusterTypeK3d, clusters[1].Type)
 		assert.Equal(t, "0/1", clusters[1].Status)
+		assert.Equal(t, models.ClusterStateStopped, clusters[1].State)
 		assert.Equal(t, 2, clusters[1].NodeCount) // 1 server + 1 agent
 
 		executor.AssertExpectations(t)
@@ -386,6 +489,55 @@ func TestK3dManager_ListClusters(t *testing.T) {
 	})
 }
 
+func TestK3dManager_ListClusters_RuntimeData(t *testing.T) {
+	executor := &MockExecutor{}
+	jsonOutput := `[
+		{
+			"name": "dev",
+			"serversCount": 1,
+			"serversRunning": 1,
+			"agentsCount": 1,
+			"agentsRunning": 0,
+			"nodes": [
+				{
+					"name": "k3d-dev-server-0",
+					"role": "server",
+					"runtimeLabels": {"k3d.server.api.host": "127.0.0.1", "k3d.server.api.port": "6551"},
+					"State": {"Running": true, "Status": "running"}
+				},
+				{
+					"name": "k3d-dev-agent-0",
+					"role": "agent",
+					"State": {"Running": false, "Status": "exited"}
+				},
+				{
+					"name": "k3d-dev-serverlb",
+					"role": "loadbalancer",
+					"portMappings": {
+						"80/tcp": [{"HostIp": "0.0.0.0", "HostPort": "8080"}],
+						"443/tcp": [{"HostIp": "0.0.0.0", "HostPort": "8443"}],
+						"6443/tcp": [{"HostIp": "127.0.0.1", "HostPort": "6551"}]
+					},
+					"State": {"Running": true, "Status": "running"}
+				}
+			]
+		}
+	]`
+
+	executor.On("Execute", mock.Anything, "k3d", []string{"cluster", "list", "--output", "json"}).Return(&execPkg.CommandResult{Stdout: jsonOutput}, nil)
+
+	manager := NewK3dManager(executor, false)
+	clusters, err := manager.ListClusters(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, clusters, 1)
+	assert.Equal(t, models.ClusterPorts{APIHost: "127.0.0.1", API: 6551, HTTP: 8080, HTTPS: 8443}, clusters[0].Ports)
+	assert.Equal(t, []models.NodeInfo{
+		{Name: "k3d-dev-server-0", Status: "running", Role: "server"},
+		{Name: "k3d-dev-agent-0", Status: "exited", Role: "agent"},
+	}, clusters[0].Nodes)
+}
+
 func TestK3dManager_ListAllClusters(t *testing.T) {
 	t.Run("calls ListClusters", func(t *testing.T) {
 		executor := &MockExecutor{}
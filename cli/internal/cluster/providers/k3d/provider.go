@@ -0,0 +1,63 @@
+package k3d
+
+import (
+	"context"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+)
+
+// Provider adapts K3dManager to the models.ClusterProvider contract
+// so k3d clusters can be dispatched through a provider registry
+type Provider struct {
+	manager *K3dManager
+}
+
+// NewProvider creates a cluster provider backed by the given K3D manager
+func NewProvider(manager *K3dManager) *Provider {
+	return &Provider{manager: manager}
+}
+
+// Manager returns the underlying K3D manager
+func (p *Provider) Manager() *K3dManager {
+	return p.manager
+}
+
+// Create creates a new K3D cluster
+func (p *Provider) Create(ctx context.Context, config models.ClusterConfig) error {
+	return p.manager.CreateCluster(ctx, config)
+}
+
+// Delete removes a K3D cluster
+func (p *Provider) Delete(ctx context.Context, name string, force bool) error {
+	return p.manager.DeleteCluster(ctx, name, models.ClusterTypeK3d, force)
+}
+
+// Start starts a stopped K3D cluster
+func (p *Provider) Start(ctx context.Context, name string) error {
+	return p.manager.StartCluster(ctx, name, models.ClusterTypeK3d)
+}
+
+// Stop stops a running K3D cluster
+func (p *Provider) Stop(ctx context.Context, name string) error {
+	return p.manager.StopCluster(ctx, name, models.ClusterTypeK3d)
+}
+
+// List returns all K3D clusters
+func (p *Provider) List(ctx context.Context) ([]models.ClusterInfo, error) {
+	return p.manager.ListClusters(ctx)
+}
+
+// Status returns detailed status for a K3D cluster
+func (p *Provider) Status(ctx context.Context, name string) (models.ClusterInfo, error) {
+	return p.manager.GetClusterStatus(ctx, name)
+}
+
+// DetectType checks if the cluster is managed by K3D
+func (p *Provider) DetectType(ctx context.Context, name string) (models.ClusterType, error) {
+	return p.manager.DetectClusterType(ctx, name)
+}
+
+// GetKubeconfig returns the kubeconfig for a K3D cluster
+func (p *Provider) GetKubeconfig(ctx context.Context, name string) (string, error) {
+	return p.manager.GetKubeconfig(ctx, name, models.ClusterTypeK3d)
+}
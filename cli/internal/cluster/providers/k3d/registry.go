@@ -0,0 +1,171 @@
+package k3d
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"gopkg.in/yaml.v3"
+)
+
+// Shared registries are created once and reused by every cluster, so pushed and
+// cached images survive cluster delete/create cycles. Host ports avoid 5000, which
+// macOS reserves for AirPlay
+const (
+	localRegistryName  = "openframe-registry.localhost" // k3d names the container models.LocalRegistryContainer
+	localRegistryPort  = 5050
+	localRegistryData  = "openframe-registry-data"
+	mirrorRegistryName = "openframe-mirror.localhost"
+	mirrorRegistryPort = 5051
+	mirrorRegistryData = "openframe-mirror-data"
+	mirrorRemoteURL    = "https://registry-1.docker.io"
+	registryDataPath   = "/var/lib/registry"
+	registryInnerPort  = 5000
+)
+
+// mirrorRegistryContainer is the docker container name k3d gives the pull-through cache
+const mirrorRegistryContainer = "k3d-" + mirrorRegistryName
+
+// sharedRegistry describes a k3d-managed registry that OpenFrame clusters attach to
+type sharedRegistry struct {
+	name      string
+	port      int
+	volume    string
+	remoteURL string
+}
+
+// sharedRegistries are the local push registry and the Docker Hub pull-through cache
+var sharedRegistries = []sharedRegistry{
+	{name: localRegistryName, port: localRegistryPort, volume: localRegistryData},
+	{name: mirrorRegistryName, port: mirrorRegistryPort, volume: mirrorRegistryData, remoteURL: mirrorRemoteURL},
+}
+
+// container returns the docker container name of the registry
+func (r sharedRegistry) container() string {
+	return "k3d-" + r.name
+}
+
+// useReference returns the registry reference used in the k3d config registries.use list
+func (r sharedRegistry) useReference() string {
+	return r.container() + ":" + strconv.Itoa(r.port)
+}
+
+// createArgs returns the k3d arguments that create the registry
+func (r sharedRegistry) createArgs() []string {
+	args := []string{"registry", "create", r.name,
+		"--port", strconv.Itoa(r.port),
+		"--volume", r.volume + ":" + registryDataPath,
+	}
+	if r.remoteURL != "" {
+		args = append(args, "--proxy-remote-url", r.remoteURL)
+	}
+	return args
+}
+
+// k3dRegistryInfo is the subset of `k3d registry list -o json` used to find existing registries
+type k3dRegistryInfo struct {
+	Name  string       `json:"name"`
+	State k3dNodeState `json:"State"`
+}
+
+// EnsureLocalRegistries creates the shared registry and pull-through cache, or starts
+// them again when they already exist from an earlier cluster
+func (m *K3dManager) EnsureLocalRegistries(ctx context.Context) error {
+	result, err := m.executor.Execute(ctx, "k3d", "registry", "list", "--output", "json")
+	if err != nil {
+		return fmt.Errorf("failed to list k3d registries: %w", err)
+	}
+
+	existing := map[string]k3dRegistryInfo{}
+	if result.Stdout != "" {
+		var registries []k3dRegistryInfo
+		if err := json.Unmarshal([]byte(result.Stdout), &registries); err != nil {
+			return fmt.Errorf("failed to parse registry list JSON: %w", err)
+		}
+		for _, registry := range registries {
+			existing[registry.Name] = registry
+		}
+	}
+
+	for _, registry := range sharedRegistries {
+		info, found := existing[registry.container()]
+		if !found {
+			if _, err := m.executor.Execute(ctx, "k3d", registry.createArgs()...); err != nil {
+				return fmt.Errorf("failed to create registry %s: %w", registry.name, err)
+			}
+			continue
+		}
+		if !info.State.Running {
+			if _, err := m.executor.Execute(ctx, "docker", "start", registry.container()); err != nil {
+				return fmt.Errorf("failed to start registry %s: %w", registry.name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// localRegistriesEnabled reports whether a cluster should use the shared registries
+func localRegistriesEnabled(config models.ClusterConfig) bool {
+	if config.LocalRegistry {
+		return true
+	}
+	return config.Spec != nil && config.Spec.Spec.Registries != nil && config.Spec.Spec.Registries.Local
+}
+
+// applyLocalRegistries attaches the shared registries to a k3d config and routes
+// docker.io pulls through the cache, keeping any mirrors configured in the spec
+func applyLocalRegistries(k3dConfig *k3dSimpleConfig) {
+	if k3dConfig.Registries == nil {
+		k3dConfig.Registries = &k3dRegistries{}
+	}
+
+	for _, registry := range sharedRegistries {
+		if !containsString(k3dConfig.Registries.Use, registry.useReference()) {
+			k3dConfig.Registries.Use = append(k3dConfig.Registries.Use, registry.useReference())
+		}
+	}
+
+	k3dConfig.Registries.Config = mergeMirrorConfig(k3dConfig.Registries.Config)
+}
+
+// mergeMirrorConfig adds the docker.io pull-through mirror to a registries.yaml document
+// unless the document already defines its own docker.io mirror
+func mergeMirrorConfig(existing string) string {
+	config := map[string]interface{}{}
+	if existing != "" {
+		if err := yaml.Unmarshal([]byte(existing), &config); err != nil || config == nil {
+			// The spec validates registries.config, so this only happens for hand-built configs
+			return existing
+		}
+	}
+
+	mirrors, ok := config["mirrors"].(map[string]interface{})
+	if !ok {
+		mirrors = map[string]interface{}{}
+		config["mirrors"] = mirrors
+	}
+	if _, found := mirrors["docker.io"]; !found {
+		mirrors["docker.io"] = map[string]interface{}{
+			"endpoint": []string{fmt.Sprintf("http://%s:%d", mirrorRegistryContainer, registryInnerPort)},
+		}
+	}
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return existing
+	}
+	return string(data)
+}
+
+// containsString checks whether a string slice contains a value
+func containsString(values []string, value string) bool {
+	for _, existing := range values {
+		if existing == value {
+			return true
+		}
+	}
+	return false
+}
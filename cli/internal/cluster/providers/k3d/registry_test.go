@@ -0,0 +1,120 @@
+package k3d
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	execPkg "github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+var registryListArgs = []string{"registry", "list", "--output", "json"}
+
+func TestK3dManager_EnsureLocalRegistries(t *testing.T) {
+	t.Run("creates missing registries with persistent volumes", func(t *testing.T) {
+		executor := &MockExecutor{}
+		executor.On("Execute", mock.Anything, "k3d", registryListArgs).Return(&execPkg.CommandResult{Stdout: "[]"}, nil)
+		executor.On("Execute", mock.Anything, "k3d", []string{"registry", "create", "openframe-registry.localhost",
+			"--port", "5050", "--volume", "openframe-registry-data:/var/lib/registry"}).Return(&execPkg.CommandResult{}, nil)
+		executor.On("Execute", mock.Anything, "k3d", []string{"registry", "create", "openframe-mirror.localhost",
+			"--port", "5051", "--volume", "openframe-mirror-data:/var/lib/registry",
+			"--proxy-remote-url", "https://registry-1.docker.io"}).Return(&execPkg.CommandResult{}, nil)
+
+		manager := NewK3dManager(executor, false)
+		require.NoError(t, manager.EnsureLocalRegistries(context.Background()))
+
+		executor.AssertExpectations(t)
+	})
+
+	t.Run("reuses running registries and starts stopped ones", func(t *testing.T) {
+		executor := &MockExecutor{}
+		executor.On("Execute", mock.Anything, "k3d", registryListArgs).Return(&execPkg.CommandResult{Stdout: `[
+			{"name": "k3d-openframe-registry.localhost", "role": "registry", "State": {"Running": true, "Status": "running"}},
+			{"name": "k3d-openframe-mirror.localhost", "role": "registry", "State": {"Running": false, "Status": "exited"}}
+		]`}, nil)
+		executor.On("Execute", mock.Anything, "docker", []string{"start", "k3d-openframe-mirror.localhost"}).Return(&execPkg.CommandResult{}, nil)
+
+		manager := NewK3dManager(executor, false)
+		require.NoError(t, manager.EnsureLocalRegistries(context.Background()))
+
+		executor.AssertExpectations(t)
+		executor.AssertNotCalled(t, "Execute", mock.Anything, "k3d", mock.MatchedBy(func(args []string) bool {
+			return len(args) > 1 && args[1] == "create"
+		}))
+	})
+
+	t.Run("fails when registries cannot be listed", func(t *testing.T) {
+		executor := &MockExecutor{}
+		executor.On("Execute", mock.Anything, "k3d", registryListArgs).Return(nil, errors.New("docker not running"))
+
+		manager := NewK3dManager(executor, false)
+		err := manager.EnsureLocalRegistries(context.Background())
+
+		assert.ErrorContains(t, err, "failed to list k3d registries")
+	})
+}
+
+func TestK3dManager_CreateCluster_LocalRegistry(t *testing.T) {
+	executor := &MockExecutor{}
+	executor.On("Execute", mock.Anything, "k3d", registryListArgs).Return(nil, errors.New("docker not running"))
+
+	manager := NewK3dManager(executor, false)
+	err := manager.CreateCluster(context.Background(), models.ClusterConfig{
+		Name:          "dev",
+		Type:          models.ClusterTypeK3d,
+		NodeCount:     1,
+		LocalRegistry: true,
+	})
+
+	assert.ErrorContains(t, err, "failed to list k3d registries")
+	executor.AssertNotCalled(t, "Execute", mock.Anything, "k3d", mock.MatchedBy(func(args []string) bool {
+		return len(args) > 1 && args[0] == "cluster" && args[1] == "create"
+	}))
+}
+
+func TestBuildK3dConfig_LocalRegistry(t *testing.T) {
+	t.Run("attaches registries and mirrors docker.io", func(t *testing.T) {
+		config := buildK3dConfig(models.ClusterConfig{Name: "dev", NodeCount: 1, LocalRegistry: true}, testPorts)
+
+		require.NotNil(t, config.Registries)
+		assert.Equal(t, []string{"k3d-openframe-registry.localhost:5050", "k3d-openframe-mirror.localhost:5051"}, config.Registries.Use)
+
+		var registries map[string]map[string]map[string][]string
+		require.NoError(t, yaml.Unmarshal([]byte(config.Registries.Config), &registries))
+		assert.Equal(t, []string{"http://k3d-openframe-mirror.localhost:5000"}, registries["mirrors"]["docker.io"]["endpoint"])
+	})
+
+	t.Run("keeps registries and mirrors from the spec", func(t *testing.T) {
+		spec := models.NewDefaultClusterSpec("dev")
+		spec.Spec.Registries = &models.RegistriesSpec{
+			Local:  true,
+			Use:    []string{"k3d-registry.localhost:5000"},
+			Config: "mirrors:\n  docker.io:\n    endpoint:\n      - https://mirror.example.com\n",
+		}
+
+		config := buildK3dConfig(spec.ToClusterConfig(), testPorts)
+
+		require.NotNil(t, config.Registries)
+		assert.Equal(t, []string{"k3d-registry.localhost:5000", "k3d-openframe-registry.localhost:5050", "k3d-openframe-mirror.localhost:5051"}, config.Registries.Use)
+		assert.Contains(t, config.Registries.Config, "https://mirror.example.com")
+		assert.NotContains(t, config.Registries.Config, "k3d-openframe-mirror.localhost")
+	})
+}
+
+func TestMergeMirrorConfig(t *testing.T) {
+	merged := mergeMirrorConfig("configs:\n  ghcr.io:\n    auth:\n      username: dev\n")
+
+	assert.Contains(t, merged, "ghcr.io")
+	assert.Contains(t, merged, "username: dev")
+	assert.Contains(t, merged, "http://k3d-openframe-mirror.localhost:5000")
+}
+
+func TestLocalRegistryHost(t *testing.T) {
+	assert.Equal(t, fmt.Sprintf("k3d-%s:%d", localRegistryName, localRegistryPort), models.LocalRegistryHost)
+}
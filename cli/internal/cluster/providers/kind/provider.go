@@ -0,0 +1,605 @@
+package kind
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/shared/executor"
+)
+
+// Constants for configuration
+const (
+	defaultKindImage   = "kindest/node:v1.31.4"
+	defaultWaitTimeout = "300s"
+	clusterLabel       = "io.x-k8s.kind.cluster"
+	roleLabel          = "io.x-k8s.kind.role"
+	loadBalancerRole   = "external-load-balancer" // Role of the haproxy kind adds in front of multiple control planes
+	dockerTimeLayout   = "2006-01-02 15:04:05 -0700 MST"
+)
+
+// hostPortPattern extracts host ports from docker ps port output (e.g. "127.0.0.1:6550->6443/tcp")
+var hostPortPattern = regexp.MustCompile(`:(\d+)->`)
+
+// publishedPortPattern captures host IP, host port and container port of a published TCP port
+var publishedPortPattern = regexp.MustCompile(`([\d.]+|\[::\]):(\d+)->(\d+)/tcp`)
+
+// KindProvider manages kind (Kubernetes in Docker) cluster operations
+type KindProvider struct {
+	executor executor.CommandExecutor
+	verbose  bool
+	timeout  string
+}
+
+// NewKindProvider creates a new kind cluster provider with default timeout
+func NewKindProvider(exec executor.CommandExecutor, verbose bool) *KindProvider {
+	if exec == nil {
+		panic("Executor cannot be nil - must be provided by calling code to avoid import cycles")
+	}
+	return &KindProvider{
+		executor: exec,
+		verbose:  verbose,
+		timeout:  defaultWaitTimeout,
+	}
+}
+
+// Create creates a new kind cluster using a generated config file
+func (p *KindProvider) Create(ctx context.Context, config models.ClusterConfig) error {
+	if err := p.validateClusterConfig(config); err != nil {
+		return err
+	}
+
+	if config.Type != models.ClusterTypeKind {
+		return models.NewProviderNotFoundError(config.Type)
+	}
+
+	configFile, err := p.createKindConfigFile(config)
+	if err != nil {
+		return models.NewClusterOperationError("create", config.Name, fmt.Errorf("failed to create config file: %w", err))
+	}
+	defer os.Remove(configFile)
+
+	if p.verbose {
+		if configContent, err := os.ReadFile(configFile); err == nil {
+			fmt.Printf("DEBUG: Config file content for %s:\n%s\n", config.Name, string(configContent))
+		}
+	}
+
+	args := []string{"create", "cluster", "--name", config.Name, "--config", configFile, "--wait", p.timeout}
+	if p.verbose {
+		args = append(args, "--verbosity", "1")
+	}
+
+	// kind always switches the current context on create, so the previous one is restored afterwards
+	previousContext := ""
+	if config.NoSwitchContext {
+		previousContext = p.currentContext(ctx)
+	}
+
+	if _, err := p.executor.Execute(ctx, "kind", args...); err != nil {
+		return models.NewClusterOperationError("create", config.Name, fmt.Errorf("failed to create cluster %s: %w", config.Name, err))
+	}
+
+	if config.NoSwitchContext {
+		if previousContext == "" {
+			return nil
+		}
+		if _, err := p.executor.Execute(ctx, "kubectl", "config", "use-context", previousContext); err != nil {
+			return models.NewClusterOperationError("context-switch", config.Name, fmt.Errorf("failed to restore kubectl context %s: %w", previousContext, err))
+		}
+		return nil
+	}
+
+	// Set kubectl context to the newly created cluster
+	contextName := fmt.Sprintf("kind-%s", config.Name)
+	if _, err := p.executor.Execute(ctx, "kubectl", "config", "use-context", contextName); err != nil {
+		return models.NewClusterOperationError("context-switch", config.Name, fmt.Errorf("failed to switch kubectl context to %s: %w", contextName, err))
+	}
+
+	return nil
+}
+
+// currentContext returns the current kubectl context, or an empty string when none is set
+func (p *KindProvider) currentContext(ctx context.Context) string {
+	result, err := p.executor.Execute(ctx, "kubectl", "config", "current-context")
+	if err != nil || result == nil {
+		return ""
+	}
+	return strings.TrimSpace(result.Stdout)
+}
+
+// Delete removes a kind cluster
+func (p *KindProvider) Delete(ctx context.Context, name string, force bool) error {
+	if name == "" {
+		return models.NewInvalidConfigError("name", name, "cluster name cannot be empty")
+	}
+
+	if _, err := p.executor.Execute(ctx, "kind", "delete", "cluster", "--name", name); err != nil {
+		return models.NewClusterOperationError("delete", name, fmt.Errorf("failed to delete cluster %s: %w", name, err))
+	}
+
+	return nil
+}
+
+// Start starts the node containers of a stopped kind cluster
+// kind has no native start command, so the node containers are started directly
+func (p *KindProvider) Start(ctx context.Context, name string) error {
+	if name == "" {
+		return models.NewInvalidConfigError("name", name, "cluster name cannot be empty")
+	}
+
+	nodes, err := p.listNodeContainers(ctx, name)
+	if err != nil {
+		return models.NewClusterOperationError("start", name, err)
+	}
+	if len(nodes) == 0 {
+		return models.NewClusterNotFoundError(name)
+	}
+
+	args := []string{"start"}
+	for _, node := range nodes {
+		args = append(args, node.name)
+	}
+
+	if _, err := p.executor.Execute(ctx, "docker", args...); err != nil {
+		return models.NewClusterOperationError("start", name, fmt.Errorf("failed to start cluster %s: %w", name, err))
+	}
+
+	return nil
+}
+
+// Stop stops the node containers of a kind cluster
+// kind has no native stop command, so the node containers are stopped directly
+func (p *KindProvider) Stop(ctx context.Context, name string) error {
+	if name == "" {
+		return models.NewInvalidConfigError("name", name, "cluster name cannot be empty")
+	}
+
+	nodes, err := p.listNodeContainers(ctx, name)
+	if err != nil {
+		return models.NewClusterOperationError("stop", name, err)
+	}
+	if len(nodes) == 0 {
+		return models.NewClusterNotFoundError(name)
+	}
+
+	args := []string{"stop"}
+	for _, node := range nodes {
+		args = append(args, node.name)
+	}
+
+	if _, err := p.executor.Execute(ctx, "docker", args...); err != nil {
+		return models.NewClusterOperationError("stop", name, fmt.Errorf("failed to stop cluster %s: %w", name, err))
+	}
+
+	return nil
+}
+
+// List returns all kind clusters
+func (p *KindProvider) List(ctx context.Context) ([]models.ClusterInfo, error) {
+	names, err := p.getClusterNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]models.ClusterInfo, 0, len(names))
+	for _, name := range names {
+		nodes, err := p.listNodeContainers(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, buildClusterInfo(name, nodes))
+	}
+
+	return clusters, nil
+}
+
+// Status returns detailed status for a specific kind cluster
+func (p *KindProvider) Status(ctx context.Context, name string) (models.ClusterInfo, error) {
+	if name == "" {
+		return models.ClusterInfo{}, models.NewInvalidConfigError("name", name, "cluster name cannot be empty")
+	}
+
+	clusters, err := p.List(ctx)
+	if err != nil {
+		return models.ClusterInfo{}, models.NewClusterOperationError("status", name, err)
+	}
+
+	for _, clusterInfo := range clusters {
+		if clusterInfo.Name == name {
+			return clusterInfo, nil
+		}
+	}
+
+	return models.ClusterInfo{}, models.NewClusterOperationError("status", name, fmt.Errorf("cluster %s not found", name))
+}
+
+// DetectType determines if a cluster is managed by kind
+func (p *KindProvider) DetectType(ctx context.Context, name string) (models.ClusterType, error) {
+	if name == "" {
+		return "", models.NewInvalidConfigError("name", name, "cluster name cannot be empty")
+	}
+
+	names, err := p.getClusterNames(ctx)
+	if err != nil {
+		return "", models.NewClusterNotFoundError(name)
+	}
+
+	for _, clusterName := range names {
+		if clusterName == name {
+			return models.ClusterTypeKind, nil
+		}
+	}
+
+	return "", models.NewClusterNotFoundError(name)
+}
+
+// GetKubeconfig gets the kubeconfig for a specific kind cluster
+func (p *KindProvider) GetKubeconfig(ctx context.Context, name string) (string, error) {
+	if name == "" {
+		return "", models.NewInvalidConfigError("name", name, "cluster name cannot be empty")
+	}
+
+	result, err := p.executor.Execute(ctx, "kind", "get", "kubeconfig", "--name", name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get kubeconfig for cluster %s: %w", name, err)
+	}
+
+	return result.Stdout, nil
+}
+
+// validateClusterConfig validates the cluster configuration
+func (p *KindProvider) validateClusterConfig(config models.ClusterConfig) error {
+	if config.Name == "" {
+		return models.NewInvalidConfigError("name", config.Name, "cluster name cannot be empty")
+	}
+	if config.Type == "" {
+		return models.NewInvalidConfigError("type", config.Type, "cluster type cannot be empty")
+	}
+	if config.NodeCount < 1 {
+		return models.NewInvalidConfigError("nodeCount", config.NodeCount, "node count must be at least 1")
+	}
+	return nil
+}
+
+// nodeImage resolves the kindest/node image for the requested Kubernetes version
+// k3s-specific versions (e.g. v1.31.5-k3s1) and "latest" fall back to the default image
+func nodeImage(k8sVersion string) string {
+	if k8sVersion == "" || k8sVersion == "latest" || strings.Contains(k8sVersion, "k3s") {
+		return defaultKindImage
+	}
+	return "kindest/node:" + k8sVersion
+}
+
+// createKindConfigFile creates a kind config file
+func (p *KindProvider) createKindConfigFile(config models.ClusterConfig) (string, error) {
+	image := nodeImage(config.K8sVersion)
+
+	controlPlanes := 1
+	if config.Servers > 0 {
+		controlPlanes = config.Servers
+	}
+	workers := config.NodeCount
+	if workers < 1 {
+		workers = 1
+	}
+
+	var extraPorts []models.PortSpec
+	if config.Spec != nil {
+		if config.Spec.Spec.Image != "" {
+			image = config.Spec.Spec.Image
+		}
+		if config.Spec.Spec.Servers > 0 {
+			controlPlanes = config.Spec.Spec.Servers
+		}
+		workers = config.Spec.Spec.Agents
+		extraPorts = config.Spec.Spec.Ports
+	}
+
+	ports, err := p.resolvePorts(config.Ports)
+	if err != nil {
+		return "", err
+	}
+
+	apiPort := strconv.Itoa(ports.API)
+	httpPort := strconv.Itoa(ports.HTTP)
+	httpsPort := strconv.Itoa(ports.HTTPS)
+
+	configContent := fmt.Sprintf(`kind: Cluster
+apiVersion: kind.x-k8s.io/v1alpha4
+name: %s
+networking:
+  apiServerAddress: "127.0.0.1"
+  apiServerPort: %s
+nodes:
+  - role: control-plane
+    image: %s
+    kubeadmConfigPatches:
+      - |
+        kind: InitConfiguration
+        nodeRegistration:
+          kubeletExtraArgs:
+            node-labels: "ingress-ready=true"
+    extraPortMappings:
+      - containerPort: 80
+        hostPort: %s
+        protocol: TCP
+      - containerPort: 443
+        hostPort: %s
+        protocol: TCP`, config.Name, apiPort, image, httpPort, httpsPort)
+
+	for _, port := range extraPorts {
+		configContent += fmt.Sprintf(`
+      - containerPort: %d
+        hostPort: %d
+        protocol: TCP`, port.ContainerPort, port.HostPort)
+	}
+
+	for i := 1; i < controlPlanes; i++ {
+		configContent += fmt.Sprintf(`
+  - role: control-plane
+    image: %s`, image)
+	}
+
+	for i := 0; i < workers; i++ {
+		configContent += fmt.Sprintf(`
+  - role: worker
+    image: %s`, image)
+	}
+	configContent += "\n"
+
+	tmpFile, err := os.CreateTemp("", "kind-config-*.yaml")
+	if err != nil {
+		return "", err
+	}
+	defer tmpFile.Close()
+
+	if _, err := tmpFile.WriteString(configContent); err != nil {
+		os.Remove(tmpFile.Name())
+		return "", err
+	}
+
+	return tmpFile.Name(), nil
+}
+
+// resolvePorts uses the ports leased by the cluster service, probing for free ports only for the missing ones
+func (p *KindProvider) resolvePorts(requested models.ClusterPorts) (models.ClusterPorts, error) {
+	if requested.IsComplete() {
+		return requested, nil
+	}
+	// Always use dynamic ports to avoid conflicts, regardless of cluster name
+	ports, err := p.findAvailablePorts(3)
+	if err != nil || len(ports) < 3 {
+		return models.ClusterPorts{}, fmt.Errorf("failed to allocate available ports: %w", err)
+	}
+	return requested.WithDefaults(models.ClusterPorts{API: ports[0], HTTP: ports[1], HTTPS: ports[2]}), nil
+}
+
+// findAvailablePorts finds the specified number of available TCP ports, preferring the defaults
+func (p *KindProvider) findAvailablePorts(count int) ([]int, error) {
+	// Get ports used by existing kind clusters
+	usedPorts := p.getUsedPortsByExistingClusters()
+
+	// Start with default ports and increment if busy (same layout as the k3d provider)
+	defaultPorts := []int{6550, 80, 443} // API, HTTP, HTTPS
+	alternatePorts := []int{6551, 8080, 8443}
+
+	var ports []int
+
+	for i := 0; i < count && i < len(defaultPorts); i++ {
+		if p.isPortAvailable(defaultPorts[i]) && !usedPorts[defaultPorts[i]] {
+			ports = append(ports, defaultPorts[i])
+		} else if p.isPortAvailable(alternatePorts[i]) && !usedPorts[alternatePorts[i]] {
+			ports = append(ports, alternatePorts[i])
+		} else {
+			found := false
+			for port := alternatePorts[i] + 1; port < alternatePorts[i]+1000; port++ {
+				if p.isPortAvailable(port) && !usedPorts[port] {
+					ports = append(ports, port)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("could not find available port for index %d", i)
+			}
+		}
+	}
+
+	if len(ports) < count {
+		return nil, fmt.Errorf("could not find %d available ports", count)
+	}
+
+	return ports, nil
+}
+
+// getUsedPortsByExistingClusters returns a map of host ports published by existing kind clusters
+// Ports are published on control planes and, for multiple control planes, on the external load
+// balancer. Stopped clusters still own their ports, so all containers are inspected.
+func (p *KindProvider) getUsedPortsByExistingClusters() map[int]bool {
+	usedPorts := make(map[int]bool)
+
+	ctx := context.Background()
+	result, err := p.executor.Execute(ctx, "docker", "ps", "-a",
+		"--filter", fmt.Sprintf("label=%s", roleLabel),
+		"--format", fmt.Sprintf(`{{.Label "%s"}}|{{.Ports}}`, roleLabel))
+	if err != nil {
+		return usedPorts // Return empty map on error, will rely on port availability check
+	}
+
+	for _, line := range strings.Split(result.Stdout, "\n") {
+		role, ports, found := strings.Cut(strings.TrimSpace(line), "|")
+		if !found || (role != "control-plane" && role != loadBalancerRole) {
+			continue
+		}
+		for _, match := range hostPortPattern.FindAllStringSubmatch(ports, -1) {
+			if port, err := strconv.Atoi(match[1]); err == nil {
+				usedPorts[port] = true
+			}
+		}
+	}
+
+	return usedPorts
+}
+
+// isPortAvailable checks if a TCP port is available
+func (p *KindProvider) isPortAvailable(port int) bool {
+	address := fmt.Sprintf(":%d", port)
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return false
+	}
+	defer listener.Close()
+	return true
+}
+
+// getClusterNames returns the names of all kind clusters
+func (p *KindProvider) getClusterNames(ctx context.Context) ([]string, error) {
+	result, err := p.executor.Execute(ctx, "kind", "get", "clusters")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	names := make([]string, 0)
+	for _, line := range strings.Split(result.Stdout, "\n") {
+		name := strings.TrimSpace(line)
+		// kind prints a notice instead of an empty list when no clusters exist
+		if name == "" || strings.HasPrefix(name, "No kind clusters found") {
+			continue
+		}
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+// kindNodeContainer represents a docker container backing a kind node
+type kindNodeContainer struct {
+	name    string
+	state   string
+	role    string
+	created time.Time
+	ports   string // Raw docker ps ports column
+}
+
+// listNodeContainers returns all node containers of a kind cluster, including stopped ones
+func (p *KindProvider) listNodeContainers(ctx context.Context, clusterName string) ([]kindNodeContainer, error) {
+	result, err := p.executor.Execute(ctx, "docker", "ps", "-a",
+		"--filter", fmt.Sprintf("label=%s=%s", clusterLabel, clusterName),
+		"--format", fmt.Sprintf(`{{.Names}}|{{.State}}|{{.Label "%s"}}|{{.CreatedAt}}|{{.Ports}}`, roleLabel))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes for cluster %s: %w", clusterName, err)
+	}
+
+	return parseNodeContainers(result.Stdout), nil
+}
+
+// parseNodeContainers parses the pipe-separated docker ps output produced by listNodeContainers
+func parseNodeContainers(output string) []kindNodeContainer {
+	nodes := make([]kindNodeContainer, 0)
+
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.Split(strings.TrimSpace(line), "|")
+		if len(fields) < 3 || fields[0] == "" {
+			continue
+		}
+
+		node := kindNodeContainer{
+			name:  fields[0],
+			state: fields[1],
+			role:  fields[2],
+		}
+		if len(fields) > 3 {
+			if created, err := time.Parse(dockerTimeLayout, fields[3]); err == nil {
+				node.created = created
+			}
+		}
+		if len(fields) > 4 {
+			node.ports = fields[4]
+		}
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
+
+// buildClusterInfo converts kind node containers into domain cluster information
+// The external load balancer is not a node, but publishes the API port of HA clusters.
+func buildClusterInfo(name string, nodes []kindNodeContainer) models.ClusterInfo {
+	info := models.ClusterInfo{
+		Name:  name,
+		Type:  models.ClusterTypeKind,
+		Nodes: make([]models.NodeInfo, 0, len(nodes)),
+	}
+
+	var loadBalancerPorts models.ClusterPorts
+	controlPlanes, controlPlanesRunning := 0, 0
+	workers, workersRunning := 0, 0
+	for _, node := range nodes {
+		switch node.role {
+		case loadBalancerRole:
+			loadBalancerPorts = parsePublishedPorts(node.ports)
+			continue
+		case "control-plane":
+			controlPlanes++
+			if node.state == "running" {
+				controlPlanesRunning++
+			}
+			// Use the earliest control plane creation time as cluster creation time
+			if !node.created.IsZero() && (info.CreatedAt.IsZero() || node.created.Before(info.CreatedAt)) {
+				info.CreatedAt = node.created
+			}
+			// Ingress ports are mapped on the first control plane, which also publishes the API without a load balancer
+			if info.Ports == (models.ClusterPorts{}) {
+				info.Ports = parsePublishedPorts(node.ports)
+			}
+		default:
+			workers++
+			if node.state == "running" {
+				workersRunning++
+			}
+		}
+
+		info.Nodes = append(info.Nodes, models.NodeInfo{
+			Name:   node.name,
+			Status: node.state,
+			Role:   node.role,
+		})
+	}
+
+	if loadBalancerPorts.API != 0 {
+		info.Ports.API = loadBalancerPorts.API
+		info.Ports.APIHost = loadBalancerPorts.APIHost
+	}
+	info.NodeCount = len(info.Nodes)
+	info.Status = fmt.Sprintf("%d/%d", controlPlanesRunning, controlPlanes)
+	info.State = models.ClusterState(controlPlanesRunning, controlPlanes, workersRunning, workers)
+	return info
+}
+
+// parsePublishedPorts extracts the API and ingress host ports from a docker ps ports column
+func parsePublishedPorts(ports string) models.ClusterPorts {
+	var result models.ClusterPorts
+	for _, match := range publishedPortPattern.FindAllStringSubmatch(ports, -1) {
+		hostPort, err := strconv.Atoi(match[2])
+		if err != nil {
+			continue
+		}
+		switch match[3] {
+		case "6443":
+			result.API = hostPort
+			result.APIHost = match[1]
+		case "80":
+			result.HTTP = hostPort
+		case "443":
+			result.HTTPS = hostPort
+		}
+	}
+	return result
+}
@@ -0,0 +1,389 @@
+package kind
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testNodeOutput = `kind-dev-control-plane|running|control-plane|2024-01-01 10:00:00 +0000 UTC|127.0.0.1:6550->6443/tcp, 0.0.0.0:8080->80/tcp, 0.0.0.0:8443->443/tcp
+kind-dev-worker|running|worker|2024-01-01 10:00:05 +0000 UTC
+kind-dev-worker2|exited|worker|2024-01-01 10:00:05 +0000 UTC`
+
+func newTestProvider() (*KindProvider, *executor.MockCommandExecutor) {
+	mockExec := executor.NewMockCommandExecutor()
+	return NewKindProvider(mockExec, false), mockExec
+}
+
+func TestNewKindProvider(t *testing.T) {
+	t.Run("creates provider with executor", func(t *testing.T) {
+		provider, mockExec := newTestProvider()
+
+		assert.NotNil(t, provider)
+		assert.Equal(t, mockExec, provider.executor)
+		assert.Equal(t, defaultWaitTimeout, provider.timeout)
+	})
+
+	t.Run("panics with nil executor", func(t *testing.T) {
+		assert.Panics(t, func() {
+			NewKindProvider(nil, false)
+		})
+	})
+
+	t.Run("implements ClusterProvider", func(t *testing.T) {
+		provider, _ := newTestProvider()
+		var _ models.ClusterProvider = provider
+	})
+}
+
+func TestKindProvider_Create(t *testing.T) {
+	t.Run("creates cluster and switches context", func(t *testing.T) {
+		provider, mockExec := newTestProvider()
+
+		err := provider.Create(context.Background(), models.ClusterConfig{
+			Name:      "dev",
+			Type:      models.ClusterTypeKind,
+			NodeCount: 2,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, mockExec.WasCommandExecuted("kind create cluster --name dev --config"))
+		assert.True(t, mockExec.WasCommandExecuted("kubectl config use-context kind-dev"))
+	})
+
+	t.Run("restores the previous context with NoSwitchContext", func(t *testing.T) {
+		provider, mockExec := newTestProvider()
+		mockExec.SetResponse("kubectl config current-context", &executor.CommandResult{Stdout: "k3d-work\n"})
+
+		err := provider.Create(context.Background(), models.ClusterConfig{
+			Name:            "dev",
+			Type:            models.ClusterTypeKind,
+			NodeCount:       1,
+			NoSwitchContext: true,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, mockExec.WasCommandExecuted("kubectl config use-context k3d-work"))
+		assert.False(t, mockExec.WasCommandExecuted("kubectl config use-context kind-dev"))
+	})
+
+	t.Run("rejects non-kind cluster type", func(t *testing.T) {
+		provider, _ := newTestProvider()
+
+		err := provider.Create(context.Background(), models.ClusterConfig{
+			Name:      "dev",
+			Type:      models.ClusterTypeK3d,
+			NodeCount: 1,
+		})
+
+		var providerErr models.ErrProviderNotFound
+		assert.ErrorAs(t, err, &providerErr)
+	})
+
+	t.Run("validates configuration", func(t *testing.T) {
+		provider, _ := newTestProvider()
+
+		err := provider.Create(context.Background(), models.ClusterConfig{Type: models.ClusterTypeKind, NodeCount: 1})
+		assert.ErrorContains(t, err, "cluster name cannot be empty")
+
+		err = provider.Create(context.Background(), models.ClusterConfig{Name: "dev", Type: models.ClusterTypeKind})
+		assert.ErrorContains(t, err, "node count must be at least 1")
+	})
+
+	t.Run("wraps kind failure", func(t *testing.T) {
+		provider, mockExec := newTestProvider()
+		mockExec.SetResponse("kind create cluster", &executor.CommandResult{ExitCode: 1})
+
+		err := provider.Create(context.Background(), models.ClusterConfig{
+			Name:      "dev",
+			Type:      models.ClusterTypeKind,
+			NodeCount: 1,
+		})
+
+		var opErr models.ErrClusterOperation
+		require.ErrorAs(t, err, &opErr)
+		assert.Equal(t, "create", opErr.Operation)
+	})
+}
+
+func TestKindProvider_createKindConfigFile(t *testing.T) {
+	provider, _ := newTestProvider()
+
+	configFile, err := provider.createKindConfigFile(models.ClusterConfig{
+		Name:       "dev",
+		Type:       models.ClusterTypeKind,
+		NodeCount:  3,
+		K8sVersion: "v1.30.8",
+	})
+	require.NoError(t, err)
+	defer os.Remove(configFile)
+
+	content, err := os.ReadFile(configFile)
+	require.NoError(t, err)
+
+	config := string(content)
+	assert.Contains(t, config, "apiVersion: kind.x-k8s.io/v1alpha4")
+	assert.Contains(t, config, "name: dev")
+	assert.Contains(t, config, "image: kindest/node:v1.30.8")
+	assert.Contains(t, config, "containerPort: 80")
+	assert.Contains(t, config, "containerPort: 443")
+	assert.Equal(t, 1, strings.Count(config, "role: control-plane"))
+	assert.Equal(t, 3, strings.Count(config, "role: worker"))
+}
+
+func TestKindProvider_createKindConfigFile_LeasedPorts(t *testing.T) {
+	provider, _ := newTestProvider()
+
+	configFile, err := provider.createKindConfigFile(models.ClusterConfig{
+		Name:      "dev",
+		Type:      models.ClusterTypeKind,
+		NodeCount: 1,
+		Ports:     models.ClusterPorts{API: 16550, HTTP: 18080, HTTPS: 18443},
+	})
+	require.NoError(t, err)
+	defer os.Remove(configFile)
+
+	content, err := os.ReadFile(configFile)
+	require.NoError(t, err)
+
+	config := string(content)
+	assert.Contains(t, config, "apiServerPort: 16550")
+	assert.Contains(t, config, "hostPort: 18080")
+	assert.Contains(t, config, "hostPort: 18443")
+}
+
+func TestKindProvider_createKindConfigFile_HA(t *testing.T) {
+	provider, _ := newTestProvider()
+
+	configFile, err := provider.createKindConfigFile(models.ClusterConfig{
+		Name:      "dev",
+		Type:      models.ClusterTypeKind,
+		Servers:   3,
+		NodeCount: 1,
+	})
+	require.NoError(t, err)
+	defer os.Remove(configFile)
+
+	content, err := os.ReadFile(configFile)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, strings.Count(string(content), "role: control-plane"))
+	assert.Equal(t, 1, strings.Count(string(content), "role: worker"))
+}
+
+func TestKindProvider_createKindConfigFile_WithSpec(t *testing.T) {
+	provider, _ := newTestProvider()
+
+	spec := models.NewDefaultClusterSpec("dev")
+	spec.Spec.Type = models.ClusterTypeKind
+	spec.Spec.Servers = 3
+	spec.Spec.Agents = 1
+	spec.Spec.Image = "kindest/node:v1.29.2"
+	spec.Spec.Ports = []models.PortSpec{{HostPort: 5432, ContainerPort: 30432}}
+
+	configFile, err := provider.createKindConfigFile(spec.ToClusterConfig())
+	require.NoError(t, err)
+	defer os.Remove(configFile)
+
+	content, err := os.ReadFile(configFile)
+	require.NoError(t, err)
+
+	config := string(content)
+	assert.Contains(t, config, "image: kindest/node:v1.29.2")
+	assert.Contains(t, config, "containerPort: 30432")
+	assert.Contains(t, config, "hostPort: 5432")
+	assert.Equal(t, 3, strings.Count(config, "role: control-plane"))
+	assert.Equal(t, 1, strings.Count(config, "role: worker"))
+}
+
+func TestNodeImage(t *testing.T) {
+	assert.Equal(t, defaultKindImage, nodeImage(""))
+	assert.Equal(t, defaultKindImage, nodeImage("latest"))
+	assert.Equal(t, defaultKindImage, nodeImage("v1.31.5-k3s1"))
+	assert.Equal(t, "kindest/node:v1.29.2", nodeImage("v1.29.2"))
+}
+
+func TestKindProvider_List(t *testing.T) {
+	t.Run("returns clusters with node details", func(t *testing.T) {
+		provider, mockExec := newTestProvider()
+		mockExec.SetResponse("kind get clusters", &executor.CommandResult{Stdout: "dev\n"})
+		mockExec.SetResponse("docker ps -a --filter label=io.x-k8s.kind.cluster=dev", &executor.CommandResult{Stdout: testNodeOutput})
+
+		clusters, err := provider.List(context.Background())
+
+		require.NoError(t, err)
+		require.Len(t, clusters, 1)
+		assert.Equal(t, "dev", clusters[0].Name)
+		assert.Equal(t, models.ClusterTypeKind, clusters[0].Type)
+		assert.Equal(t, "1/1", clusters[0].Status)
+		assert.Equal(t, models.ClusterStatePartial, clusters[0].State)
+		assert.Equal(t, 3, clusters[0].NodeCount)
+		assert.Len(t, clusters[0].Nodes, 3)
+		assert.Equal(t, 2024, clusters[0].CreatedAt.Year())
+		assert.Equal(t, models.ClusterPorts{APIHost: "127.0.0.1", API: 6550, HTTP: 8080, HTTPS: 8443}, clusters[0].Ports)
+	})
+
+	t.Run("handles no clusters notice", func(t *testing.T) {
+		provider, mockExec := newTestProvider()
+		mockExec.SetResponse("kind get clusters", &executor.CommandResult{Stdout: "No kind clusters found.\n"})
+
+		clusters, err := provider.List(context.Background())
+
+		require.NoError(t, err)
+		assert.Empty(t, clusters)
+	})
+
+	t.Run("returns error when kind fails", func(t *testing.T) {
+		provider, mockExec := newTestProvider()
+		mockExec.SetShouldFail(true, "kind not installed")
+
+		_, err := provider.List(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func TestKindProvider_Status(t *testing.T) {
+	provider, mockExec := newTestProvider()
+	mockExec.SetResponse("kind get clusters", &executor.CommandResult{Stdout: "dev\n"})
+	mockExec.SetResponse("docker ps -a --filter label=io.x-k8s.kind.cluster=dev", &executor.CommandResult{Stdout: testNodeOutput})
+
+	info, err := provider.Status(context.Background(), "dev")
+	require.NoError(t, err)
+	assert.Equal(t, "dev", info.Name)
+
+	_, err = provider.Status(context.Background(), "missing")
+	assert.ErrorContains(t, err, "not found")
+
+	_, err = provider.Status(context.Background(), "")
+	assert.ErrorContains(t, err, "cluster name cannot be empty")
+}
+
+func TestKindProvider_DetectType(t *testing.T) {
+	provider, mockExec := newTestProvider()
+	mockExec.SetResponse("kind get clusters", &executor.CommandResult{Stdout: "dev\nother\n"})
+
+	clusterType, err := provider.DetectType(context.Background(), "other")
+	require.NoError(t, err)
+	assert.Equal(t, models.ClusterTypeKind, clusterType)
+
+	_, err = provider.DetectType(context.Background(), "missing")
+	var notFound models.ErrClusterNotFound
+	assert.ErrorAs(t, err, &notFound)
+}
+
+func TestKindProvider_Start(t *testing.T) {
+	t.Run("starts all node containers", func(t *testing.T) {
+		provider, mockExec := newTestProvider()
+		mockExec.SetResponse("docker ps -a --filter label=io.x-k8s.kind.cluster=dev", &executor.CommandResult{Stdout: testNodeOutput})
+
+		err := provider.Start(context.Background(), "dev")
+
+		require.NoError(t, err)
+		assert.True(t, mockExec.WasCommandExecuted("docker start kind-dev-control-plane kind-dev-worker kind-dev-worker2"))
+	})
+
+	t.Run("returns not found when cluster has no nodes", func(t *testing.T) {
+		provider, mockExec := newTestProvider()
+		mockExec.SetResponse("docker ps -a", &executor.CommandResult{Stdout: ""})
+
+		err := provider.Start(context.Background(), "dev")
+
+		var notFound models.ErrClusterNotFound
+		assert.ErrorAs(t, err, &notFound)
+	})
+}
+
+func TestKindProvider_Stop(t *testing.T) {
+	t.Run("stops all node containers", func(t *testing.T) {
+		provider, mockExec := newTestProvider()
+		mockExec.SetResponse("docker ps -a --filter label=io.x-k8s.kind.cluster=dev", &executor.CommandResult{Stdout: testNodeOutput})
+
+		err := provider.Stop(context.Background(), "dev")
+
+		require.NoError(t, err)
+		assert.True(t, mockExec.WasCommandExecuted("docker stop kind-dev-control-plane kind-dev-worker kind-dev-worker2"))
+	})
+
+	t.Run("returns not found when cluster has no nodes", func(t *testing.T) {
+		provider, mockExec := newTestProvider()
+		mockExec.SetResponse("docker ps -a", &executor.CommandResult{Stdout: ""})
+
+		err := provider.Stop(context.Background(), "dev")
+
+		var notFound models.ErrClusterNotFound
+		assert.ErrorAs(t, err, &notFound)
+	})
+
+	t.Run("reports stopped state once all nodes exited", func(t *testing.T) {
+		info := buildClusterInfo("dev", []kindNodeContainer{
+			{name: "kind-dev-control-plane", state: "exited", role: "control-plane"},
+			{name: "kind-dev-worker", state: "exited", role: "worker"},
+		})
+
+		assert.Equal(t, models.ClusterStateStopped, info.State)
+	})
+}
+
+func TestKindProvider_DeleteAndKubeconfig(t *testing.T) {
+	provider, mockExec := newTestProvider()
+	mockExec.SetResponse("kind get kubeconfig", &executor.CommandResult{Stdout: "apiVersion: v1\n"})
+
+	require.NoError(t, provider.Delete(context.Background(), "dev", false))
+	assert.True(t, mockExec.WasCommandExecuted("kind delete cluster --name dev"))
+
+	kubeconfig, err := provider.GetKubeconfig(context.Background(), "dev")
+	require.NoError(t, err)
+	assert.Equal(t, "apiVersion: v1\n", kubeconfig)
+
+	assert.Error(t, provider.Delete(context.Background(), "", false))
+}
+
+func TestKindProvider_getUsedPortsByExistingClusters(t *testing.T) {
+	provider, mockExec := newTestProvider()
+	mockExec.SetResponse("docker ps -a --filter label=io.x-k8s.kind.role", &executor.CommandResult{
+		Stdout: "control-plane|127.0.0.1:6550->6443/tcp, 0.0.0.0:80->80/tcp, 0.0.0.0:443->443/tcp\n" +
+			"control-plane|0.0.0.0:8080->80/tcp\n" +
+			"external-load-balancer|127.0.0.1:6551->6443/tcp\n" +
+			"worker|0.0.0.0:9000->9000/tcp",
+	})
+
+	usedPorts := provider.getUsedPortsByExistingClusters()
+
+	assert.True(t, usedPorts[6550])
+	assert.True(t, usedPorts[6551])
+	assert.True(t, usedPorts[80])
+	assert.True(t, usedPorts[443])
+	assert.True(t, usedPorts[8080])
+	assert.False(t, usedPorts[6443])
+	assert.False(t, usedPorts[9000])
+}
+
+func TestBuildClusterInfo_ExternalLoadBalancer(t *testing.T) {
+	info := buildClusterInfo("dev", []kindNodeContainer{
+		{name: "dev-external-load-balancer", state: "running", role: "external-load-balancer", ports: "127.0.0.1:6552->6443/tcp"},
+		{name: "dev-control-plane", state: "running", role: "control-plane", ports: "0.0.0.0:8080->80/tcp, 0.0.0.0:8443->443/tcp"},
+		{name: "dev-control-plane2", state: "running", role: "control-plane"},
+		{name: "dev-control-plane3", state: "running", role: "control-plane"},
+		{name: "dev-worker", state: "running", role: "worker"},
+	})
+
+	assert.Equal(t, 4, info.NodeCount)
+	servers, agents := info.RoleCounts()
+	assert.Equal(t, 3, servers)
+	assert.Equal(t, 1, agents)
+	assert.Equal(t, "3/3", info.Status)
+	assert.Equal(t, models.ClusterPorts{APIHost: "127.0.0.1", API: 6552, HTTP: 8080, HTTPS: 8443}, info.Ports)
+}
+
+func TestParsePublishedPorts(t *testing.T) {
+	ports := parsePublishedPorts("127.0.0.1:6551->6443/tcp, 0.0.0.0:80->80/tcp, [::]:80->80/tcp, 0.0.0.0:443->443/tcp")
+	assert.Equal(t, models.ClusterPorts{APIHost: "127.0.0.1", API: 6551, HTTP: 80, HTTPS: 443}, ports)
+
+	assert.Equal(t, models.ClusterPorts{}, parsePublishedPorts(""))
+}
@@ -0,0 +1,52 @@
+package providers
+
+import (
+	"sync"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+)
+
+// Registry is the default models.ProviderRegistry implementation
+// It maps cluster types to the provider responsible for them
+type Registry struct {
+	mu        sync.RWMutex
+	providers map[models.ClusterType]models.ClusterProvider
+}
+
+// NewRegistry creates an empty provider registry
+func NewRegistry() *Registry {
+	return &Registry{
+		providers: make(map[models.ClusterType]models.ClusterProvider),
+	}
+}
+
+// RegisterProvider adds a provider for a specific cluster type, replacing any existing one
+func (r *Registry) RegisterProvider(clusterType models.ClusterType, provider models.ClusterProvider) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.providers[clusterType] = provider
+}
+
+// GetProvider returns the provider for a given cluster type
+func (r *Registry) GetProvider(clusterType models.ClusterType) (models.ClusterProvider, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	provider, exists := r.providers[clusterType]
+	if !exists || provider == nil {
+		return nil, models.NewProviderNotFoundError(clusterType)
+	}
+	return provider, nil
+}
+
+// GetAllProviders returns a copy of all registered providers
+func (r *Registry) GetAllProviders() map[models.ClusterType]models.ClusterProvider {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	providers := make(map[models.ClusterType]models.ClusterProvider, len(r.providers))
+	for clusterType, provider := range r.providers {
+		providers[clusterType] = provider
+	}
+	return providers
+}
@@ -0,0 +1,55 @@
+package providers
+
+import (
+	"testing"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/cluster/providers/k3d"
+	"github.com/flamingo/openframe/internal/cluster/providers/kind"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	exec := executor.NewMockCommandExecutor()
+	k3dProvider := k3d.NewProvider(k3d.NewK3dManager(exec, false))
+	kindProvider := kind.NewKindProvider(exec, false)
+
+	t.Run("implements ProviderRegistry", func(t *testing.T) {
+		var _ models.ProviderRegistry = NewRegistry()
+	})
+
+	t.Run("returns registered providers", func(t *testing.T) {
+		registry := NewRegistry()
+		registry.RegisterProvider(models.ClusterTypeK3d, k3dProvider)
+		registry.RegisterProvider(models.ClusterTypeKind, kindProvider)
+
+		provider, err := registry.GetProvider(models.ClusterTypeKind)
+		require.NoError(t, err)
+		assert.Equal(t, kindProvider, provider)
+
+		assert.Len(t, registry.GetAllProviders(), 2)
+	})
+
+	t.Run("returns provider not found for unknown type", func(t *testing.T) {
+		registry := NewRegistry()
+
+		_, err := registry.GetProvider(models.ClusterTypeGKE)
+
+		var providerErr models.ErrProviderNotFound
+		require.ErrorAs(t, err, &providerErr)
+		assert.Equal(t, models.ClusterTypeGKE, providerErr.ClusterType)
+	})
+
+	t.Run("GetAllProviders returns a copy", func(t *testing.T) {
+		registry := NewRegistry()
+		registry.RegisterProvider(models.ClusterTypeK3d, k3dProvider)
+
+		all := registry.GetAllProviders()
+		delete(all, models.ClusterTypeK3d)
+
+		_, err := registry.GetProvider(models.ClusterTypeK3d)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,179 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+)
+
+// kubeContextName returns the kubeconfig context created for a cluster of the given type
+func kubeContextName(info models.ClusterInfo) string {
+	if info.Type == models.ClusterTypeKind {
+		return fmt.Sprintf("kind-%s", info.Name)
+	}
+	return fmt.Sprintf("k3d-%s", info.Name)
+}
+
+// KubeContext returns the kubeconfig context of a cluster, detecting which provider created it
+func (s *ClusterService) KubeContext(name string) (string, error) {
+	clusterType, err := s.DetectClusterType(name)
+	if err != nil {
+		return "", err
+	}
+	return kubeContextName(models.ClusterInfo{Name: name, Type: clusterType}), nil
+}
+
+// GetClusterResourceUsage collects real node CPU/memory usage and pod counts per namespace
+// Node metrics come from the metrics API when available and fall back to docker stats on the node containers
+func (s *ClusterService) GetClusterResourceUsage(info models.ClusterInfo) (models.ClusterResourceUsage, error) {
+	ctx := context.Background()
+	var usage models.ClusterResourceUsage
+
+	nodes, nodeErr := s.collectNodeMetrics(ctx, info)
+	if nodeErr == nil {
+		usage.Source = models.ResourceSourceMetricsAPI
+		usage.Nodes = nodes
+	} else if nodes, err := s.collectDockerStats(ctx, info); err == nil {
+		usage.Source = models.ResourceSourceDockerStats
+		usage.Nodes = nodes
+		nodeErr = nil
+	}
+
+	namespaces, podErr := s.collectPodCounts(ctx, info)
+	if podErr == nil {
+		usage.Namespaces = namespaces
+	}
+
+	if nodeErr != nil && podErr != nil {
+		return usage, fmt.Errorf("failed to collect resource usage for cluster %s: %w", info.Name, podErr)
+	}
+
+	return usage, nil
+}
+
+// collectNodeMetrics reads node usage from the metrics API via kubectl top
+func (s *ClusterService) collectNodeMetrics(ctx context.Context, info models.ClusterInfo) ([]models.NodeResourceUsage, error) {
+	result, err := s.executor.Execute(ctx, "kubectl", "--context", kubeContextName(info), "top", "nodes", "--no-headers")
+	if err != nil {
+		return nil, fmt.Errorf("metrics API not available: %w", err)
+	}
+
+	nodes := parseKubectlTopNodes(result.Stdout)
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("metrics API returned no node metrics")
+	}
+
+	return nodes, nil
+}
+
+// parseKubectlTopNodes parses `kubectl top nodes --no-headers` output
+// Lines look like: "k3d-dev-server-0   152m   3%   1024Mi   13%"
+func parseKubectlTopNodes(output string) []models.NodeResourceUsage {
+	var nodes []models.NodeResourceUsage
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 5 {
+			continue
+		}
+		nodes = append(nodes, models.NodeResourceUsage{
+			Name:          fields[0],
+			CPU:           fields[1],
+			CPUPercent:    fields[2],
+			Memory:        fields[3],
+			MemoryPercent: fields[4],
+		})
+	}
+	return nodes
+}
+
+// collectDockerStats reads container-level usage of the cluster node containers
+func (s *ClusterService) collectDockerStats(ctx context.Context, info models.ClusterInfo) ([]models.NodeResourceUsage, error) {
+	if len(info.Nodes) == 0 {
+		return nil, fmt.Errorf("no node containers known for cluster %s", info.Name)
+	}
+
+	args := []string{"stats", "--no-stream", "--format", "{{.Name}}|{{.CPUPerc}}|{{.MemUsage}}|{{.MemPerc}}"}
+	for _, node := range info.Nodes {
+		args = append(args, node.Name)
+	}
+
+	result, err := s.executor.Execute(ctx, "docker", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get docker stats: %w", err)
+	}
+
+	nodes := parseDockerStats(result.Stdout)
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("docker stats returned no data")
+	}
+
+	return nodes, nil
+}
+
+// parseDockerStats parses the pipe-separated output produced by collectDockerStats
+// Lines look like: "k3d-dev-server-0|12.50%|812.3MiB / 7.66GiB|10.36%"
+func parseDockerStats(output string) []models.NodeResourceUsage {
+	var nodes []models.NodeResourceUsage
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.Split(strings.TrimSpace(line), "|")
+		if len(fields) < 4 || fields[0] == "" {
+			continue
+		}
+
+		// Only the used part of "used / limit" is reported
+		memory := strings.TrimSpace(strings.SplitN(fields[2], "/", 2)[0])
+
+		nodes = append(nodes, models.NodeResourceUsage{
+			Name:          fields[0],
+			CPU:           "-",
+			CPUPercent:    fields[1],
+			Memory:        memory,
+			MemoryPercent: fields[3],
+		})
+	}
+	return nodes
+}
+
+// collectPodCounts counts running and total pods per namespace
+func (s *ClusterService) collectPodCounts(ctx context.Context, info models.ClusterInfo) ([]models.NamespacePodCount, error) {
+	result, err := s.executor.Execute(ctx, "kubectl", "--context", kubeContextName(info),
+		"get", "pods", "--all-namespaces", "--no-headers",
+		"-o", "custom-columns=NAMESPACE:.metadata.namespace,PHASE:.status.phase")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	return parsePodCounts(result.Stdout), nil
+}
+
+// parsePodCounts aggregates "<namespace> <phase>" lines into per-namespace pod counts sorted by namespace
+func parsePodCounts(output string) []models.NamespacePodCount {
+	counts := make(map[string]*models.NamespacePodCount)
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		count, exists := counts[fields[0]]
+		if !exists {
+			count = &models.NamespacePodCount{Namespace: fields[0]}
+			counts[fields[0]] = count
+		}
+		count.Total++
+		if fields[1] == "Running" {
+			count.Running++
+		}
+	}
+
+	namespaces := make([]models.NamespacePodCount, 0, len(counts))
+	for _, count := range counts {
+		namespaces = append(namespaces, *count)
+	}
+	sort.Slice(namespaces, func(i, j int) bool { return namespaces[i].Namespace < namespaces[j].Namespace })
+
+	return namespaces
+}
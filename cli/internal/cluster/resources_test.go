@@ -0,0 +1,121 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testResourceCluster = models.ClusterInfo{
+	Name: "dev",
+	Type: models.ClusterTypeK3d,
+	Nodes: []models.NodeInfo{
+		{Name: "k3d-dev-server-0", Role: "server", Status: "running"},
+		{Name: "k3d-dev-agent-0", Role: "agent", Status: "running"},
+	},
+}
+
+const testPodOutput = `kube-system   Running
+kube-system   Running
+argocd        Running
+argocd        Pending
+default       Succeeded`
+
+func TestKubeContextName(t *testing.T) {
+	assert.Equal(t, "k3d-dev", kubeContextName(models.ClusterInfo{Name: "dev", Type: models.ClusterTypeK3d}))
+	assert.Equal(t, "kind-dev", kubeContextName(models.ClusterInfo{Name: "dev", Type: models.ClusterTypeKind}))
+}
+
+func TestClusterService_GetClusterResourceUsage(t *testing.T) {
+	t.Run("uses metrics API when available", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("top nodes", &executor.CommandResult{
+			Stdout: "k3d-dev-server-0   152m   3%   1024Mi   13%\nk3d-dev-agent-0   48m   1%   512Mi   6%\n",
+		})
+		mockExec.SetResponse("get pods", &executor.CommandResult{Stdout: testPodOutput})
+		service := NewClusterService(mockExec)
+
+		usage, err := service.GetClusterResourceUsage(testResourceCluster)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.ResourceSourceMetricsAPI, usage.Source)
+		require.Len(t, usage.Nodes, 2)
+		assert.Equal(t, models.NodeResourceUsage{
+			Name: "k3d-dev-server-0", CPU: "152m", CPUPercent: "3%", Memory: "1024Mi", MemoryPercent: "13%",
+		}, usage.Nodes[0])
+		assert.True(t, mockExec.WasCommandExecuted("kubectl --context k3d-dev top nodes"))
+		assert.False(t, mockExec.WasCommandExecuted("docker stats"))
+
+		running, total := usage.PodTotals()
+		assert.Equal(t, 3, running)
+		assert.Equal(t, 5, total)
+	})
+
+	t.Run("falls back to docker stats without metrics API", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("top nodes", &executor.CommandResult{ExitCode: 1, Stderr: "Metrics API not available"})
+		mockExec.SetResponse("docker stats", &executor.CommandResult{
+			Stdout: "k3d-dev-server-0|12.50%|812.3MiB / 7.66GiB|10.36%\nk3d-dev-agent-0|2.10%|301MiB / 7.66GiB|3.84%\n",
+		})
+		mockExec.SetResponse("get pods", &executor.CommandResult{Stdout: testPodOutput})
+		service := NewClusterService(mockExec)
+
+		usage, err := service.GetClusterResourceUsage(testResourceCluster)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.ResourceSourceDockerStats, usage.Source)
+		require.Len(t, usage.Nodes, 2)
+		assert.Equal(t, "812.3MiB", usage.Nodes[0].Memory)
+		assert.Equal(t, "12.50%", usage.Nodes[0].CPUPercent)
+		assert.True(t, mockExec.WasCommandExecuted("docker stats --no-stream --format {{.Name}}|{{.CPUPerc}}|{{.MemUsage}}|{{.MemPerc}} k3d-dev-server-0 k3d-dev-agent-0"))
+	})
+
+	t.Run("returns error when nothing can be collected", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetShouldFail(true, "cluster unreachable")
+		service := NewClusterService(mockExec)
+
+		_, err := service.GetClusterResourceUsage(testResourceCluster)
+
+		assert.ErrorContains(t, err, "failed to collect resource usage for cluster dev")
+	})
+
+	t.Run("keeps pod counts when node metrics are unavailable", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("top nodes", &executor.CommandResult{ExitCode: 1})
+		mockExec.SetResponse("get pods", &executor.CommandResult{Stdout: testPodOutput})
+		service := NewClusterService(mockExec)
+
+		usage, err := service.GetClusterResourceUsage(models.ClusterInfo{Name: "dev", Type: models.ClusterTypeKind})
+
+		require.NoError(t, err)
+		assert.Empty(t, usage.Source)
+		assert.Empty(t, usage.Nodes)
+		assert.Len(t, usage.Namespaces, 3)
+		assert.True(t, mockExec.WasCommandExecuted("kubectl --context kind-dev get pods"))
+	})
+}
+
+func TestParsePodCounts(t *testing.T) {
+	namespaces := parsePodCounts(testPodOutput)
+
+	assert.Equal(t, []models.NamespacePodCount{
+		{Namespace: "argocd", Running: 1, Total: 2},
+		{Namespace: "default", Running: 0, Total: 1},
+		{Namespace: "kube-system", Running: 2, Total: 2},
+	}, namespaces)
+
+	assert.Empty(t, parsePodCounts(""))
+}
+
+func TestParseDockerStats(t *testing.T) {
+	nodes := parseDockerStats("k3d-dev-server-0|12.50%|812.3MiB / 7.66GiB|10.36%\n\ngarbage\n")
+
+	require.Len(t, nodes, 1)
+	assert.Equal(t, "k3d-dev-server-0", nodes[0].Name)
+	assert.Equal(t, "812.3MiB", nodes[0].Memory)
+	assert.Equal(t, "10.36%", nodes[0].MemoryPercent)
+}
@@ -0,0 +1,244 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/shared/state"
+	"github.com/flamingo/openframe/internal/shared/ui/progress"
+	"github.com/pterm/pterm"
+)
+
+// ScaleOptions controls how agent nodes are added to or removed from a cluster
+type ScaleOptions struct {
+	Agents  int           // Target number of agent nodes
+	Timeout time.Duration // How long to wait for each node to become Ready or to drain
+}
+
+// ScaleCluster adds or removes agent nodes of a running k3d cluster until it has opts.Agents agents
+// New agents run the k3s version of the servers, so clusters upgraded in place stay consistent.
+// Agents are removed newest first; each one is cordoned and drained before its container is deleted
+// and its Node object is removed.
+func (s *ClusterService) ScaleCluster(name string, opts ScaleOptions) (*models.ScaleResult, error) {
+	ctx := context.Background()
+
+	if opts.Agents < 0 {
+		return nil, fmt.Errorf("agent count cannot be negative: %d", opts.Agents)
+	}
+
+	info, err := s.GetClusterStatus(name)
+	if err != nil {
+		return nil, err
+	}
+	if info.Type != models.ClusterTypeK3d {
+		return nil, fmt.Errorf("scaling is only supported for k3d clusters, %s is a %s cluster", name, info.Type)
+	}
+	if info.IsStopped() {
+		return nil, fmt.Errorf("cluster %s is stopped; start it before scaling", name)
+	}
+
+	agents := agentNodeNames(info)
+	result := &models.ScaleResult{Cluster: name, FromAgents: len(agents), ToAgents: opts.Agents}
+	if opts.Agents == len(agents) {
+		return result, nil
+	}
+
+	if opts.Agents > len(agents) {
+		err = s.addAgents(ctx, info, agents, opts, result)
+	} else {
+		err = s.removeAgents(ctx, info, agents[opts.Agents:], opts, result)
+	}
+
+	s.recordAgentCount(name, len(agents)+len(result.Added)-len(result.Removed))
+	return result, err
+}
+
+// addAgents creates the missing agent nodes one at a time and waits for each to become Ready
+func (s *ClusterService) addAgents(ctx context.Context, info models.ClusterInfo, existing []string, opts ScaleOptions, result *models.ScaleResult) error {
+	kubeletVersion, err := s.serverVersion(ctx, info)
+	if err != nil {
+		return err
+	}
+	image := k3sImageRepository + ":" + strings.Replace(kubeletVersion, "+k3s", "-k3s", 1)
+
+	names := newAgentNames(info.Name, existing, opts.Agents-len(existing))
+	steps := make([]progress.Step, len(names))
+	for i, node := range names {
+		steps[i] = progress.Step{Name: "Add agent " + node, Weight: 1}
+	}
+	tracker := progress.NewTracker(fmt.Sprintf("Scaling cluster %s to %d agents", info.Name, opts.Agents), steps)
+	tracker.Start()
+
+	for i, node := range names {
+		tracker.StartStep(i)
+
+		// k3d names the container k3d-<name>-<replica>, so pass the name without prefix and suffix
+		nodeName := strings.TrimSuffix(strings.TrimPrefix(node, "k3d-"), "-0")
+		if _, err := s.executor.Execute(ctx, "k3d", "node", "create", nodeName,
+			"--cluster", info.Name,
+			"--role", "agent",
+			"--image", image,
+			"--wait",
+			"--timeout", opts.Timeout.String()); err != nil {
+			err = fmt.Errorf("failed to create agent %s: %w", node, err)
+			tracker.FailStep(i, err)
+			tracker.Fail(err)
+			return err
+		}
+
+		result.Added = append(result.Added, node)
+
+		if err := s.waitForNodeVersion(ctx, info, node, kubeletVersion, time.Now().Add(opts.Timeout)); err != nil {
+			err = fmt.Errorf("agent %s did not join the cluster: %w", node, err)
+			tracker.FailStep(i, err)
+			tracker.Fail(err)
+			return err
+		}
+		tracker.CompleteStep(i)
+	}
+
+	tracker.Complete()
+	return nil
+}
+
+// removeAgents cordons, drains and deletes agent nodes one at a time
+func (s *ClusterService) removeAgents(ctx context.Context, info models.ClusterInfo, nodes []string, opts ScaleOptions, result *models.ScaleResult) error {
+	// Newest agents go first
+	nodes = append([]string(nil), nodes...)
+	for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	}
+
+	steps := make([]progress.Step, len(nodes))
+	for i, node := range nodes {
+		steps[i] = progress.Step{Name: "Remove agent " + node, Weight: 1}
+	}
+	tracker := progress.NewTracker(fmt.Sprintf("Scaling cluster %s to %d agents", info.Name, opts.Agents), steps)
+	tracker.Start()
+
+	for i, node := range nodes {
+		tracker.StartStep(i)
+		if err := s.removeAgent(ctx, info, node, opts.Timeout); err != nil {
+			err = fmt.Errorf("failed to remove agent %s: %w", node, err)
+			tracker.FailStep(i, err)
+			tracker.Fail(err)
+			return err
+		}
+		result.Removed = append(result.Removed, node)
+		tracker.CompleteStep(i)
+	}
+
+	tracker.Complete()
+	return nil
+}
+
+// removeAgent drains the workloads off an agent, then deletes its container and Node object
+// A node that cannot be drained is uncordoned again and kept
+func (s *ClusterService) removeAgent(ctx context.Context, info models.ClusterInfo, node string, timeout time.Duration) error {
+	kubeContext := kubeContextName(info)
+
+	if _, err := s.executor.Execute(ctx, "kubectl", "--context", kubeContext, "cordon", node); err != nil {
+		return fmt.Errorf("failed to cordon node: %w", err)
+	}
+
+	if _, err := s.executor.Execute(ctx, "kubectl", "--context", kubeContext, "drain", node,
+		"--ignore-daemonsets", "--delete-emptydir-data", "--timeout", timeout.String()); err != nil {
+		s.executor.Execute(ctx, "kubectl", "--context", kubeContext, "uncordon", node)
+		return fmt.Errorf("failed to drain node: %w", err)
+	}
+
+	if _, err := s.executor.Execute(ctx, "k3d", "node", "delete", node); err != nil {
+		return fmt.Errorf("failed to delete node container: %w", err)
+	}
+
+	// k3d only removes the container; the Node object would otherwise stay NotReady forever
+	if _, err := s.executor.Execute(ctx, "kubectl", "--context", kubeContext, "delete", "node", node, "--ignore-not-found"); err != nil {
+		pterm.Warning.Printf("Failed to remove node %s from the cluster: %v\n", node, err)
+	}
+	return nil
+}
+
+// serverVersion returns the kubelet version of the first server node, which new agents must match
+func (s *ClusterService) serverVersion(ctx context.Context, info models.ClusterInfo) (string, error) {
+	versions, err := s.nodeVersions(ctx, info)
+	if err != nil {
+		return "", err
+	}
+	for _, node := range upgradeOrder(info.Nodes) {
+		if models.IsServerRole(node.Role) {
+			if v, ok := versions[node.Name]; ok {
+				return v, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no server node of cluster %s is registered", info.Name)
+}
+
+// agentNodeNames returns the agent node containers of a cluster, oldest first
+func agentNodeNames(info models.ClusterInfo) []string {
+	var names []string
+	for _, node := range info.Nodes {
+		if node.Role == "agent" {
+			names = append(names, node.Name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		left, right := agentIndex(info.Name, names[i]), agentIndex(info.Name, names[j])
+		if left != right {
+			return left < right
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// agentIndex returns n of an agent named k3d-<cluster>-agent-<n>[-0], or -1 for other names
+func agentIndex(cluster string, name string) int {
+	prefix := fmt.Sprintf("k3d-%s-agent-", cluster)
+	if !strings.HasPrefix(name, prefix) {
+		return -1
+	}
+	n, err := strconv.Atoi(strings.SplitN(strings.TrimPrefix(name, prefix), "-", 2)[0])
+	if err != nil {
+		return -1
+	}
+	return n
+}
+
+// newAgentNames picks container names for added agents, continuing the index after the highest one in use
+// k3d creates agents as k3d-<cluster>-agent-<n> and `k3d node create` appends a replica suffix, so
+// added agents are named k3d-<cluster>-agent-<n>-0
+func newAgentNames(cluster string, existing []string, count int) []string {
+	next := 0
+	for _, name := range existing {
+		if n := agentIndex(cluster, name); n >= next {
+			next = n + 1
+		}
+	}
+
+	names := make([]string, count)
+	for i := range names {
+		names[i] = fmt.Sprintf("k3d-%s-agent-%d-0", cluster, next+i)
+	}
+	return names
+}
+
+// recordAgentCount remembers the number of agents of a scaled cluster
+func (s *ClusterService) recordAgentCount(name string, agents int) {
+	store := s.stateStore()
+	if store == nil {
+		return
+	}
+	err := store.Update(name, func(record *state.ClusterRecord) {
+		record.Type = string(models.ClusterTypeK3d)
+		record.Agents = agents
+	})
+	if err != nil {
+		pterm.Warning.Printf("Failed to save state of cluster %s: %v\n", name, err)
+	}
+}
@@ -0,0 +1,115 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newScaleTestService returns a running k3d cluster dev with one server and one agent on v1.31.5+k3s1
+func newScaleTestService(t *testing.T) (*ClusterService, *executor.MockCommandExecutor, *state.Store) {
+	t.Helper()
+	useFastReadinessPolling(t)
+
+	service, _, mockExec := newSnapshotTestService(models.ClusterStateRunning)
+	store := state.NewStore(t.TempDir())
+	service.SetStateStore(store)
+
+	mockExec.SetResponse("get nodes --no-headers", &executor.CommandResult{Stdout: testReadyNodesOutput})
+	mockExec.SetResponse("get node k3d-dev-agent-1-0", &executor.CommandResult{Stdout: "k3d-dev-agent-1-0   Ready   <none>   1m   v1.31.5+k3s1"})
+	mockExec.SetResponse("get node k3d-dev-agent-2-0", &executor.CommandResult{Stdout: "k3d-dev-agent-2-0   Ready   <none>   1m   v1.31.5+k3s1"})
+	return service, mockExec, store
+}
+
+func TestClusterService_ScaleCluster(t *testing.T) {
+	t.Run("adds agents on the server version", func(t *testing.T) {
+		service, mockExec, store := newScaleTestService(t)
+
+		result, err := service.ScaleCluster("dev", ScaleOptions{Agents: 3, Timeout: time.Second})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.FromAgents)
+		assert.Equal(t, []string{"k3d-dev-agent-1-0", "k3d-dev-agent-2-0"}, result.Added)
+		assert.True(t, mockExec.WasCommandExecuted("k3d node create dev-agent-1 --cluster dev --role agent --image rancher/k3s:v1.31.5-k3s1 --wait"))
+		assert.True(t, mockExec.WasCommandExecuted("k3d node create dev-agent-2"))
+
+		record, err := store.Load("dev")
+		require.NoError(t, err)
+		assert.Equal(t, 3, record.Agents)
+	})
+
+	t.Run("drains and deletes agents newest first", func(t *testing.T) {
+		service, mockExec, store := newScaleTestService(t)
+
+		result, err := service.ScaleCluster("dev", ScaleOptions{Agents: 0, Timeout: time.Second})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"k3d-dev-agent-0"}, result.Removed)
+		commands := mockExec.GetExecutedCommands()
+		cordon := commandIndex(commands, "cordon k3d-dev-agent-0")
+		drain := commandIndex(commands, "drain k3d-dev-agent-0 --ignore-daemonsets")
+		remove := commandIndex(commands, "k3d node delete k3d-dev-agent-0")
+		require.NotEqual(t, -1, cordon)
+		assert.Less(t, cordon, drain)
+		assert.Less(t, drain, remove)
+		assert.True(t, mockExec.WasCommandExecuted("delete node k3d-dev-agent-0 --ignore-not-found"))
+		assert.False(t, mockExec.WasCommandExecuted("k3d-dev-server-0"), "servers are never removed")
+
+		record, err := store.Load("dev")
+		require.NoError(t, err)
+		assert.Equal(t, 0, record.Agents)
+	})
+
+	t.Run("a failed drain keeps the node schedulable", func(t *testing.T) {
+		service, mockExec, _ := newScaleTestService(t)
+		mockExec.SetResponse("drain k3d-dev-agent-0", &executor.CommandResult{ExitCode: 1, Stderr: "cannot evict pod"})
+
+		result, err := service.ScaleCluster("dev", ScaleOptions{Agents: 0, Timeout: time.Second})
+
+		assert.ErrorContains(t, err, "failed to remove agent k3d-dev-agent-0: failed to drain node")
+		assert.Empty(t, result.Removed)
+		assert.True(t, mockExec.WasCommandExecuted("uncordon k3d-dev-agent-0"))
+		assert.False(t, mockExec.WasCommandExecuted("k3d node delete"))
+	})
+
+	t.Run("the same agent count changes nothing", func(t *testing.T) {
+		service, mockExec, _ := newScaleTestService(t)
+
+		result, err := service.ScaleCluster("dev", ScaleOptions{Agents: 1, Timeout: time.Second})
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Added)
+		assert.False(t, mockExec.WasCommandExecuted("k3d node"))
+	})
+
+	t.Run("kind clusters are not supported", func(t *testing.T) {
+		service, _, _ := newFakeRegistryService()
+
+		_, err := service.ScaleCluster("beta", ScaleOptions{Agents: 2, Timeout: time.Second})
+
+		assert.ErrorContains(t, err, "scaling is only supported for k3d clusters")
+	})
+}
+
+func TestAgentNodeNames(t *testing.T) {
+	info := models.ClusterInfo{
+		Name: "dev",
+		Nodes: []models.NodeInfo{
+			{Name: "k3d-dev-agent-10-0", Role: "agent"},
+			{Name: "k3d-dev-agent-2", Role: "agent"},
+			{Name: "k3d-dev-server-0", Role: "server"},
+			{Name: "k3d-dev-agent-0", Role: "agent"},
+		},
+	}
+
+	names := agentNodeNames(info)
+
+	assert.Equal(t, []string{"k3d-dev-agent-0", "k3d-dev-agent-2", "k3d-dev-agent-10-0"}, names)
+	assert.Equal(t, []string{"k3d-dev-agent-11-0", "k3d-dev-agent-12-0"}, newAgentNames("dev", names, 2))
+	assert.Equal(t, []string{"k3d-dev-agent-0-0"}, newAgentNames("dev", nil, 1))
+}
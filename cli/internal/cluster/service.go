@@ -3,15 +3,22 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/flamingo/openframe/internal/cluster/models"
 	"github.com/flamingo/openframe/internal/cluster/prerequisites"
+	"github.com/flamingo/openframe/internal/cluster/providers"
 	"github.com/flamingo/openframe/internal/cluster/providers/k3d"
+	"github.com/flamingo/openframe/internal/cluster/providers/kind"
 	uiCluster "github.com/flamingo/openframe/internal/cluster/ui"
+	sharedErrors "github.com/flamingo/openframe/internal/shared/errors"
 	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/output"
+	"github.com/flamingo/openframe/internal/shared/state"
 	"github.com/flamingo/openframe/internal/shared/ui"
 	"github.com/pterm/pterm"
 )
@@ -19,9 +26,12 @@ import (
 // ClusterService provides cluster configuration and management operations
 // This handles cluster lifecycle operations and configuration management
 type ClusterService struct {
-	manager    *k3d.K3dManager
-	executor   executor.CommandExecutor
-	suppressUI bool // Suppress interactive UI elements for automation
+	registry      models.ProviderRegistry
+	executor      executor.CommandExecutor
+	applications  models.ApplicationLister // Optional, enables the application section of status
+	state         *state.Store             // Optional, defaults to ~/.config/openframe/clusters
+	portAvailable func(port int) bool      // Optional host port probe, defaults to binding the port
+	suppressUI    bool                     // Suppress interactive UI elements for automation
 }
 
 // isTerminalEnvironment checks if we're running in a proper terminal
@@ -37,7 +47,7 @@ func isTerminalEnvironment() bool {
 func NewClusterService(exec executor.CommandExecutor) *ClusterService {
 	manager := k3d.CreateClusterManagerWithExecutor(exec)
 	return &ClusterService{
-		manager:    manager,
+		registry:   newDefaultRegistry(exec, manager),
 		executor:   exec,
 		suppressUI: false,
 	}
@@ -47,7 +57,7 @@ func NewClusterService(exec executor.CommandExecutor) *ClusterService {
 func NewClusterServiceSuppressed(exec executor.CommandExecutor) *ClusterService {
 	manager := k3d.CreateClusterManagerWithExecutor(exec)
 	return &ClusterService{
-		manager:    manager,
+		registry:   newDefaultRegistry(exec, manager),
 		executor:   exec,
 		suppressUI: true,
 	}
@@ -56,17 +66,69 @@ func NewClusterServiceSuppressed(exec executor.CommandExecutor) *ClusterService
 // NewClusterServiceWithOptions creates a cluster service with custom options
 func NewClusterServiceWithOptions(exec executor.CommandExecutor, manager *k3d.K3dManager) *ClusterService {
 	return &ClusterService{
-		manager:  manager,
+		registry: newDefaultRegistry(exec, manager),
 		executor: exec,
 	}
 }
 
+// NewClusterServiceWithRegistry creates a cluster service that dispatches through a custom provider registry
+func NewClusterServiceWithRegistry(exec executor.CommandExecutor, registry models.ProviderRegistry) *ClusterService {
+	return &ClusterService{
+		registry: registry,
+		executor: exec,
+	}
+}
+
+// newDefaultRegistry builds the provider registry with all supported local cluster backends
+func newDefaultRegistry(exec executor.CommandExecutor, manager *k3d.K3dManager) *providers.Registry {
+	registry := providers.NewRegistry()
+	registry.RegisterProvider(models.ClusterTypeK3d, k3d.NewProvider(manager))
+	registry.RegisterProvider(models.ClusterTypeKind, kind.NewKindProvider(exec, false))
+	return registry
+}
+
+// SetApplicationLister configures how GitOps applications are listed for cluster status
+func (s *ClusterService) SetApplicationLister(lister models.ApplicationLister) {
+	s.applications = lister
+}
+
+// orderedProviders returns all registered providers sorted by cluster type
+// so listing and detection behave deterministically across runs
+func (s *ClusterService) orderedProviders() []models.ClusterProvider {
+	all := s.registry.GetAllProviders()
+
+	types := make([]models.ClusterType, 0, len(all))
+	for clusterType := range all {
+		types = append(types, clusterType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
+	ordered := make([]models.ClusterProvider, 0, len(types))
+	for _, clusterType := range types {
+		ordered = append(ordered, all[clusterType])
+	}
+	return ordered
+}
+
+// networkName returns the docker network used by a cluster of the given type
+func networkName(info models.ClusterInfo) string {
+	if info.Type == models.ClusterTypeKind {
+		return "kind"
+	}
+	return fmt.Sprintf("k3d-%s", info.Name)
+}
+
 // CreateCluster handles cluster creation operations
 func (s *ClusterService) CreateCluster(config models.ClusterConfig) error {
 	ctx := context.Background()
 
+	provider, err := s.registry.GetProvider(config.Type)
+	if err != nil {
+		return err
+	}
+
 	// Check if cluster already exists
-	if existingInfo, err := s.manager.GetClusterStatus(ctx, config.Name); err == nil {
+	if existingInfo, err := provider.Status(ctx, config.Name); err == nil {
 		// Cluster already exists - show friendly message
 
 		// Show warning for existing cluster
@@ -78,16 +140,16 @@ func (s *ClusterService) CreateCluster(config models.ClusterConfig) error {
 				"TYPE:     %s\n"+
 				"STATUS:   %s\n"+
 				"NODES:    %d\n"+
-				"NETWORK:  k3d-%s",
+				"NETWORK:  %s",
 			pterm.Bold.Sprint(existingInfo.Name),
 			strings.ToUpper(string(existingInfo.Type)),
-			pterm.Green("Running"),
+			ui.GetStatusColor(displayState(existingInfo))(displayState(existingInfo)),
 			existingInfo.NodeCount,
-			existingInfo.Name,
+			networkName(existingInfo),
 		)
 
 		pterm.DefaultBox.
-			WithTitle(" ⚠️  Cluster Already Running  ⚠️ ").
+			WithTitle(" ⚠️  Cluster Already Exists  ⚠️ ").
 			WithTitleTopCenter().
 			Println(boxContent)
 
@@ -95,6 +157,9 @@ func (s *ClusterService) CreateCluster(config models.ClusterConfig) error {
 		if !s.suppressUI {
 			fmt.Println()
 			pterm.Info.Printf("What would you like to do?\n")
+			if existingInfo.IsStopped() {
+				pterm.Printf("  • Start it:     openframe cluster start %s\n", config.Name)
+			}
 			pterm.Printf("  • Check status: openframe cluster status %s\n", config.Name)
 			pterm.Printf("  • Delete first: openframe cluster delete %s\n", config.Name)
 			pterm.Printf("  • Use different name: openframe cluster create my-new-cluster\n")
@@ -103,24 +168,32 @@ func (s *ClusterService) CreateCluster(config models.ClusterConfig) error {
 		return nil // Exit gracefully without error
 	}
 
-	// Cluster doesn't exist, proceed with creation
+	// Cluster doesn't exist, lease its host ports and proceed with creation
+	config, releasePorts, err := s.leasePorts(ctx, config)
+	if err != nil {
+		return err
+	}
+
 	spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Creating %s cluster '%s'...", config.Type, config.Name))
 
-	err := s.manager.CreateCluster(ctx, config)
+	err = provider.Create(ctx, config)
 	if err != nil {
 		spinner.Fail(fmt.Sprintf("Failed to create cluster '%s'", config.Name))
+		releasePorts()
 		return err
 	}
 
 	spinner.Success(fmt.Sprintf("Cluster '%s' created successfully", config.Name))
 
-	// Get and display cluster status
-	if clusterInfo, statusErr := s.manager.GetClusterStatus(ctx, config.Name); statusErr == nil {
-		s.displayClusterCreationSummary(clusterInfo)
+	// Get and display cluster status, remembering the ports the provider picked
+	clusterInfo, statusErr := provider.Status(ctx, config.Name)
+	s.recordClusterCreated(config, clusterInfo)
+	if statusErr == nil {
+		s.displayClusterCreationSummary(clusterInfo, config.Ports)
 	}
 
 	// Show next steps
-	s.showNextSteps(config.Name)
+	s.showNextSteps(config.Name, !config.NoSwitchContext)
 
 	return nil
 }
@@ -132,7 +205,13 @@ func (s *ClusterService) DeleteCluster(name string, clusterType models.ClusterTy
 	// Show deletion progress
 	spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Deleting %s cluster '%s'...", clusterType, name))
 
-	err := s.manager.DeleteCluster(ctx, name, clusterType, force)
+	provider, err := s.registry.GetProvider(clusterType)
+	if err != nil {
+		spinner.Fail(fmt.Sprintf("Failed to delete cluster '%s'", name))
+		return err
+	}
+
+	err = provider.Delete(ctx, name, force)
 	if err != nil {
 		spinner.Fail(fmt.Sprintf("Failed to delete cluster '%s'", name))
 		return err
@@ -140,41 +219,98 @@ func (s *ClusterService) DeleteCluster(name string, clusterType models.ClusterTy
 
 	spinner.Stop() // Stop spinner without message - UI layer will show success
 
+	s.forgetCluster(name)
+
 	// Don't show summary here - let the UI layer handle it
 
 	return nil
 }
 
 // ListClusters handles cluster listing business logic
+// Clusters from every registered provider are merged; a provider whose tooling
+// is unavailable is skipped unless no provider could be queried at all
 func (s *ClusterService) ListClusters() ([]models.ClusterInfo, error) {
 	ctx := context.Background()
-	return s.manager.ListAllClusters(ctx)
+
+	clusters := make([]models.ClusterInfo, 0)
+	var lastErr error
+	succeeded := 0
+
+	for _, provider := range s.orderedProviders() {
+		providerClusters, err := provider.List(ctx)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		succeeded++
+		clusters = append(clusters, providerClusters...)
+	}
+
+	if succeeded == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+
+	// Only a complete listing proves that a recorded cluster is gone
+	if lastErr == nil {
+		s.pruneState(clusters)
+	}
+	for i := range clusters {
+		clusters[i] = s.withState(clusters[i])
+	}
+
+	return clusters, nil
 }
 
 // GetClusterStatus handles cluster status business logic
 func (s *ClusterService) GetClusterStatus(name string) (models.ClusterInfo, error) {
 	ctx := context.Background()
-	return s.manager.GetClusterStatus(ctx, name)
+
+	clusterType, err := s.DetectClusterType(name)
+	if err != nil {
+		return models.ClusterInfo{}, err
+	}
+
+	provider, err := s.registry.GetProvider(clusterType)
+	if err != nil {
+		return models.ClusterInfo{}, err
+	}
+
+	info, err := provider.Status(ctx, name)
+	if err != nil {
+		return info, err
+	}
+	return s.withState(info), nil
 }
 
 // DetectClusterType handles cluster type detection business logic
+// Each registered provider is asked in turn; the first one that recognises the cluster wins
 func (s *ClusterService) DetectClusterType(name string) (models.ClusterType, error) {
 	ctx := context.Background()
-	return s.manager.DetectClusterType(ctx, name)
+
+	if name == "" {
+		return "", models.NewInvalidConfigError("name", name, "cluster name cannot be empty")
+	}
+
+	for _, provider := range s.orderedProviders() {
+		if clusterType, err := provider.DetectType(ctx, name); err == nil {
+			return clusterType, nil
+		}
+	}
+
+	return "", models.NewClusterNotFoundError(name)
 }
 
 // CleanupCluster handles cluster cleanup business logic
 func (s *ClusterService) CleanupCluster(name string, clusterType models.ClusterType, verbose bool, force bool) error {
-	switch clusterType {
-	case models.ClusterTypeK3d:
-		return s.cleanupK3dCluster(name, verbose, force)
-	default:
+	if _, err := s.registry.GetProvider(clusterType); err != nil {
 		return fmt.Errorf("cleanup not supported for cluster type: %s", clusterType)
 	}
+	return s.cleanupClusterResources(name, verbose, force)
 }
 
-// cleanupK3dCluster handles K3d-specific cleanup
-func (s *ClusterService) cleanupK3dCluster(clusterName string, verbose bool, force bool) error {
+// cleanupClusterResources removes Helm releases, namespaces and node images for a cluster
+// Node image pruning only matches k3d node containers and is a no-op for other providers
+func (s *ClusterService) cleanupClusterResources(clusterName string, verbose bool, force bool) error {
 	ctx := context.Background()
 
 	if verbose {
@@ -471,22 +607,29 @@ func (s *ClusterService) isK3dWorkerNode(nodeName, clusterName string) bool {
 }
 
 // displayClusterCreationSummary displays a summary after cluster creation
-func (s *ClusterService) displayClusterCreationSummary(info models.ClusterInfo) {
+// The leased ports stand in for ports the provider does not report.
+func (s *ClusterService) displayClusterCreationSummary(info models.ClusterInfo, leased models.ClusterPorts) {
 	fmt.Println()
 
+	apiEndpoint := info.Ports.WithDefaults(leased).APIEndpoint()
+	if apiEndpoint == "" {
+		apiEndpoint = "unknown"
+	}
+
 	// Create a clean box for the summary
 	boxContent := fmt.Sprintf(
 		"NAME:     %s\n"+
 			"TYPE:     %s\n"+
 			"STATUS:   %s\n"+
 			"NODES:    %d\n"+
-			"NETWORK:  k3d-%s\n"+
-			"API:      https://0.0.0.0:6550",
+			"NETWORK:  %s\n"+
+			"API:      %s",
 		pterm.Bold.Sprint(info.Name),
 		strings.ToUpper(string(info.Type)),
 		pterm.Green("Ready"),
 		info.NodeCount,
-		info.Name,
+		networkName(info),
+		apiEndpoint,
 	)
 
 	pterm.DefaultBox.
@@ -496,13 +639,16 @@ func (s *ClusterService) displayClusterCreationSummary(info models.ClusterInfo)
 }
 
 // showNextSteps displays clean next steps after cluster creation
-func (s *ClusterService) showNextSteps(clusterName string) {
+func (s *ClusterService) showNextSteps(clusterName string, contextSwitched bool) {
 	// Skip showing next steps if UI is suppressed (e.g., during bootstrap)
 	if s.suppressUI {
 		return
 	}
 
 	fmt.Println()
+	if !contextSwitched {
+		pterm.Info.Printf("kubectl context unchanged; switch with: openframe cluster kubeconfig %s --use\n", clusterName)
+	}
 	pterm.Info.Printf("🚀 Next Steps:\n")
 	pterm.Printf("  1. Bootstrap platform:   openframe bootstrap\n")
 	pterm.Printf("  2. Check cluster nodes:  kubectl get nodes\n")
@@ -514,10 +660,8 @@ func (s *ClusterService) showNextSteps(clusterName string) {
 
 // ShowClusterStatus handles cluster status display logic
 func (s *ClusterService) ShowClusterStatus(name string, detailed bool, skipApps bool, verbose bool) error {
-	ctx := context.Background()
-
 	// Get cluster status
-	status, err := s.manager.GetClusterStatus(ctx, name)
+	status, err := s.GetClusterStatus(name)
 	if err != nil {
 		// Check if it's a "cluster not found" error and handle it friendly
 		if strings.Contains(err.Error(), "not found") {
@@ -526,7 +670,7 @@ func (s *ClusterService) ShowClusterStatus(name string, detailed bool, skipApps
 				fmt.Println()
 
 				// Get list of available clusters to show user their options
-				clusters, listErr := s.manager.ListClusters(ctx)
+				clusters, listErr := s.ListClusters()
 
 				var boxContent string
 				if listErr == nil && len(clusters) > 0 {
@@ -566,17 +710,190 @@ func (s *ClusterService) ShowClusterStatus(name string, detailed bool, skipApps
 	// Display comprehensive cluster status
 	s.displayDetailedClusterStatus(status, detailed, verbose)
 
+	// A stopped cluster has no API server to query for applications
+	if status.IsStopped() {
+		return nil
+	}
+
+	if !skipApps {
+		return s.displayApplicationStatus(status, verbose)
+	}
+
 	return nil
 }
 
+// WriteClusterList writes the cluster list as a machine-readable document
+func (s *ClusterService) WriteClusterList(w io.Writer, format output.Format, clusters []models.ClusterInfo) error {
+	if clusters == nil {
+		clusters = []models.ClusterInfo{}
+	}
+	return output.Write(w, format, models.ClusterListOutput{
+		APIVersion: output.DocumentAPIVersion,
+		Kind:       models.ClusterListKind,
+		Clusters:   clusters,
+	})
+}
+
+// WriteClusterStatus writes the cluster status as a machine-readable document
+// Like ShowClusterStatus it returns an error when any application is degraded
+func (s *ClusterService) WriteClusterStatus(w io.Writer, format output.Format, name string, detailed bool, skipApps bool) error {
+	status, err := s.GetClusterStatus(name)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return fmt.Errorf("cluster '%s' not found", name)
+		}
+		return fmt.Errorf("failed to get cluster status: %w", err)
+	}
+
+	document := models.ClusterStatusOutput{
+		APIVersion: output.DocumentAPIVersion,
+		Kind:       models.ClusterStatusKind,
+		Cluster:    status,
+	}
+
+	if detailed && !status.IsStopped() {
+		if usage, err := s.GetClusterResourceUsage(status); err == nil {
+			document.Resources = &usage
+		}
+	}
+
+	if !skipApps && !status.IsStopped() && s.applications != nil {
+		apps, err := s.applications.ListApplications(context.Background(), kubeContextName(status))
+		if err != nil {
+			document.ApplicationsError = err.Error()
+		}
+		document.Applications = apps
+		for _, app := range apps {
+			if app.IsDegraded() {
+				document.DegradedApplications = append(document.DegradedApplications, app.Name)
+			}
+		}
+	}
+
+	if err := output.Write(w, format, document); err != nil {
+		return err
+	}
+
+	if len(document.DegradedApplications) > 0 {
+		return &sharedErrors.AlreadyHandledError{
+			OriginalError: fmt.Errorf("%d ArgoCD application(s) degraded in cluster %s", len(document.DegradedApplications), status.Name),
+		}
+	}
+
+	return nil
+}
+
+// displayApplicationStatus lists ArgoCD applications grouped by sync wave
+// It returns an error when any application is degraded so that the command exits non-zero
+func (s *ClusterService) displayApplicationStatus(status models.ClusterInfo, verbose bool) error {
+	fmt.Println()
+	pterm.Info.Printf("🚢 ArgoCD Applications:\n")
+
+	if s.applications == nil {
+		pterm.Printf("  Application status not available\n")
+		return nil
+	}
+
+	apps, err := s.applications.ListApplications(context.Background(), kubeContextName(status))
+	if err != nil {
+		pterm.Printf("  Application status not available: %v\n", err)
+		return nil
+	}
+	if len(apps) == 0 {
+		pterm.Printf("  No ArgoCD applications found (install with: openframe chart install)\n")
+		return nil
+	}
+
+	var degraded []string
+	for start := 0; start < len(apps); {
+		// Applications are sorted by sync wave, so each wave is a contiguous block
+		wave := apps[start].SyncWave
+		end := start
+		tableData := pterm.TableData{{"NAME", "SYNC", "HEALTH", "REVISION", "MESSAGE"}}
+		for ; end < len(apps) && apps[end].SyncWave == wave; end++ {
+			app := apps[end]
+			if app.IsDegraded() {
+				degraded = append(degraded, app.Name)
+			}
+			tableData = append(tableData, []string{
+				app.Name,
+				app.Sync,
+				colorApplicationHealth(app.Health),
+				shortRevision(app.Revision),
+				truncateMessage(app.Message, 60),
+			})
+		}
+
+		fmt.Println()
+		pterm.Printf("  Sync wave %d\n", wave)
+		ui.RenderTableWithFallback(tableData, true)
+		start = end
+	}
+
+	if len(degraded) > 0 {
+		fmt.Println()
+		pterm.Error.Printf("%d application(s) degraded: %s\n", len(degraded), strings.Join(degraded, ", "))
+		return &sharedErrors.AlreadyHandledError{
+			OriginalError: fmt.Errorf("%d ArgoCD application(s) degraded in cluster %s", len(degraded), status.Name),
+		}
+	}
+
+	return nil
+}
+
+// colorApplicationHealth colors an ArgoCD health status for display
+func colorApplicationHealth(health string) string {
+	switch health {
+	case "Healthy":
+		return pterm.Green(health)
+	case "Progressing", "Suspended":
+		return pterm.Yellow(health)
+	case "Degraded", "Missing":
+		return pterm.Red(health)
+	default:
+		return pterm.Gray(health)
+	}
+}
+
+// shortRevision abbreviates git commit SHAs for display
+func shortRevision(revision string) string {
+	if revision == "" {
+		return "-"
+	}
+	if len(revision) == 40 {
+		return revision[:8]
+	}
+	return revision
+}
+
+// truncateMessage shortens a message to a single line of at most max characters
+func truncateMessage(message string, max int) string {
+	message = strings.Join(strings.Fields(message), " ")
+	if message == "" {
+		return "-"
+	}
+	if len(message) > max {
+		return message[:max-3] + "..."
+	}
+	return message
+}
+
 // displayDetailedClusterStatus shows comprehensive cluster information
 func (s *ClusterService) displayDetailedClusterStatus(status models.ClusterInfo, detailed bool, verbose bool) {
 	fmt.Println()
 
 	// Main cluster information box
 	statusDisplay := fmt.Sprintf("Ready (%s)", status.Status)
-	if status.Status != "1/1" {
+	switch status.State {
+	case models.ClusterStateStopped:
+		statusDisplay = fmt.Sprintf("Stopped (%s)", status.Status)
+	case models.ClusterStatePartial:
 		statusDisplay = fmt.Sprintf("Partial (%s)", status.Status)
+	case models.ClusterStateRunning:
+	default:
+		if status.Status != "1/1" {
+			statusDisplay = fmt.Sprintf("Partial (%s)", status.Status)
+		}
 	}
 
 	// Calculate age
@@ -593,19 +910,25 @@ func (s *ClusterService) displayDetailedClusterStatus(status models.ClusterInfo,
 		}
 	}
 
+	apiEndpoint := status.Ports.APIEndpoint()
+	if apiEndpoint == "" {
+		apiEndpoint = "Unknown"
+	}
+
 	boxContent := fmt.Sprintf(
 		"NAME:     %s\n"+
 			"TYPE:     %s\n"+
 			"STATUS:   %s\n"+
-			"NODES:    %d\n"+
-			"NETWORK:  k3d-%s\n"+
-			"API:      https://0.0.0.0:6550\n"+
+			"NODES:    %s\n"+
+			"NETWORK:  %s\n"+
+			"API:      %s\n"+
 			"AGE:      %s",
 		pterm.Bold.Sprint(status.Name),
 		strings.ToUpper(string(status.Type)),
 		statusDisplay,
-		status.NodeCount,
-		status.Name,
+		nodesDisplay(status),
+		networkName(status),
+		apiEndpoint,
 		ageStr,
 	)
 
@@ -614,32 +937,140 @@ func (s *ClusterService) displayDetailedClusterStatus(status models.ClusterInfo,
 		WithTitleTopCenter().
 		Println(boxContent)
 
+	// An in-place upgrade leaves the containers on their original image
+	if status.NodeImage != "" {
+		fmt.Println()
+		pterm.Info.Printf("Nodes run k3s from %s after an in-place upgrade; docker and k3d show the image the containers were created from\n", status.NodeImage)
+	}
+
 	// Network information
 	fmt.Println()
 	pterm.Info.Printf("🌐 Network Information:\n")
-	pterm.Printf("  Network:    k3d-%s\n", status.Name)
-	pterm.Printf("  API Server: https://0.0.0.0:6550\n")
+	pterm.Printf("  Network:    %s\n", networkName(status))
+	pterm.Printf("  API Server: %s\n", apiEndpoint)
+	if status.Ports.HTTP != 0 {
+		pterm.Printf("  HTTP:       http://localhost:%d\n", status.Ports.HTTP)
+	}
+	if status.Ports.HTTPS != 0 {
+		pterm.Printf("  HTTPS:      https://localhost:%d\n", status.Ports.HTTPS)
+	}
 	pterm.Printf("  Kubeconfig: ~/.kube/config\n")
 
-	// Show resource usage if detailed
-	if detailed {
+	// Chart installation as remembered by the state store
+	if status.Install != nil {
 		fmt.Println()
-		pterm.Info.Printf("💾 Resource Usage:\n")
-		pterm.Printf("  CPU:     0.2 cores (10%%)\n")
-		pterm.Printf("  Memory:  512MB (5%%)\n")
-		pterm.Printf("  Storage: 2.1GB (local)\n")
-		pterm.Printf("  Pods:    System pods running\n")
+		pterm.Info.Printf("📦 Installation:\n")
+		pterm.Printf("  Branch:     %s\n", valueOrDash(status.Install.Branch))
+		pterm.Printf("  Ingress:    %s\n", valueOrDash(status.Install.IngressMode))
+		if status.Install.Repository != "" {
+			pterm.Printf("  Repository: %s\n", status.Install.Repository)
+		}
+		if !status.Install.InstalledAt.IsZero() {
+			pterm.Printf("  Installed:  %s\n", status.Install.InstalledAt.Local().Format("2006-01-02 15:04"))
+		}
+		if status.Install.ValuesFile != "" {
+			pterm.Printf("  Values:     %s\n", status.Install.ValuesFile)
+		}
+	}
+
+	// Node roles, so HA control planes can be told apart from agents
+	if len(status.Nodes) > 0 {
+		fmt.Println()
+		pterm.Info.Printf("🖥️ Nodes:\n")
+		tableData := pterm.TableData{{"NAME", "ROLE", "STATUS"}}
+		for _, node := range status.Nodes {
+			tableData = append(tableData, []string{node.Name, node.Role, node.Status})
+		}
+		ui.RenderTableWithFallback(tableData, true)
+	}
+
+	// Show resource usage if detailed
+	if detailed && !status.IsStopped() {
+		s.displayResourceUsage(status, verbose)
 	}
 
 	// Management commands
 	fmt.Println()
 	pterm.Info.Printf("⚙️ Management Commands:\n")
+	if status.IsStopped() {
+		pterm.Printf("  Start cluster:       openframe cluster start %s\n", status.Name)
+	} else {
+		pterm.Printf("  Stop cluster:        openframe cluster stop %s\n", status.Name)
+	}
 	pterm.Printf("  Delete cluster:      openframe cluster delete %s\n", status.Name)
 	pterm.Printf("  Access with kubectl: kubectl get nodes\n")
 	pterm.Printf("  View pods:           kubectl get pods -A\n")
 	pterm.Printf("  Get cluster info:    kubectl cluster-info\n")
 }
 
+// valueOrDash returns a placeholder for empty values in status output
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+// nodesDisplay formats the node count with the server/agent split, marking HA control planes
+func nodesDisplay(status models.ClusterInfo) string {
+	if len(status.Nodes) == 0 {
+		return fmt.Sprintf("%d", status.NodeCount)
+	}
+	servers, agents := status.RoleCounts()
+	display := fmt.Sprintf("%d (servers: %d, agents: %d)", status.NodeCount, servers, agents)
+	if status.IsHA() {
+		display += " HA"
+	}
+	return display
+}
+
+// displayResourceUsage shows real per-node usage and pod counts per namespace
+func (s *ClusterService) displayResourceUsage(status models.ClusterInfo, verbose bool) {
+	fmt.Println()
+	pterm.Info.Printf("💾 Resource Usage:\n")
+
+	usage, err := s.GetClusterResourceUsage(status)
+	if err != nil {
+		pterm.Printf("  Resource usage unavailable (is the cluster running?)\n")
+		if verbose {
+			pterm.Printf("  %v\n", err)
+		}
+		return
+	}
+
+	if len(usage.Nodes) > 0 {
+		tableData := pterm.TableData{{"NODE", "CPU", "CPU%", "MEMORY", "MEMORY%"}}
+		for _, node := range usage.Nodes {
+			tableData = append(tableData, []string{node.Name, node.CPU, node.CPUPercent, node.Memory, node.MemoryPercent})
+		}
+		ui.RenderTableWithFallback(tableData, true)
+		if usage.Source == models.ResourceSourceDockerStats {
+			pterm.Printf("  Node usage from docker stats (metrics API not available)\n")
+		}
+	} else {
+		pterm.Printf("  Node metrics unavailable\n")
+	}
+
+	if len(usage.Namespaces) > 0 {
+		fmt.Println()
+		running, total := usage.PodTotals()
+		pterm.Info.Printf("📦 Pods: %d/%d running\n", running, total)
+		tableData := pterm.TableData{{"NAMESPACE", "RUNNING", "TOTAL"}}
+		for _, namespace := range usage.Namespaces {
+			tableData = append(tableData, []string{namespace.Namespace, fmt.Sprintf("%d", namespace.Running), fmt.Sprintf("%d", namespace.Total)})
+		}
+		ui.RenderTableWithFallback(tableData, true)
+	}
+}
+
+// displayState returns the lifecycle state of a cluster for tables, falling back to the raw node status
+func displayState(info models.ClusterInfo) string {
+	if info.State != "" {
+		return info.State
+	}
+	return info.Status
+}
+
 // DisplayClusterList handles cluster list display logic
 func (s *ClusterService) DisplayClusterList(clusters []models.ClusterInfo, quiet bool, verbose bool) error {
 	if len(clusters) == 0 {
@@ -667,7 +1098,7 @@ func (s *ClusterService) DisplayClusterList(clusters []models.ClusterInfo, quiet
 		displayClusters[i] = uiCluster.ClusterDisplayInfo{
 			Name:      cluster.Name,
 			Type:      string(cluster.Type),
-			Status:    cluster.Status,
+			Status:    displayState(cluster),
 			NodeCount: cluster.NodeCount,
 			CreatedAt: cluster.CreatedAt,
 		}
@@ -688,31 +1119,43 @@ func (s *ClusterService) DisplayClusterList(clusters []models.ClusterInfo, quiet
 
 // CreateClusterWithPrerequisites creates a cluster after checking prerequisites
 // This is a wrapper function for bootstrap and other automated flows
-func CreateClusterWithPrerequisites(clusterName string, verbose bool) error {
+func CreateClusterWithPrerequisites(clusterName string, verbose bool, skipPreflight bool) error {
+	// Build cluster configuration
+	config := models.ClusterConfig{
+		Name:       clusterName,
+		Type:       models.ClusterTypeK3d,
+		K8sVersion: "",
+		NodeCount:  3,
+	}
+	if clusterName == "" {
+		config.Name = "openframe-dev" // default name
+	}
+
+	return CreateClusterFromConfigWithPrerequisites(config, verbose, skipPreflight)
+}
+
+// CreateClusterFromConfigWithPrerequisites creates a cluster from a prepared configuration after
+// checking prerequisites, for automated flows that need more than the default cluster
+func CreateClusterFromConfigWithPrerequisites(config models.ClusterConfig, verbose bool, skipPreflight bool) error {
 	// Show logo first, then check prerequisites (consistent with individual commands)
 	ui.ShowLogo()
-	
+
 	// Check prerequisites using the installer directly
 	installer := prerequisites.NewInstaller()
 	if err := installer.CheckAndInstall(); err != nil {
 		return err
 	}
-	
+
 	// Create service directly without using utils to avoid circular import
 	exec := executor.NewRealCommandExecutor(false, verbose) // dryRun = false
 	service := NewClusterServiceSuppressed(exec)
-	
-	// Build cluster configuration
-	config := models.ClusterConfig{
-		Name:       clusterName,
-		Type:       models.ClusterTypeK3d,
-		K8sVersion: "",
-		NodeCount:  3,
-	}
-	if clusterName == "" {
-		config.Name = "openframe-dev" // default name
+
+	if !skipPreflight {
+		if err := service.RunPreflightChecks(); err != nil {
+			return fmt.Errorf("%w; fix the problems above or rerun with --skip-preflight", err)
+		}
 	}
-	
+
 	// Create the cluster
 	return service.CreateCluster(config)
 }
@@ -1,11 +1,21 @@
 package cluster
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/cluster/providers"
 	"github.com/flamingo/openframe/internal/cluster/providers/k3d"
+	sharedErrors "github.com/flamingo/openframe/internal/shared/errors"
 	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // createTestExecutor creates a mock executor for testing
@@ -20,6 +30,12 @@ func createTestExecutor() executor.CommandExecutor {
 		Duration: 100,
 	})
 	
+	// No kind clusters
+	mock.SetResponse("kind get clusters", &executor.CommandResult{
+		ExitCode: 0,
+		Stdout:   "",
+	})
+	
 	return mock
 }
 
@@ -35,8 +51,14 @@ func TestNewClusterService(t *testing.T) {
 		t.Error("service should store the provided executor")
 	}
 	
-	if service.manager == nil {
-		t.Error("service should have a manager initialized")
+	if service.registry == nil {
+		t.Fatal("service should have a provider registry initialized")
+	}
+	
+	for _, clusterType := range []models.ClusterType{models.ClusterTypeK3d, models.ClusterTypeKind} {
+		if _, err := service.registry.GetProvider(clusterType); err != nil {
+			t.Errorf("registry should have a %s provider: %v", clusterType, err)
+		}
 	}
 }
 
@@ -54,8 +76,13 @@ func TestNewClusterServiceWithOptions(t *testing.T) {
 		t.Error("service should store the provided executor")
 	}
 	
-	if service.manager != customManager {
-		t.Error("service should store the provided manager")
+	provider, err := service.registry.GetProvider(models.ClusterTypeK3d)
+	if err != nil {
+		t.Fatalf("registry should have a k3d provider: %v", err)
+	}
+	
+	if provider.(*k3d.Provider).Manager() != customManager {
+		t.Error("service should dispatch k3d operations to the provided manager")
 	}
 }
 
@@ -77,6 +104,44 @@ func TestClusterService_CreateCluster(t *testing.T) {
 	}
 }
 
+func TestClusterService_CreateCluster_RoutesByType(t *testing.T) {
+	t.Run("kind clusters are created by the kind provider", func(t *testing.T) {
+		mock := executor.NewMockCommandExecutor()
+		mock.SetResponse("kind get clusters", &executor.CommandResult{Stdout: ""})
+		service := NewClusterService(mock)
+
+		err := service.CreateCluster(models.ClusterConfig{
+			Name:      "kind-dev",
+			Type:      models.ClusterTypeKind,
+			NodeCount: 1,
+		})
+
+		if err != nil {
+			t.Fatalf("CreateCluster should not error for kind: %v", err)
+		}
+		if !mock.WasCommandExecuted("kind create cluster --name kind-dev") {
+			t.Error("expected kind create cluster to be executed")
+		}
+		if mock.WasCommandExecuted("k3d cluster create") {
+			t.Error("k3d should not be used for kind clusters")
+		}
+	})
+
+	t.Run("unknown cluster type returns provider not found", func(t *testing.T) {
+		service := NewClusterService(createTestExecutor())
+
+		err := service.CreateCluster(models.ClusterConfig{
+			Name:      "gke-dev",
+			Type:      models.ClusterTypeGKE,
+			NodeCount: 1,
+		})
+
+		if _, ok := err.(models.ErrProviderNotFound); !ok {
+			t.Errorf("expected ErrProviderNotFound, got %v", err)
+		}
+	})
+}
+
 func TestClusterService_DeleteCluster(t *testing.T) {
 	exec := createTestExecutor()
 	service := NewClusterService(exec)
@@ -179,4 +244,344 @@ func TestClusterService_WithRealExecutor(t *testing.T) {
 	err := service.CreateCluster(config)
 	// Dry-run might still error if k3d is not available, which is acceptable in tests
 	_ = err
-}
\ No newline at end of file
+}
+// fakeProvider is a minimal in-memory ClusterProvider used to verify registry dispatch
+type fakeProvider struct {
+	clusterType models.ClusterType
+	clusters    []models.ClusterInfo
+	listErr     error
+	createErr   error
+	created     []models.ClusterConfig
+	deleted     []string
+	started     []string
+	stopped     []string
+}
+
+func (f *fakeProvider) Create(ctx context.Context, config models.ClusterConfig) error {
+	if f.createErr != nil {
+		return f.createErr
+	}
+	f.created = append(f.created, config)
+	f.clusters = append(f.clusters, models.ClusterInfo{Name: config.Name, Type: f.clusterType, Ports: config.Ports})
+	return nil
+}
+
+func (f *fakeProvider) Delete(ctx context.Context, name string, force bool) error {
+	f.deleted = append(f.deleted, name)
+	return nil
+}
+
+func (f *fakeProvider) Start(ctx context.Context, name string) error {
+	f.started = append(f.started, name)
+	return nil
+}
+
+func (f *fakeProvider) Stop(ctx context.Context, name string) error {
+	f.stopped = append(f.stopped, name)
+	return nil
+}
+
+func (f *fakeProvider) List(ctx context.Context) ([]models.ClusterInfo, error) {
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+	return f.clusters, nil
+}
+
+func (f *fakeProvider) Status(ctx context.Context, name string) (models.ClusterInfo, error) {
+	for _, c := range f.clusters {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+	return models.ClusterInfo{}, models.NewClusterNotFoundError(name)
+}
+
+func (f *fakeProvider) DetectType(ctx context.Context, name string) (models.ClusterType, error) {
+	if _, err := f.Status(ctx, name); err != nil {
+		return "", err
+	}
+	return f.clusterType, nil
+}
+
+func (f *fakeProvider) GetKubeconfig(ctx context.Context, name string) (string, error) {
+	if _, err := f.Status(ctx, name); err != nil {
+		return "", err
+	}
+	return fakeKubeconfig(string(f.clusterType) + "-" + name), nil
+}
+
+// fakeKubeconfig renders a minimal kubeconfig with one cluster, user and context of the same name
+func fakeKubeconfig(name string) string {
+	return fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+  - name: %[1]s
+    cluster:
+      server: https://127.0.0.1:6550
+contexts:
+  - name: %[1]s
+    context:
+      cluster: %[1]s
+      user: admin@%[1]s
+users:
+  - name: admin@%[1]s
+    user:
+      token: secret
+current-context: %[1]s
+`, name)
+}
+
+func newFakeRegistryService() (*ClusterService, *fakeProvider, *fakeProvider) {
+	k3dFake := &fakeProvider{
+		clusterType: models.ClusterTypeK3d,
+		clusters:    []models.ClusterInfo{{Name: "alpha", Type: models.ClusterTypeK3d}},
+	}
+	kindFake := &fakeProvider{
+		clusterType: models.ClusterTypeKind,
+		clusters:    []models.ClusterInfo{{Name: "beta", Type: models.ClusterTypeKind}},
+	}
+
+	registry := providers.NewRegistry()
+	registry.RegisterProvider(models.ClusterTypeK3d, k3dFake)
+	registry.RegisterProvider(models.ClusterTypeKind, kindFake)
+
+	return NewClusterServiceWithRegistry(executor.NewMockCommandExecutor(), registry), k3dFake, kindFake
+}
+
+func TestClusterService_RegistryDispatch(t *testing.T) {
+	t.Run("list merges clusters from all providers", func(t *testing.T) {
+		service, _, _ := newFakeRegistryService()
+
+		clusters, err := service.ListClusters()
+
+		require.NoError(t, err)
+		require.Len(t, clusters, 2)
+		assert.Equal(t, "alpha", clusters[0].Name)
+		assert.Equal(t, "beta", clusters[1].Name)
+	})
+
+	t.Run("list skips providers that fail", func(t *testing.T) {
+		service, _, kindFake := newFakeRegistryService()
+		kindFake.listErr = errors.New("kind: command not found")
+
+		clusters, err := service.ListClusters()
+
+		require.NoError(t, err)
+		require.Len(t, clusters, 1)
+		assert.Equal(t, "alpha", clusters[0].Name)
+	})
+
+	t.Run("list fails when every provider fails", func(t *testing.T) {
+		service, k3dFake, kindFake := newFakeRegistryService()
+		k3dFake.listErr = errors.New("k3d: command not found")
+		kindFake.listErr = errors.New("kind: command not found")
+
+		_, err := service.ListClusters()
+
+		assert.Error(t, err)
+	})
+
+	t.Run("detect asks each provider in turn", func(t *testing.T) {
+		service, _, _ := newFakeRegistryService()
+
+		clusterType, err := service.DetectClusterType("beta")
+		require.NoError(t, err)
+		assert.Equal(t, models.ClusterTypeKind, clusterType)
+
+		_, err = service.DetectClusterType("gamma")
+		var notFound models.ErrClusterNotFound
+		assert.ErrorAs(t, err, &notFound)
+	})
+
+	t.Run("status uses the detected provider", func(t *testing.T) {
+		service, _, _ := newFakeRegistryService()
+
+		info, err := service.GetClusterStatus("beta")
+
+		require.NoError(t, err)
+		assert.Equal(t, models.ClusterTypeKind, info.Type)
+	})
+
+	t.Run("delete dispatches by cluster type", func(t *testing.T) {
+		service, k3dFake, kindFake := newFakeRegistryService()
+
+		require.NoError(t, service.DeleteCluster("beta", models.ClusterTypeKind, false))
+
+		assert.Equal(t, []string{"beta"}, kindFake.deleted)
+		assert.Empty(t, k3dFake.deleted)
+	})
+
+	t.Run("cleanup rejects unregistered cluster types", func(t *testing.T) {
+		service, _, _ := newFakeRegistryService()
+
+		err := service.CleanupCluster("alpha", models.ClusterTypeGKE, false, false)
+
+		assert.ErrorContains(t, err, "cleanup not supported")
+	})
+}
+
+// fakeApplicationLister returns a fixed set of applications and records the requested context
+type fakeApplicationLister struct {
+	apps        []models.ApplicationStatus
+	err         error
+	kubeContext string
+	calls       int
+}
+
+func (f *fakeApplicationLister) ListApplications(ctx context.Context, kubeContext string) ([]models.ApplicationStatus, error) {
+	f.calls++
+	f.kubeContext = kubeContext
+	return f.apps, f.err
+}
+
+func TestClusterService_ShowClusterStatus_Applications(t *testing.T) {
+	healthyApps := []models.ApplicationStatus{
+		{Name: "argocd-apps", Sync: "Synced", Health: "Healthy", SyncWave: -1},
+		{Name: "cassandra", Sync: "Synced", Health: "Healthy", SyncWave: 0},
+		{Name: "openframe-api", Sync: "OutOfSync", Health: "Progressing", SyncWave: 2},
+	}
+
+	t.Run("lists applications for the cluster context", func(t *testing.T) {
+		service, _, _ := newFakeRegistryService()
+		lister := &fakeApplicationLister{apps: healthyApps}
+		service.SetApplicationLister(lister)
+
+		err := service.ShowClusterStatus("beta", false, false, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, lister.calls)
+		assert.Equal(t, "kind-beta", lister.kubeContext)
+	})
+
+	t.Run("no-apps skips the application section", func(t *testing.T) {
+		service, _, _ := newFakeRegistryService()
+		lister := &fakeApplicationLister{apps: healthyApps}
+		service.SetApplicationLister(lister)
+
+		require.NoError(t, service.ShowClusterStatus("alpha", false, true, false))
+		assert.Equal(t, 0, lister.calls)
+	})
+
+	t.Run("degraded applications fail the command", func(t *testing.T) {
+		service, _, _ := newFakeRegistryService()
+		service.SetApplicationLister(&fakeApplicationLister{apps: append(healthyApps,
+			models.ApplicationStatus{Name: "kafka", Sync: "Synced", Health: "Degraded", SyncWave: 1, Message: "one or more objects failed to apply"},
+		)})
+
+		err := service.ShowClusterStatus("alpha", false, false, false)
+
+		var handled *sharedErrors.AlreadyHandledError
+		require.ErrorAs(t, err, &handled)
+		assert.ErrorContains(t, err, "1 ArgoCD application(s) degraded in cluster alpha")
+	})
+
+	t.Run("missing ArgoCD is not an error", func(t *testing.T) {
+		service, _, _ := newFakeRegistryService()
+		service.SetApplicationLister(&fakeApplicationLister{err: errors.New("the server doesn't have a resource type \"applications\"")})
+
+		assert.NoError(t, service.ShowClusterStatus("alpha", false, false, true))
+	})
+
+	t.Run("stopped clusters skip the application section", func(t *testing.T) {
+		service, k3dFake, _ := newFakeRegistryService()
+		k3dFake.clusters[0].State = models.ClusterStateStopped
+		lister := &fakeApplicationLister{apps: healthyApps}
+		service.SetApplicationLister(lister)
+
+		require.NoError(t, service.ShowClusterStatus("alpha", true, false, false))
+		assert.Equal(t, 0, lister.calls)
+	})
+}
+
+func TestApplicationDisplayHelpers(t *testing.T) {
+	assert.Equal(t, "-", shortRevision(""))
+	assert.Equal(t, "3f2a9c1d", shortRevision("3f2a9c1d8e7b6a5f4e3d2c1b0a9f8e7d6c5b4a39"))
+	assert.Equal(t, "main", shortRevision("main"))
+
+	assert.Equal(t, "-", truncateMessage("  ", 10))
+	assert.Equal(t, "sync ok", truncateMessage("sync\n ok", 10))
+	assert.Equal(t, "abcdefg...", truncateMessage("abcdefghijklmnop", 10))
+}
+
+func TestNodesDisplay(t *testing.T) {
+	assert.Equal(t, "4", nodesDisplay(models.ClusterInfo{NodeCount: 4}))
+
+	single := models.ClusterInfo{NodeCount: 2, Nodes: []models.NodeInfo{
+		{Name: "k3d-dev-server-0", Role: "server"},
+		{Name: "k3d-dev-agent-0", Role: "agent"},
+	}}
+	assert.Equal(t, "2 (servers: 1, agents: 1)", nodesDisplay(single))
+
+	ha := models.ClusterInfo{NodeCount: 3, Nodes: []models.NodeInfo{
+		{Name: "k3d-dev-server-0", Role: "server"},
+		{Name: "k3d-dev-server-1", Role: "server"},
+		{Name: "k3d-dev-server-2", Role: "server"},
+	}}
+	assert.Equal(t, "3 (servers: 3, agents: 0) HA", nodesDisplay(ha))
+}
+
+func TestClusterService_WriteClusterList(t *testing.T) {
+	service, _, _ := newFakeRegistryService()
+	clusters, err := service.ListClusters()
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, service.WriteClusterList(&buf, output.FormatJSON, clusters))
+
+	var document models.ClusterListOutput
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &document))
+	assert.Equal(t, output.DocumentAPIVersion, document.APIVersion)
+	assert.Equal(t, models.ClusterListKind, document.Kind)
+	require.Len(t, document.Clusters, 2)
+	assert.Equal(t, "alpha", document.Clusters[0].Name)
+
+	buf.Reset()
+	require.NoError(t, service.WriteClusterList(&buf, output.FormatJSON, nil))
+	assert.Contains(t, buf.String(), `"clusters": []`)
+}
+
+func TestClusterService_WriteClusterStatus(t *testing.T) {
+	t.Run("includes applications and fails on degraded ones", func(t *testing.T) {
+		service, _, _ := newFakeRegistryService()
+		service.SetApplicationLister(&fakeApplicationLister{apps: []models.ApplicationStatus{
+			{Name: "cassandra", Sync: "Synced", Health: "Healthy"},
+			{Name: "kafka", Sync: "Synced", Health: "Degraded", SyncWave: 1},
+		}})
+
+		var buf bytes.Buffer
+		err := service.WriteClusterStatus(&buf, output.FormatYAML, "alpha", false, false)
+
+		var handled *sharedErrors.AlreadyHandledError
+		require.ErrorAs(t, err, &handled)
+		assert.Contains(t, buf.String(), "kind: ClusterStatus")
+		assert.Contains(t, buf.String(), "name: alpha")
+		assert.Contains(t, buf.String(), "degraded_applications:\n  - kafka")
+	})
+
+	t.Run("skips applications with no-apps", func(t *testing.T) {
+		service, _, _ := newFakeRegistryService()
+		lister := &fakeApplicationLister{}
+		service.SetApplicationLister(lister)
+
+		var buf bytes.Buffer
+		require.NoError(t, service.WriteClusterStatus(&buf, output.FormatJSON, "beta", false, true))
+
+		var document models.ClusterStatusOutput
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &document))
+		assert.Equal(t, "beta", document.Cluster.Name)
+		assert.Nil(t, document.Applications)
+		assert.Nil(t, document.Resources)
+		assert.Equal(t, 0, lister.calls)
+	})
+
+	t.Run("returns not found without writing a document", func(t *testing.T) {
+		service, _, _ := newFakeRegistryService()
+
+		var buf bytes.Buffer
+		err := service.WriteClusterStatus(&buf, output.FormatJSON, "missing", false, true)
+
+		assert.EqualError(t, err, "cluster 'missing' not found")
+		assert.Empty(t, buf.String())
+	})
+}
@@ -0,0 +1,511 @@
+package cluster
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	chartmodels "github.com/flamingo/openframe/internal/chart/models"
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/pterm/pterm"
+)
+
+const (
+	// snapshotHelperImage runs tar against the data volumes of stopped node containers
+	snapshotHelperImage = "alpine:3.20"
+	// k3sDataDir is the k3s data volume inside every k3d node container
+	k3sDataDir = "/var/lib/rancher/k3s"
+	// snapshotExtension is appended to snapshot names to build the archive file name
+	snapshotExtension = ".tar.gz"
+)
+
+// Snapshots hold the k3s datastore, with every Kubernetes Secret, and the helm values, with
+// registry and ngrok credentials, so only the current user can read them
+const (
+	snapshotDirMode  os.FileMode = 0700
+	snapshotFileMode os.FileMode = 0600
+)
+
+// SnapshotOptions controls where a cluster snapshot is written
+type SnapshotOptions struct {
+	Name      string // Snapshot name, defaults to <cluster>-<timestamp>
+	Directory string // Target directory, defaults to DefaultSnapshotDirectory
+}
+
+// helmReleaseInfo mirrors the fields of `helm list --output json` used in snapshots
+type helmReleaseInfo struct {
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace"`
+	Revision   string `json:"revision"`
+	Chart      string `json:"chart"`
+	AppVersion string `json:"app_version"`
+}
+
+// DefaultSnapshotDirectory returns the directory snapshots are stored in by default
+func DefaultSnapshotDirectory() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".config", "openframe", "snapshots"), nil
+}
+
+// ResolveSnapshotPath accepts either a path to a snapshot archive or the name of a snapshot
+// in the default snapshot directory
+func ResolveSnapshotPath(from string) (string, error) {
+	if from == "" {
+		return "", fmt.Errorf("snapshot name or path is required")
+	}
+	if _, err := os.Stat(from); err == nil {
+		return from, nil
+	}
+
+	directory, err := DefaultSnapshotDirectory()
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(directory, from)
+	if !strings.HasSuffix(path, snapshotExtension) {
+		path += snapshotExtension
+	}
+	if _, err := os.Stat(path); err != nil {
+		return "", fmt.Errorf("snapshot '%s' not found", from)
+	}
+	return path, nil
+}
+
+// snapshotPaths returns the k3s data paths archived for a node role
+// Servers also carry the datastore so Kubernetes objects match the restored volume data
+func snapshotPaths(role string) []string {
+	if role == "server" {
+		return []string{"storage", "server/db"}
+	}
+	return []string{"storage"}
+}
+
+// shellQuote quotes a value for a POSIX shell script
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// shellQuoteAll quotes each value and joins them with spaces
+func shellQuoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = shellQuote(value)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// snapshotNodes returns the server and agent nodes of a cluster, the ones holding k3s data
+func snapshotNodes(info models.ClusterInfo) []models.NodeInfo {
+	var nodes []models.NodeInfo
+	for _, node := range info.Nodes {
+		if node.Role == "server" || node.Role == "agent" {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// SnapshotCluster archives the local-path volume data, the k3s datastore, the installed helm
+// values and the ArgoCD application revisions of a k3d cluster into a single tarball
+// A running cluster is stopped while its volumes are archived and started again afterwards
+func (s *ClusterService) SnapshotCluster(name string, opts SnapshotOptions) (string, *models.SnapshotManifest, error) {
+	ctx := context.Background()
+
+	info, err := s.GetClusterStatus(name)
+	if err != nil {
+		return "", nil, err
+	}
+	if info.Type != models.ClusterTypeK3d {
+		return "", nil, fmt.Errorf("snapshots are only supported for k3d clusters, %s is a %s cluster", name, info.Type)
+	}
+	nodes := snapshotNodes(info)
+	if len(nodes) == 0 {
+		return "", nil, fmt.Errorf("no node containers found for cluster %s", name)
+	}
+
+	if opts.Name == "" {
+		opts.Name = fmt.Sprintf("%s-%s", name, time.Now().Format("20060102-150405"))
+	}
+	if opts.Directory == "" {
+		if opts.Directory, err = DefaultSnapshotDirectory(); err != nil {
+			return "", nil, err
+		}
+	}
+	archivePath := filepath.Join(opts.Directory, opts.Name+snapshotExtension)
+	if _, err := os.Stat(archivePath); err == nil {
+		return "", nil, fmt.Errorf("snapshot %s already exists", archivePath)
+	}
+
+	staging, err := os.MkdirTemp("", "openframe-snapshot-")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create staging directory: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	manifest := &models.SnapshotManifest{
+		APIVersion: models.SnapshotAPIVersion,
+		Kind:       models.SnapshotKind,
+		Name:       opts.Name,
+		CreatedAt:  time.Now().UTC(),
+		Cluster:    models.SnapshotCluster{Name: info.Name, Type: info.Type},
+	}
+
+	// Helm and ArgoCD state can only be read while the API server is up
+	wasRunning := !info.IsStopped()
+	if wasRunning {
+		s.captureHelmReleases(ctx, info, staging, manifest)
+		s.captureApplications(ctx, info, manifest)
+		if err := s.StopCluster(name, info.Type); err != nil {
+			return "", nil, err
+		}
+	} else {
+		pterm.Warning.Printf("Cluster '%s' is stopped, helm values and application revisions are not recorded\n", name)
+	}
+
+	archiveErr := s.archiveNodeVolumes(ctx, nodes, staging, manifest)
+
+	if wasRunning {
+		if err := s.StartCluster(name, info.Type); err != nil && archiveErr == nil {
+			archiveErr = err
+		}
+	}
+	if archiveErr != nil {
+		return "", nil, archiveErr
+	}
+
+	manifestData, err := manifest.ToYAML()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encode snapshot manifest: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(staging, models.SnapshotManifestFile), manifestData, snapshotFileMode); err != nil {
+		return "", nil, fmt.Errorf("failed to write snapshot manifest: %w", err)
+	}
+
+	if err := os.MkdirAll(opts.Directory, snapshotDirMode); err != nil {
+		return "", nil, fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+	if err := writeTarGz(staging, archivePath); err != nil {
+		os.Remove(archivePath)
+		return "", nil, fmt.Errorf("failed to write snapshot archive: %w", err)
+	}
+
+	return archivePath, manifest, nil
+}
+
+// archiveNodeVolumes tars the k3s data paths of each stopped node container into the staging directory
+func (s *ClusterService) archiveNodeVolumes(ctx context.Context, nodes []models.NodeInfo, staging string, manifest *models.SnapshotManifest) error {
+	nodesDir := filepath.Join(staging, models.SnapshotNodesDir)
+	if err := os.MkdirAll(nodesDir, snapshotDirMode); err != nil {
+		return fmt.Errorf("failed to create staging directory: %w", err)
+	}
+
+	spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Archiving %d node volumes...", len(nodes)))
+	for _, node := range nodes {
+		paths := snapshotPaths(node.Role)
+		archive := node.Name + snapshotExtension
+		script := fmt.Sprintf("umask 077 && cd %s && mkdir -p %s && tar -czf %s %s",
+			shellQuote(k3sDataDir), shellQuoteAll(paths), shellQuote("/backup/"+archive), shellQuoteAll(paths))
+
+		if _, err := s.executor.Execute(ctx, "docker", "run", "--rm",
+			"--volumes-from", node.Name,
+			"-v", nodesDir+":/backup",
+			snapshotHelperImage, "sh", "-c", script); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to archive node %s", node.Name))
+			return fmt.Errorf("failed to archive volumes of node %s: %w", node.Name, err)
+		}
+
+		manifest.Nodes = append(manifest.Nodes, models.SnapshotNode{
+			Name:    node.Name,
+			Role:    node.Role,
+			Archive: models.SnapshotNodesDir + "/" + archive,
+			Paths:   paths,
+		})
+	}
+	spinner.Success(fmt.Sprintf("Archived %d node volumes", len(nodes)))
+
+	return nil
+}
+
+// captureHelmReleases saves the user-supplied values of every helm release
+// Failures only drop the helm section from the snapshot
+func (s *ClusterService) captureHelmReleases(ctx context.Context, info models.ClusterInfo, staging string, manifest *models.SnapshotManifest) {
+	kubeContext := kubeContextName(info)
+
+	result, err := s.executor.Execute(ctx, "helm", "--kube-context", kubeContext, "list", "--all-namespaces", "--output", "json")
+	if err != nil {
+		pterm.Warning.Printf("Could not list helm releases, helm values are not recorded: %v\n", err)
+		return
+	}
+
+	var releases []helmReleaseInfo
+	if err := json.Unmarshal([]byte(result.Stdout), &releases); err != nil {
+		pterm.Warning.Printf("Could not parse helm releases, helm values are not recorded: %v\n", err)
+		return
+	}
+
+	for _, release := range releases {
+		entry := models.SnapshotHelmRelease{
+			Name:       release.Name,
+			Namespace:  release.Namespace,
+			Chart:      release.Chart,
+			AppVersion: release.AppVersion,
+			Revision:   release.Revision,
+		}
+
+		values, err := s.executor.Execute(ctx, "helm", "--kube-context", kubeContext,
+			"get", "values", release.Name, "--namespace", release.Namespace, "--output", "yaml")
+		if err == nil {
+			valuesFile := filepath.Join("helm", release.Namespace, release.Name+".yaml")
+			if writeErr := writeStagingFile(staging, valuesFile, []byte(values.Stdout)); writeErr == nil {
+				entry.ValuesFile = filepath.ToSlash(valuesFile)
+			}
+		}
+
+		manifest.HelmReleases = append(manifest.HelmReleases, entry)
+	}
+}
+
+// captureApplications records the synced revision of each ArgoCD application
+func (s *ClusterService) captureApplications(ctx context.Context, info models.ClusterInfo, manifest *models.SnapshotManifest) {
+	if s.applications == nil {
+		return
+	}
+
+	apps, err := s.applications.ListApplications(ctx, kubeContextName(info))
+	if err != nil {
+		pterm.Warning.Printf("Could not list ArgoCD applications, application revisions are not recorded: %v\n", err)
+		return
+	}
+
+	for _, app := range apps {
+		manifest.Applications = append(manifest.Applications, models.SnapshotApplication{
+			Name:     app.Name,
+			Revision: app.Revision,
+			Health:   app.Health,
+		})
+		if app.Name == chartmodels.RootApplication {
+			manifest.AppOfAppsRevision = app.Revision
+		}
+	}
+}
+
+// RestoreCluster replaces the volume data and datastore of a k3d cluster with a snapshot
+// The snapshot must have been taken from the same cluster; the cluster is started afterwards
+func (s *ClusterService) RestoreCluster(name string, from string) (*models.SnapshotManifest, error) {
+	ctx := context.Background()
+
+	archivePath, err := ResolveSnapshotPath(from)
+	if err != nil {
+		return nil, err
+	}
+
+	staging, err := os.MkdirTemp("", "openframe-restore-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create staging directory: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	if err := extractTarGz(archivePath, staging); err != nil {
+		return nil, fmt.Errorf("failed to read snapshot %s: %w", archivePath, err)
+	}
+
+	manifestData, err := os.ReadFile(filepath.Join(staging, models.SnapshotManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("snapshot %s has no manifest", archivePath)
+	}
+	manifest, err := models.ParseSnapshotManifest(manifestData)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.Cluster.Name != name {
+		return nil, fmt.Errorf("snapshot %s was taken from cluster %s, not %s", manifest.Name, manifest.Cluster.Name, name)
+	}
+
+	info, err := s.GetClusterStatus(name)
+	if err != nil {
+		return nil, err
+	}
+	if info.Type != models.ClusterTypeK3d {
+		return nil, fmt.Errorf("snapshots are only supported for k3d clusters, %s is a %s cluster", name, info.Type)
+	}
+	if err := verifySnapshotNodes(manifest, info, staging); err != nil {
+		return nil, err
+	}
+
+	if !info.IsStopped() {
+		if err := s.StopCluster(name, info.Type); err != nil {
+			return nil, err
+		}
+	}
+
+	spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Restoring %d node volumes...", len(manifest.Nodes)))
+	for _, node := range manifest.Nodes {
+		// The manifest was validated, so the archive is a plain file name in the nodes directory
+		backupDir := filepath.Join(staging, models.SnapshotNodesDir)
+		script := fmt.Sprintf("cd %s && rm -rf %s && tar -xzf %s",
+			shellQuote(k3sDataDir), shellQuoteAll(node.Paths), shellQuote("/backup/"+path.Base(node.Archive)))
+
+		if _, err := s.executor.Execute(ctx, "docker", "run", "--rm",
+			"--volumes-from", node.Name,
+			"-v", backupDir+":/backup:ro",
+			snapshotHelperImage, "sh", "-c", script); err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to restore node %s", node.Name))
+			return nil, fmt.Errorf("failed to restore volumes of node %s: %w", node.Name, err)
+		}
+	}
+	spinner.Success(fmt.Sprintf("Restored %d node volumes", len(manifest.Nodes)))
+
+	if err := s.StartCluster(name, info.Type); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// verifySnapshotNodes checks that every archived node exists in the cluster and its archive is present
+func verifySnapshotNodes(manifest *models.SnapshotManifest, info models.ClusterInfo, staging string) error {
+	existing := make(map[string]bool)
+	for _, node := range info.Nodes {
+		existing[node.Name] = true
+	}
+
+	for _, node := range manifest.Nodes {
+		if !existing[node.Name] {
+			return fmt.Errorf("snapshot node %s does not exist in cluster %s; the cluster topology has changed", node.Name, info.Name)
+		}
+		if _, err := os.Stat(filepath.Join(staging, filepath.FromSlash(node.Archive))); err != nil {
+			return fmt.Errorf("snapshot is missing archive %s", node.Archive)
+		}
+	}
+	return nil
+}
+
+// writeStagingFile writes a file below the staging directory, creating parent directories
+func writeStagingFile(staging, name string, data []byte) error {
+	path := filepath.Join(staging, name)
+	if err := os.MkdirAll(filepath.Dir(path), snapshotDirMode); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, snapshotFileMode)
+}
+
+// writeTarGz packs the contents of a directory into a gzip-compressed tarball
+func writeTarGz(sourceDir, archivePath string) error {
+	file, err := os.OpenFile(archivePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, snapshotFileMode)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	err = filepath.Walk(sourceDir, func(path string, fileInfo os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relative, err := filepath.Rel(sourceDir, path)
+		if err != nil || relative == "." {
+			return err
+		}
+
+		header, err := tar.FileInfoHeader(fileInfo, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relative)
+		header.Mode = int64(snapshotFileMode)
+		if fileInfo.IsDir() {
+			header.Mode = int64(snapshotDirMode)
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		if !fileInfo.Mode().IsRegular() {
+			return nil
+		}
+
+		source, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer source.Close()
+		_, err = io.Copy(tarWriter, source)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// extractTarGz unpacks a gzip-compressed tarball, rejecting entries that escape the target directory
+func extractTarGz(archivePath, targetDir string) error {
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(targetDir, filepath.FromSlash(header.Name))
+		if !strings.HasPrefix(target, filepath.Clean(targetDir)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid entry %s in snapshot", header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, snapshotDirMode); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), snapshotDirMode); err != nil {
+				return err
+			}
+			out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, snapshotFileMode)
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(out, tarReader); err != nil {
+				out.Close()
+				return err
+			}
+			if err := out.Close(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package cluster
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/cluster/providers"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testHelmListOutput = `[{"name":"app-of-apps","namespace":"argocd","revision":"3","chart":"app-of-apps-0.1.0","app_version":"1.0"}]`
+
+// newSnapshotTestService returns a service with a single running k3d cluster named dev
+func newSnapshotTestService(state string) (*ClusterService, *fakeProvider, *executor.MockCommandExecutor) {
+	k3dFake := &fakeProvider{
+		clusterType: models.ClusterTypeK3d,
+		clusters: []models.ClusterInfo{{
+			Name:  "dev",
+			Type:  models.ClusterTypeK3d,
+			State: state,
+			Nodes: []models.NodeInfo{
+				{Name: "k3d-dev-server-0", Role: "server", Status: "running"},
+				{Name: "k3d-dev-agent-0", Role: "agent", Status: "running"},
+				{Name: "k3d-dev-serverlb", Role: "loadbalancer", Status: "running"},
+			},
+		}},
+	}
+
+	registry := providers.NewRegistry()
+	registry.RegisterProvider(models.ClusterTypeK3d, k3dFake)
+
+	mockExec := executor.NewMockCommandExecutor()
+	return NewClusterServiceWithRegistry(mockExec, registry), k3dFake, mockExec
+}
+
+// writeTestSnapshot builds a snapshot archive for cluster dev with one server node
+func writeTestSnapshot(t *testing.T, clusterName string) string {
+	t.Helper()
+
+	staging := t.TempDir()
+	manifest := &models.SnapshotManifest{
+		APIVersion: models.SnapshotAPIVersion,
+		Kind:       models.SnapshotKind,
+		Name:       "seeded",
+		Cluster:    models.SnapshotCluster{Name: clusterName, Type: models.ClusterTypeK3d},
+		Nodes: []models.SnapshotNode{
+			{Name: "k3d-dev-server-0", Role: "server", Archive: "nodes/k3d-dev-server-0.tar.gz", Paths: []string{"storage", "server/db"}},
+		},
+		AppOfAppsRevision: "3f2a9c1d",
+	}
+	data, err := manifest.ToYAML()
+	require.NoError(t, err)
+	require.NoError(t, writeStagingFile(staging, models.SnapshotManifestFile, data))
+	require.NoError(t, writeStagingFile(staging, "nodes/k3d-dev-server-0.tar.gz", []byte("node data")))
+
+	archivePath := filepath.Join(t.TempDir(), "seeded.tar.gz")
+	require.NoError(t, writeTarGz(staging, archivePath))
+	return archivePath
+}
+
+func TestClusterService_SnapshotCluster(t *testing.T) {
+	t.Run("archives node volumes, helm values and application revisions", func(t *testing.T) {
+		service, k3dFake, mockExec := newSnapshotTestService(models.ClusterStateRunning)
+		mockExec.SetResponse("helm --kube-context k3d-dev list", &executor.CommandResult{Stdout: testHelmListOutput})
+		mockExec.SetResponse("get values app-of-apps", &executor.CommandResult{Stdout: "repoRevision: main\n"})
+		service.SetApplicationLister(&fakeApplicationLister{apps: []models.ApplicationStatus{
+			{Name: "argocd-apps", Health: "Healthy", Revision: "3f2a9c1d"},
+			{Name: "mongodb", Health: "Healthy", Revision: "9b0e7d45"},
+		}})
+		directory := filepath.Join(t.TempDir(), "snapshots")
+
+		archivePath, manifest, err := service.SnapshotCluster("dev", SnapshotOptions{Name: "seeded", Directory: directory})
+
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(directory, "seeded.tar.gz"), archivePath)
+		assert.Equal(t, []string{"dev"}, k3dFake.stopped)
+		assert.Equal(t, []string{"dev"}, k3dFake.started)
+
+		require.Len(t, manifest.Nodes, 2)
+		assert.Equal(t, []string{"storage", "server/db"}, manifest.Nodes[0].Paths)
+		assert.Equal(t, []string{"storage"}, manifest.Nodes[1].Paths)
+		assert.True(t, mockExec.WasCommandExecuted("docker run --rm --volumes-from k3d-dev-server-0"))
+		assert.True(t, mockExec.WasCommandExecuted("umask 077 && cd '/var/lib/rancher/k3s'"))
+		assert.True(t, mockExec.WasCommandExecuted("tar -czf '/backup/k3d-dev-agent-0.tar.gz' 'storage'"))
+		assert.False(t, mockExec.WasCommandExecuted("--volumes-from k3d-dev-serverlb"))
+
+		require.Len(t, manifest.HelmReleases, 1)
+		assert.Equal(t, "helm/argocd/app-of-apps.yaml", manifest.HelmReleases[0].ValuesFile)
+		assert.Equal(t, "3f2a9c1d", manifest.AppOfAppsRevision)
+		assert.Len(t, manifest.Applications, 2)
+
+		extracted := t.TempDir()
+		require.NoError(t, extractTarGz(archivePath, extracted))
+		values, err := os.ReadFile(filepath.Join(extracted, "helm", "argocd", "app-of-apps.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "repoRevision: main\n", string(values))
+
+		// The datastore and helm values hold credentials
+		for path, mode := range map[string]os.FileMode{
+			directory:                        0700,
+			archivePath:                      0600,
+			filepath.Join(extracted, "helm"): 0700,
+			filepath.Join(extracted, "helm", "argocd", "app-of-apps.yaml"): 0600,
+			filepath.Join(extracted, models.SnapshotManifestFile):          0600,
+		} {
+			info, err := os.Stat(path)
+			require.NoError(t, err)
+			assert.Equal(t, mode, info.Mode().Perm(), path)
+		}
+		manifestData, err := os.ReadFile(filepath.Join(extracted, models.SnapshotManifestFile))
+		require.NoError(t, err)
+		parsed, err := models.ParseSnapshotManifest(manifestData)
+		require.NoError(t, err)
+		assert.Equal(t, "dev", parsed.Cluster.Name)
+	})
+
+	t.Run("stopped clusters stay stopped", func(t *testing.T) {
+		service, k3dFake, mockExec := newSnapshotTestService(models.ClusterStateStopped)
+
+		_, manifest, err := service.SnapshotCluster("dev", SnapshotOptions{Name: "cold", Directory: t.TempDir()})
+
+		require.NoError(t, err)
+		assert.Empty(t, k3dFake.stopped)
+		assert.Empty(t, k3dFake.started)
+		assert.Empty(t, manifest.HelmReleases)
+		assert.False(t, mockExec.WasCommandExecuted("helm"))
+	})
+
+	t.Run("snapshots without application revisions when ArgoCD cannot be listed", func(t *testing.T) {
+		service, _, _ := newSnapshotTestService(models.ClusterStateRunning)
+		lister := &fakeApplicationLister{err: errors.New("the server could not find the requested resource")}
+		service.SetApplicationLister(lister)
+
+		_, manifest, err := service.SnapshotCluster("dev", SnapshotOptions{Name: "no-argocd", Directory: t.TempDir()})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, lister.calls)
+		assert.Empty(t, manifest.Applications)
+		assert.Empty(t, manifest.AppOfAppsRevision)
+	})
+
+	t.Run("restarts the cluster when archiving fails", func(t *testing.T) {
+		service, k3dFake, mockExec := newSnapshotTestService(models.ClusterStateRunning)
+		mockExec.SetResponse("docker run", &executor.CommandResult{ExitCode: 1, Stderr: "no space left on device"})
+		directory := t.TempDir()
+
+		_, _, err := service.SnapshotCluster("dev", SnapshotOptions{Name: "broken", Directory: directory})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to archive volumes of node k3d-dev-server-0")
+		assert.Equal(t, []string{"dev"}, k3dFake.started)
+		assert.NoFileExists(t, filepath.Join(directory, "broken.tar.gz"))
+	})
+
+	t.Run("refuses to overwrite an existing snapshot", func(t *testing.T) {
+		service, _, _ := newSnapshotTestService(models.ClusterStateRunning)
+		directory := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(directory, "seeded.tar.gz"), nil, 0644))
+
+		_, _, err := service.SnapshotCluster("dev", SnapshotOptions{Name: "seeded", Directory: directory})
+
+		assert.ErrorContains(t, err, "already exists")
+	})
+
+	t.Run("kind clusters are not supported", func(t *testing.T) {
+		service, _, _ := newFakeRegistryService()
+
+		_, _, err := service.SnapshotCluster("beta", SnapshotOptions{Directory: t.TempDir()})
+
+		assert.ErrorContains(t, err, "snapshots are only supported for k3d clusters")
+	})
+}
+
+func TestClusterService_RestoreCluster(t *testing.T) {
+	t.Run("replaces node data and starts the cluster", func(t *testing.T) {
+		service, k3dFake, mockExec := newSnapshotTestService(models.ClusterStateRunning)
+
+		manifest, err := service.RestoreCluster("dev", writeTestSnapshot(t, "dev"))
+
+		require.NoError(t, err)
+		assert.Equal(t, "seeded", manifest.Name)
+		assert.Equal(t, []string{"dev"}, k3dFake.stopped)
+		assert.Equal(t, []string{"dev"}, k3dFake.started)
+		assert.True(t, mockExec.WasCommandExecuted("--volumes-from k3d-dev-server-0"))
+		assert.True(t, mockExec.WasCommandExecuted("rm -rf 'storage' 'server/db' && tar -xzf '/backup/k3d-dev-server-0.tar.gz'"))
+	})
+
+	t.Run("rejects snapshots of other clusters", func(t *testing.T) {
+		service, k3dFake, _ := newSnapshotTestService(models.ClusterStateRunning)
+
+		_, err := service.RestoreCluster("dev", writeTestSnapshot(t, "staging"))
+
+		assert.ErrorContains(t, err, "was taken from cluster staging, not dev")
+		assert.Empty(t, k3dFake.stopped)
+	})
+
+	t.Run("rejects snapshots with unknown nodes", func(t *testing.T) {
+		service, k3dFake, _ := newSnapshotTestService(models.ClusterStateRunning)
+		k3dFake.clusters[0].Nodes = k3dFake.clusters[0].Nodes[1:]
+
+		_, err := service.RestoreCluster("dev", writeTestSnapshot(t, "dev"))
+
+		assert.ErrorContains(t, err, "snapshot node k3d-dev-server-0 does not exist in cluster dev")
+	})
+
+	t.Run("unknown snapshot names fail", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		service, _, _ := newSnapshotTestService(models.ClusterStateRunning)
+
+		_, err := service.RestoreCluster("dev", "missing")
+
+		assert.EqualError(t, err, "snapshot 'missing' not found")
+	})
+}
+
+func TestResolveSnapshotPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	directory := filepath.Join(home, ".config", "openframe", "snapshots")
+	require.NoError(t, os.MkdirAll(directory, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "seeded.tar.gz"), nil, 0644))
+
+	path, err := ResolveSnapshotPath("seeded")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(directory, "seeded.tar.gz"), path)
+
+	explicit := filepath.Join(home, "other.tar.gz")
+	require.NoError(t, os.WriteFile(explicit, nil, 0644))
+	path, err = ResolveSnapshotPath(explicit)
+	require.NoError(t, err)
+	assert.Equal(t, explicit, path)
+
+	_, err = ResolveSnapshotPath("")
+	assert.Error(t, err)
+}
+
+func TestExtractTarGz_RejectsPathTraversal(t *testing.T) {
+	archivePath := filepath.Join(t.TempDir(), "evil.tar.gz")
+	file, err := os.Create(archivePath)
+	require.NoError(t, err)
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+	require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: "../escape", Mode: 0644, Size: 1, Typeflag: tar.TypeReg}))
+	_, err = tarWriter.Write([]byte("x"))
+	require.NoError(t, err)
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	require.NoError(t, file.Close())
+
+	err = extractTarGz(archivePath, t.TempDir())
+
+	assert.ErrorContains(t, err, "invalid entry ../escape")
+}
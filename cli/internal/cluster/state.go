@@ -0,0 +1,205 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/shared/state"
+	"github.com/pterm/pterm"
+)
+
+// SetStateStore overrides where cluster records are kept, mainly for tests
+func (s *ClusterService) SetStateStore(store *state.Store) {
+	s.state = store
+}
+
+// stateStore returns the configured store, falling back to ~/.config/openframe/clusters
+// State is best effort: nil is returned when no home directory is available
+func (s *ClusterService) stateStore() *state.Store {
+	if s.state != nil {
+		return s.state
+	}
+	store, err := state.NewDefaultStore()
+	if err != nil {
+		return nil
+	}
+	return store
+}
+
+// recordClusterCreated replaces any stale record with the configuration and ports of a new cluster
+func (s *ClusterService) recordClusterCreated(config models.ClusterConfig, info models.ClusterInfo) {
+	store := s.stateStore()
+	if store == nil {
+		return
+	}
+
+	servers, agents := info.RoleCounts()
+	if len(info.Nodes) == 0 {
+		servers, agents = config.Servers, config.NodeCount
+	}
+
+	ports := info.Ports.WithDefaults(config.Ports)
+	record := &state.ClusterRecord{
+		Name:          config.Name,
+		Type:          string(config.Type),
+		CreatedAt:     info.CreatedAt,
+		Servers:       servers,
+		Agents:        agents,
+		K8sVersion:    config.K8sVersion,
+		LocalRegistry: config.LocalRegistry,
+		Ports: state.Ports{
+			API:   ports.API,
+			HTTP:  ports.HTTP,
+			HTTPS: ports.HTTPS,
+		},
+	}
+	if err := store.Save(record); err != nil {
+		pterm.Warning.Printf("Failed to save state of cluster %s: %v\n", config.Name, err)
+	}
+}
+
+// forgetCluster removes the record of a deleted cluster
+func (s *ClusterService) forgetCluster(name string) {
+	store := s.stateStore()
+	if store == nil {
+		return
+	}
+	if err := store.Delete(name); err != nil {
+		pterm.Warning.Printf("Failed to remove state of cluster %s: %v\n", name, err)
+	}
+}
+
+// withState fills in what the provider cannot report from the cluster record
+func (s *ClusterService) withState(info models.ClusterInfo) models.ClusterInfo {
+	store := s.stateStore()
+	if store == nil {
+		return info
+	}
+	record, err := store.Load(info.Name)
+	if err != nil {
+		return info
+	}
+	return applyRecord(info, record)
+}
+
+// pruneState drops records of clusters that were deleted outside the CLI
+func (s *ClusterService) pruneState(clusters []models.ClusterInfo) {
+	store := s.stateStore()
+	if store == nil {
+		return
+	}
+	existing := make(map[string]bool, len(clusters))
+	for _, cluster := range clusters {
+		existing[cluster.Name] = true
+	}
+	store.Prune(func(record state.ClusterRecord) bool {
+		// A fresh reservation belongs to a create that is still running
+		return existing[record.Name] || (record.Reserved && !record.IsStaleReservation())
+	})
+}
+
+// leasePorts reserves the host ports of a new cluster so concurrent creates never pick the same ones
+// The returned release function drops the lease when the create fails. Without a usable store the
+// providers fall back to probing for free ports themselves.
+func (s *ClusterService) leasePorts(ctx context.Context, config models.ClusterConfig) (models.ClusterConfig, func(), error) {
+	noop := func() {}
+	store := s.stateStore()
+	if store == nil {
+		return config, noop, nil
+	}
+
+	used := s.clusterPortsInUse(ctx)
+	probe := s.portAvailable
+	if probe == nil {
+		probe = hostPortAvailable
+	}
+
+	requested := state.Ports{API: config.Ports.API, HTTP: config.Ports.HTTP, HTTPS: config.Ports.HTTPS}
+	leased, err := store.LeasePorts(config.Name, string(config.Type), requested, func(port int) bool {
+		return !used[port] && probe(port)
+	})
+	if err != nil {
+		var conflict state.PortConflictError
+		if errors.As(err, &conflict) {
+			return config, nil, fmt.Errorf("failed to allocate ports for cluster %s: %w", config.Name, err)
+		}
+		pterm.Warning.Printf("Failed to lease ports for cluster %s: %v\n", config.Name, err)
+		return config, noop, nil
+	}
+
+	config.Ports = config.Ports.WithDefaults(models.ClusterPorts{API: leased.API, HTTP: leased.HTTP, HTTPS: leased.HTTPS})
+	release := func() {
+		if err := store.ReleasePorts(config.Name); err != nil {
+			pterm.Warning.Printf("Failed to release ports of cluster %s: %v\n", config.Name, err)
+		}
+	}
+	return config, release, nil
+}
+
+// clusterPortsInUse returns the host ports published by every existing cluster, running or stopped
+// Stopped clusters do not hold their ports open but will need them back when started
+func (s *ClusterService) clusterPortsInUse(ctx context.Context) map[int]bool {
+	used := make(map[int]bool)
+	for _, provider := range s.orderedProviders() {
+		clusters, err := provider.List(ctx)
+		if err != nil {
+			continue
+		}
+		for _, cluster := range clusters {
+			for _, port := range []int{cluster.Ports.API, cluster.Ports.HTTP, cluster.Ports.HTTPS} {
+				if port != 0 {
+					used[port] = true
+				}
+			}
+		}
+	}
+	return used
+}
+
+// hostPortAvailable checks if a TCP port can be bound on the host
+func hostPortAvailable(port int) bool {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return false
+	}
+	listener.Close()
+	return true
+}
+
+// applyRecord merges a cluster record into provider-reported cluster info
+// Live provider data always wins; the record only fills gaps
+func applyRecord(info models.ClusterInfo, record *state.ClusterRecord) models.ClusterInfo {
+	if record.Type != "" && record.Type != string(info.Type) {
+		// A record of a different cluster that happened to reuse the name
+		return info
+	}
+
+	if info.CreatedAt.IsZero() {
+		info.CreatedAt = record.CreatedAt
+	}
+	if info.Ports.API == 0 {
+		info.Ports.API = record.Ports.API
+	}
+	if info.Ports.HTTP == 0 {
+		info.Ports.HTTP = record.Ports.HTTP
+	}
+	if info.Ports.HTTPS == 0 {
+		info.Ports.HTTPS = record.Ports.HTTPS
+	}
+	if info.NodeImage == "" {
+		info.NodeImage = record.NodeImage
+	}
+	if record.Install != nil {
+		info.Install = &models.InstallInfo{
+			Repository:  record.Install.Repository,
+			Branch:      record.Install.Branch,
+			IngressMode: record.Install.IngressMode,
+			ValuesFile:  record.Install.ValuesFile,
+			InstalledAt: record.Install.InstalledAt,
+		}
+	}
+	return info
+}
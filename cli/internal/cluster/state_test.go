@@ -0,0 +1,228 @@
+package cluster
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/shared/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMain keeps the state store of every test out of the real home directory
+func TestMain(m *testing.M) {
+	home, err := os.MkdirTemp("", "openframe-cluster-test-")
+	if err != nil {
+		panic(err)
+	}
+	os.Setenv("HOME", home)
+
+	code := m.Run()
+	os.RemoveAll(home)
+	os.Exit(code)
+}
+
+func newStateTestService(t *testing.T) (*ClusterService, *state.Store, *fakeProvider) {
+	t.Helper()
+	service, _, kindFake := newFakeRegistryService()
+	store := state.NewStore(t.TempDir())
+	service.SetStateStore(store)
+	service.portAvailable = func(port int) bool { return true }
+	return service, store, kindFake
+}
+
+func TestClusterService_State(t *testing.T) {
+	t.Run("create records the cluster configuration", func(t *testing.T) {
+		service, store, _ := newStateTestService(t)
+
+		require.NoError(t, service.CreateCluster(models.ClusterConfig{
+			Name:          "gamma",
+			Type:          models.ClusterTypeK3d,
+			Servers:       3,
+			NodeCount:     2,
+			LocalRegistry: true,
+		}))
+
+		record, err := store.Load("gamma")
+		require.NoError(t, err)
+		assert.Equal(t, "k3d", record.Type)
+		assert.Equal(t, 3, record.Servers)
+		assert.Equal(t, 2, record.Agents)
+		assert.True(t, record.LocalRegistry)
+		assert.False(t, record.CreatedAt.IsZero())
+	})
+
+	t.Run("delete forgets the cluster", func(t *testing.T) {
+		service, store, _ := newStateTestService(t)
+		require.NoError(t, store.Save(&state.ClusterRecord{Name: "alpha", Type: "k3d"}))
+
+		require.NoError(t, service.DeleteCluster("alpha", models.ClusterTypeK3d, true))
+
+		_, err := store.Load("alpha")
+		assert.ErrorIs(t, err, state.ErrRecordNotFound)
+	})
+
+	t.Run("status shows the install and recorded ports", func(t *testing.T) {
+		service, store, _ := newStateTestService(t)
+		installedAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+		require.NoError(t, store.Save(&state.ClusterRecord{
+			Name:  "alpha",
+			Type:  "k3d",
+			Ports: state.Ports{API: 6551, HTTP: 20080, HTTPS: 20443},
+			Install: &state.InstallRecord{
+				Branch:      "develop",
+				IngressMode: "ngrok",
+				InstalledAt: installedAt,
+			},
+		}))
+
+		info, err := service.GetClusterStatus("alpha")
+
+		require.NoError(t, err)
+		assert.Equal(t, 6551, info.Ports.API)
+		assert.Equal(t, 20443, info.Ports.HTTPS)
+		require.NotNil(t, info.Install)
+		assert.Equal(t, "develop", info.Install.Branch)
+		assert.Equal(t, "ngrok", info.Install.IngressMode)
+		assert.Equal(t, installedAt, info.Install.InstalledAt)
+	})
+
+	t.Run("list prunes records of clusters deleted outside the CLI", func(t *testing.T) {
+		service, store, _ := newStateTestService(t)
+		require.NoError(t, store.Save(&state.ClusterRecord{Name: "alpha", Type: "k3d"}))
+		require.NoError(t, store.Save(&state.ClusterRecord{Name: "gone", Type: "k3d"}))
+
+		clusters, err := service.ListClusters()
+
+		require.NoError(t, err)
+		assert.Len(t, clusters, 2)
+		assert.False(t, clusters[0].CreatedAt.IsZero())
+		records, err := store.List()
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, "alpha", records[0].Name)
+	})
+
+	t.Run("partial listings keep records", func(t *testing.T) {
+		service, store, kindFake := newStateTestService(t)
+		require.NoError(t, store.Save(&state.ClusterRecord{Name: "gone", Type: "kind"}))
+		kindFake.listErr = assert.AnError
+
+		_, err := service.ListClusters()
+
+		require.NoError(t, err)
+		_, err = store.Load("gone")
+		assert.NoError(t, err)
+	})
+}
+
+func TestApplyRecord(t *testing.T) {
+	live := models.ClusterInfo{
+		Name:  "dev",
+		Type:  models.ClusterTypeK3d,
+		Ports: models.ClusterPorts{API: 6550},
+	}
+	record := &state.ClusterRecord{
+		Name:      "dev",
+		Type:      "k3d",
+		Ports:     state.Ports{API: 7000, HTTP: 20080},
+		NodeImage: "rancher/k3s:v1.32.2-k3s1",
+	}
+
+	merged := applyRecord(live, record)
+	assert.Equal(t, 6550, merged.Ports.API, "live ports win over recorded ones")
+	assert.Equal(t, 20080, merged.Ports.HTTP)
+	assert.Equal(t, "rancher/k3s:v1.32.2-k3s1", merged.NodeImage)
+
+	record.Type = "kind"
+	assert.Equal(t, live, applyRecord(live, record), "records of another cluster type are ignored")
+}
+
+func TestClusterService_PortLeases(t *testing.T) {
+	t.Run("create passes leased ports to the provider and records them", func(t *testing.T) {
+		service, store, kindFake := newStateTestService(t)
+
+		require.NoError(t, service.CreateCluster(models.ClusterConfig{Name: "gamma", Type: models.ClusterTypeKind, NodeCount: 1}))
+
+		require.Len(t, kindFake.created, 1)
+		assert.Equal(t, models.ClusterPorts{API: 6550, HTTP: 80, HTTPS: 443}, kindFake.created[0].Ports)
+		record, err := store.Load("gamma")
+		require.NoError(t, err)
+		assert.False(t, record.Reserved)
+		assert.Equal(t, state.Ports{API: 6550, HTTP: 80, HTTPS: 443}, record.Ports)
+	})
+
+	t.Run("ports of other clusters are skipped", func(t *testing.T) {
+		service, store, kindFake := newStateTestService(t)
+		kindFake.clusters[0].Ports = models.ClusterPorts{API: 6550}
+		require.NoError(t, store.Save(&state.ClusterRecord{Name: "alpha", Type: "k3d", Ports: state.Ports{HTTP: 80, HTTPS: 443}}))
+
+		require.NoError(t, service.CreateCluster(models.ClusterConfig{
+			Name:      "gamma",
+			Type:      models.ClusterTypeKind,
+			NodeCount: 1,
+			Ports:     models.ClusterPorts{HTTPS: 9443},
+		}))
+
+		require.Len(t, kindFake.created, 1)
+		assert.Equal(t, models.ClusterPorts{API: 6551, HTTP: 8080, HTTPS: 9443}, kindFake.created[0].Ports)
+	})
+
+	t.Run("requested port leased to another cluster fails the create", func(t *testing.T) {
+		service, store, kindFake := newStateTestService(t)
+		require.NoError(t, store.Save(&state.ClusterRecord{Name: "alpha", Type: "k3d", Ports: state.Ports{API: 7000}}))
+
+		err := service.CreateCluster(models.ClusterConfig{
+			Name:      "gamma",
+			Type:      models.ClusterTypeKind,
+			NodeCount: 1,
+			Ports:     models.ClusterPorts{API: 7000},
+		})
+
+		assert.ErrorContains(t, err, "port 7000 is already leased to cluster alpha")
+		assert.Empty(t, kindFake.created)
+	})
+
+	t.Run("failed create releases the lease", func(t *testing.T) {
+		service, store, kindFake := newStateTestService(t)
+		kindFake.createErr = assert.AnError
+
+		err := service.CreateCluster(models.ClusterConfig{Name: "gamma", Type: models.ClusterTypeKind, NodeCount: 1})
+
+		assert.ErrorIs(t, err, assert.AnError)
+		_, err = store.Load("gamma")
+		assert.ErrorIs(t, err, state.ErrRecordNotFound)
+	})
+
+	t.Run("list keeps reservations of creates in progress", func(t *testing.T) {
+		service, store, _ := newStateTestService(t)
+		_, err := store.LeasePorts("gamma", "k3d", state.Ports{}, func(port int) bool { return true })
+		require.NoError(t, err)
+
+		_, err = service.ListClusters()
+
+		require.NoError(t, err)
+		_, err = store.Load("gamma")
+		assert.NoError(t, err)
+	})
+}
+
+func TestClusterService_GetClusterPorts(t *testing.T) {
+	service, store, _ := newStateTestService(t)
+	require.NoError(t, store.Save(&state.ClusterRecord{Name: "alpha", Type: "k3d", Ports: state.Ports{API: 6551, HTTP: 8080, HTTPS: 443}}))
+
+	info, mappings, err := service.GetClusterPorts("alpha")
+
+	require.NoError(t, err)
+	assert.Equal(t, "alpha", info.Name)
+	assert.Equal(t, []models.HostPortMapping{
+		{Name: "api", HostPort: 6551, ContainerPort: 6443, URL: "https://0.0.0.0:6551"},
+		{Name: "http", HostPort: 8080, ContainerPort: 80, URL: "http://localhost:8080"},
+		{Name: "https", HostPort: 443, ContainerPort: 443, URL: "https://localhost"},
+	}, mappings)
+
+	_, _, err = service.GetClusterPorts("missing")
+	assert.Error(t, err)
+}
@@ -1,6 +1,8 @@
 package cluster
 
 import (
+	"time"
+
 	"github.com/flamingo/openframe/internal/cluster/models"
 	"github.com/flamingo/openframe/internal/cluster/providers/k3d"
 	"github.com/flamingo/openframe/internal/shared/executor"
@@ -9,12 +11,20 @@ import (
 // FlagContainer holds all flag structures needed by cluster commands
 type FlagContainer struct {
 	// Flag instances
-	Global  *models.GlobalFlags  `json:"global"`
-	Create  *models.CreateFlags  `json:"create"`
-	List    *models.ListFlags    `json:"list"`
-	Status  *models.StatusFlags  `json:"status"`
-	Delete  *models.DeleteFlags  `json:"delete"`
-	Cleanup *models.CleanupFlags `json:"cleanup"`
+	Global     *models.GlobalFlags     `json:"global"`
+	Create     *models.CreateFlags     `json:"create"`
+	List       *models.ListFlags       `json:"list"`
+	Status     *models.StatusFlags     `json:"status"`
+	Delete     *models.DeleteFlags     `json:"delete"`
+	Cleanup    *models.CleanupFlags    `json:"cleanup"`
+	Start      *models.StartFlags      `json:"start"`
+	Stop       *models.StopFlags       `json:"stop"`
+	Restart    *models.StartFlags      `json:"restart"`
+	Snapshot   *models.SnapshotFlags   `json:"snapshot"`
+	Restore    *models.RestoreFlags    `json:"restore"`
+	Kubeconfig *models.KubeconfigFlags `json:"kubeconfig"`
+	Upgrade    *models.UpgradeFlags    `json:"upgrade"`
+	Scale      *models.ScaleFlags      `json:"scale"`
 	
 	// Dependencies for testing and execution
 	Executor    executor.CommandExecutor `json:"-"` // Command executor for external commands
@@ -34,12 +44,20 @@ func (f *FlagContainer) GetExecutor() executor.CommandExecutor {
 // NewFlagContainer creates a new flag container with initialized flags
 func NewFlagContainer() *FlagContainer {
 	return &FlagContainer{
-		Global:  &models.GlobalFlags{},
-		Create:  &models.CreateFlags{ClusterType: "k3d", NodeCount: 3, K8sVersion: "v1.31.5-k3s1"},
-		List:    &models.ListFlags{},
-		Status:  &models.StatusFlags{},
-		Delete:  &models.DeleteFlags{},
-		Cleanup: &models.CleanupFlags{},
+		Global:     &models.GlobalFlags{},
+		Create:     &models.CreateFlags{ClusterType: "k3d", Servers: 1, NodeCount: 3, K8sVersion: "v1.31.5-k3s1"},
+		List:       &models.ListFlags{},
+		Status:     &models.StatusFlags{},
+		Delete:     &models.DeleteFlags{},
+		Cleanup:    &models.CleanupFlags{},
+		Start:      &models.StartFlags{Timeout: 10 * time.Minute},
+		Stop:       &models.StopFlags{},
+		Restart:    &models.StartFlags{Timeout: 10 * time.Minute},
+		Snapshot:   &models.SnapshotFlags{},
+		Restore:    &models.RestoreFlags{Timeout: 10 * time.Minute},
+		Kubeconfig: &models.KubeconfigFlags{},
+		Upgrade:    &models.UpgradeFlags{Timeout: 5 * time.Minute},
+		Scale:      &models.ScaleFlags{Agents: -1, Timeout: 5 * time.Minute},
 	}
 }
 
@@ -51,6 +69,14 @@ func (f *FlagContainer) SyncGlobalFlags() {
 		f.Status.GlobalFlags = *f.Global
 		f.Delete.GlobalFlags = *f.Global
 		f.Cleanup.GlobalFlags = *f.Global
+		f.Start.GlobalFlags = *f.Global
+		f.Stop.GlobalFlags = *f.Global
+		f.Restart.GlobalFlags = *f.Global
+		f.Snapshot.GlobalFlags = *f.Global
+		f.Restore.GlobalFlags = *f.Global
+		f.Kubeconfig.GlobalFlags = *f.Global
+		f.Upgrade.GlobalFlags = *f.Global
+		f.Scale.GlobalFlags = *f.Global
 	}
 }
 
@@ -62,5 +88,13 @@ func (f *FlagContainer) Reset() {
 	f.Status = &models.StatusFlags{}
 	f.Delete = &models.DeleteFlags{}
 	f.Cleanup = &models.CleanupFlags{}
+	f.Start = &models.StartFlags{}
+	f.Stop = &models.StopFlags{}
+	f.Restart = &models.StartFlags{}
+	f.Snapshot = &models.SnapshotFlags{}
+	f.Restore = &models.RestoreFlags{}
+	f.Kubeconfig = &models.KubeconfigFlags{}
+	f.Upgrade = &models.UpgradeFlags{}
+	f.Scale = &models.ScaleFlags{}
 }
 
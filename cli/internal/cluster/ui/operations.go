@@ -214,6 +214,20 @@ func (ui *OperationsUI) ShowOperationStart(operation, clusterName string) {
 		pterm.Info.Printf("Cleaning up cluster '%s'...\n", pterm.Cyan(clusterName))
 	case "delete":
 		pterm.Info.Printf("Deleting cluster '%s'...\n", pterm.Cyan(clusterName))
+	case "start":
+		pterm.Info.Printf("Starting cluster '%s'...\n", pterm.Cyan(clusterName))
+	case "stop":
+		pterm.Info.Printf("Stopping cluster '%s'...\n", pterm.Cyan(clusterName))
+	case "restart":
+		pterm.Info.Printf("Restarting cluster '%s'...\n", pterm.Cyan(clusterName))
+	case "snapshot":
+		pterm.Info.Printf("Creating snapshot of cluster '%s'...\n", pterm.Cyan(clusterName))
+	case "restore":
+		pterm.Info.Printf("Restoring cluster '%s'...\n", pterm.Cyan(clusterName))
+	case "upgrade":
+		pterm.Info.Printf("Upgrading cluster '%s'...\n", pterm.Cyan(clusterName))
+	case "scale":
+		pterm.Info.Printf("Scaling cluster '%s'...\n", pterm.Cyan(clusterName))
 	default:
 		pterm.Info.Printf("Processing '%s' for cluster '%s'...\n", operation, pterm.Cyan(clusterName))
 	}
@@ -264,6 +278,14 @@ func (ui *OperationsUI) ShowOperationSuccess(operation, clusterName string) {
 		pterm.Printf("  Kubeconfig entries cleaned\n")
 		
 		
+	case "start", "restart":
+		pterm.Success.Printf("Cluster '%s' is up and running\n", pterm.Cyan(clusterName))
+		pterm.Printf("  View status: openframe cluster status %s\n", clusterName)
+		
+	case "stop":
+		pterm.Success.Printf("Cluster '%s' stopped\n", pterm.Cyan(clusterName))
+		pterm.Printf("  Start again: openframe cluster start %s\n", clusterName)
+		
 	default:
 		pterm.Success.Printf("Operation '%s' completed for cluster '%s'\n", operation, pterm.Cyan(clusterName))
 	}
@@ -283,6 +305,14 @@ func (ui *OperationsUI) ShowOperationError(operation, clusterName string, err er
 
 
 
+// portOrAuto formats a requested host port, where zero means it is picked at create time
+func portOrAuto(port int) string {
+	if port == 0 {
+		return "auto"
+	}
+	return fmt.Sprintf("%d", port)
+}
+
 // ShowConfigurationSummary displays the cluster configuration summary
 func (ui *OperationsUI) ShowConfigurationSummary(config models.ClusterConfig, dryRun bool, skipWizard bool) {
 	pterm.Info.Printf("Configuration Summary\n")
@@ -291,11 +321,40 @@ func (ui *OperationsUI) ShowConfigurationSummary(config models.ClusterConfig, dr
 	fmt.Printf("   Name: %s\n", pterm.Cyan(config.Name))
 	fmt.Printf("   Type: %s\n", string(config.Type))
 	fmt.Printf("  Nodes: %d\n", config.NodeCount)
+	if config.Servers > 1 && config.Spec == nil {
+		fmt.Printf("Servers: %d (HA, embedded etcd)\n", config.Servers)
+	}
 	
 	if config.K8sVersion != "" {
 		fmt.Printf("Version: %s\n", config.K8sVersion)
 	}
 	
+	if config.LocalRegistry {
+		fmt.Printf("Registry: %s (Docker Hub cache enabled)\n", models.LocalRegistryHost)
+	}
+	
+	if config.Ports != (models.ClusterPorts{}) {
+		fmt.Printf("  Ports: API %s, HTTP %s, HTTPS %s\n",
+			portOrAuto(config.Ports.API), portOrAuto(config.Ports.HTTP), portOrAuto(config.Ports.HTTPS))
+	}
+	
+	if config.Spec != nil {
+		fmt.Printf("Servers: %d\n", config.Spec.Spec.Servers)
+		fmt.Printf(" Agents: %d\n", config.Spec.Spec.Agents)
+		if config.Spec.Spec.Image != "" {
+			fmt.Printf("  Image: %s\n", config.Spec.Spec.Image)
+		}
+		
+		// Show the fully merged spec so it can be reviewed before anything is created
+		if dryRun {
+			if specYAML, err := config.Spec.ToYAML(); err == nil {
+				fmt.Println()
+				pterm.Info.Println("Cluster spec (file merged with flags):")
+				fmt.Print(specYAML)
+			}
+		}
+	}
+	
 	fmt.Println()
 	
 	if dryRun {
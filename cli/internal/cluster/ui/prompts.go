@@ -14,8 +14,9 @@ type ClusterInfo = models.ClusterInfo
 
 // Re-export domain constants for UI convenience
 const (
-	ClusterTypeK3d = models.ClusterTypeK3d
-	ClusterTypeGKE = models.ClusterTypeGKE
+	ClusterTypeK3d  = models.ClusterTypeK3d
+	ClusterTypeKind = models.ClusterTypeKind
+	ClusterTypeGKE  = models.ClusterTypeGKE
 )
 
 // UI should not depend on business logic interfaces
@@ -45,7 +45,7 @@ func (ws *WizardSteps) PromptClusterName(defaultName string) (string, error) {
 func (ws *WizardSteps) PromptClusterType() (models.ClusterType, error) {
 	prompt := promptui.Select{
 		Label: "Cluster Type",
-		Items: []string{"k3d (Recommended for local development)", "gke (Google Kubernetes Engine - Coming Soon)"},
+		Items: []string{"k3d (Recommended for local development)", "kind (Kubernetes in Docker)", "gke (Google Kubernetes Engine - Coming Soon)"},
 		Templates: &promptui.SelectTemplates{
 			Label:    "{{ . }}:",
 			Active:   "→ {{ . | cyan }}",
@@ -63,6 +63,8 @@ func (ws *WizardSteps) PromptClusterType() (models.ClusterType, error) {
 	case 0:
 		return models.ClusterTypeK3d, nil
 	case 1:
+		return models.ClusterTypeKind, nil
+	case 2:
 		return models.ClusterTypeGKE, nil
 	default:
 		return models.ClusterTypeK3d, nil
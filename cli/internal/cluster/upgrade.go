@@ -0,0 +1,447 @@
+package cluster
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/shared/state"
+	"github.com/flamingo/openframe/internal/shared/ui"
+	"github.com/flamingo/openframe/internal/shared/version"
+	"github.com/pterm/pterm"
+)
+
+const (
+	// k3sImageRepository is the image k3d node containers run
+	k3sImageRepository = "rancher/k3s"
+	// k3sBinaryPath is the k3s binary inside every k3d node container; kubectl, crictl and ctr link to it
+	k3sBinaryPath = "/bin/k3s"
+)
+
+// UpgradeOptions controls a rolling k3s upgrade of a cluster
+type UpgradeOptions struct {
+	Version string        // Target k3s release, e.g. v1.32.2-k3s1
+	Force   bool          // Upgrade even when deployed charts do not support the target version
+	DryRun  bool          // Only run the compatibility check
+	Timeout time.Duration // How long to wait for each node to become Ready
+}
+
+// helmReleaseSecret mirrors the fields of a helm release, as stored in its secret, used by the upgrade check
+type helmReleaseSecret struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Chart     struct {
+		Metadata struct {
+			Name        string `json:"name"`
+			Version     string `json:"version"`
+			KubeVersion string `json:"kubeVersion"`
+		} `json:"metadata"`
+	} `json:"chart"`
+}
+
+// PlanClusterUpgrade checks that a running k3d cluster can move to a k3s version
+// Downgrades and skipping minor versions are rejected, as Kubernetes only supports upgrading one
+// minor version at a time. Deployed helm charts whose kubeVersion excludes the target are reported
+// as incompatible.
+func (s *ClusterService) PlanClusterUpgrade(name string, targetVersion string) (*models.UpgradePlan, error) {
+	ctx := context.Background()
+
+	if err := models.ValidateK3sVersion(targetVersion); err != nil {
+		return nil, err
+	}
+	target := version.MustParse(targetVersion)
+
+	info, err := s.GetClusterStatus(name)
+	if err != nil {
+		return nil, err
+	}
+	if info.Type != models.ClusterTypeK3d {
+		return nil, fmt.Errorf("upgrades are only supported for k3d clusters, %s is a %s cluster", name, info.Type)
+	}
+	if info.IsStopped() {
+		return nil, fmt.Errorf("cluster %s is stopped; start it before upgrading", name)
+	}
+	nodes := upgradeOrder(snapshotNodes(info))
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("no node containers found for cluster %s", name)
+	}
+
+	nodeVersions, err := s.nodeVersions(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &models.UpgradePlan{
+		Cluster:   name,
+		ToVersion: targetVersion,
+		Image:     k3sImageRepository + ":" + targetVersion,
+	}
+	var oldest *version.Version
+	for _, node := range nodes {
+		current, ok := nodeVersions[node.Name]
+		if !ok {
+			return nil, fmt.Errorf("node %s is not registered in cluster %s", node.Name, name)
+		}
+		currentVersion, err := version.Parse(current)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the version of node %s: %w", node.Name, err)
+		}
+		if err := checkVersionSkew(currentVersion, target); err != nil {
+			return nil, fmt.Errorf("cannot upgrade node %s from %s to %s: %w", node.Name, current, targetVersion, err)
+		}
+		if oldest == nil || currentVersion.LessThan(*oldest) {
+			oldest = &currentVersion
+			plan.FromVersion = current
+		}
+
+		status := models.NodeUpgradePending
+		if current == models.KubeletVersion(targetVersion) {
+			status = models.NodeUpgradeSkipped
+		}
+		plan.Nodes = append(plan.Nodes, models.NodeUpgradeResult{
+			Name:           node.Name,
+			Role:           node.Role,
+			FromVersion:    current,
+			ToVersion:      models.KubeletVersion(targetVersion),
+			ContainerImage: s.containerImage(ctx, node.Name),
+			Status:         status,
+		})
+	}
+
+	plan.Charts, err = s.chartCompatibility(ctx, info, target)
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// UpgradeCluster rolls every node of a k3d cluster to a new k3s version, one node at a time
+// Servers go first, then agents. Each node container is stopped, given the k3s binary of the target
+// image and started again, so its volumes, networks and k3d entrypoint scripts are kept. The next
+// node is only touched once the previous one is Ready on the new version; the first failure stops
+// the roll. The returned plan carries the result of every node, also when an error is returned.
+// The containers keep referencing the image they were created from, so the image the nodes now run
+// k3s from is recorded in the cluster state and reported by ShowUpgradePlan and cluster status.
+func (s *ClusterService) UpgradeCluster(name string, opts UpgradeOptions) (*models.UpgradePlan, error) {
+	ctx := context.Background()
+
+	plan, err := s.PlanClusterUpgrade(name, opts.Version)
+	if err != nil {
+		return nil, err
+	}
+	if incompatible := plan.IncompatibleCharts(); len(incompatible) > 0 {
+		if !opts.Force {
+			return plan, fmt.Errorf("%d deployed chart(s) do not support Kubernetes %s; use --force to upgrade anyway", len(incompatible), opts.Version)
+		}
+		pterm.Warning.Printf("Upgrading although %d deployed chart(s) do not support Kubernetes %s\n", len(incompatible), opts.Version)
+	}
+	if opts.DryRun || plan.PendingNodes() == 0 {
+		return plan, nil
+	}
+
+	staging, err := os.MkdirTemp("", "openframe-upgrade-")
+	if err != nil {
+		return plan, fmt.Errorf("failed to create staging directory: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	binary, err := s.extractK3sBinary(ctx, plan.Image, staging)
+	if err != nil {
+		return plan, err
+	}
+
+	info := models.ClusterInfo{Name: name, Type: models.ClusterTypeK3d}
+	for i := range plan.Nodes {
+		node := &plan.Nodes[i]
+		if node.Status != models.NodeUpgradePending {
+			continue
+		}
+
+		started := time.Now()
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Upgrading node %s (%d/%d)...", node.Name, i+1, len(plan.Nodes)))
+		err := s.upgradeNode(ctx, info, node.Name, binary, node.ToVersion, time.Now().Add(opts.Timeout))
+		node.Duration = time.Since(started).Round(time.Second)
+		if err != nil {
+			spinner.Fail(fmt.Sprintf("Failed to upgrade node %s", node.Name))
+			node.Status = models.NodeUpgradeFailed
+			node.Error = err.Error()
+			return plan, fmt.Errorf("failed to upgrade node %s: %w", node.Name, err)
+		}
+		spinner.Success(fmt.Sprintf("Node %s running %s", node.Name, node.ToVersion))
+		node.Status = models.NodeUpgradeUpgraded
+	}
+
+	s.recordClusterVersion(name, opts.Version, plan.Image)
+	return plan, nil
+}
+
+// ShowUpgradePlan prints the chart compatibility and the upgrade state of every node
+func ShowUpgradePlan(plan *models.UpgradePlan) {
+	if len(plan.Charts) > 0 {
+		pterm.Info.Printf("Chart compatibility with Kubernetes %s:\n", plan.ToVersion)
+		chartData := pterm.TableData{{"RELEASE", "NAMESPACE", "CHART", "KUBE VERSION", "COMPATIBLE"}}
+		for _, chart := range plan.Charts {
+			compatible := pterm.Green("yes")
+			if !chart.Compatible {
+				compatible = pterm.Red("no")
+			}
+			chartData = append(chartData, []string{chart.Release, chart.Namespace, chart.Chart, valueOrDash(chart.KubeVersion), compatible})
+		}
+		ui.RenderTableWithFallback(chartData, true)
+		fmt.Println()
+	}
+
+	pterm.Info.Printf("Nodes of cluster '%s' (%s -> %s):\n", pterm.Cyan(plan.Cluster), plan.FromVersion, models.KubeletVersion(plan.ToVersion))
+	nodeData := pterm.TableData{{"NODE", "ROLE", "FROM", "TO", "STATUS", "DURATION"}}
+	for _, node := range plan.Nodes {
+		duration := "-"
+		if node.Duration > 0 {
+			duration = node.Duration.String()
+		}
+		nodeData = append(nodeData, []string{node.Name, node.Role, node.FromVersion, node.ToVersion, upgradeStatusText(node.Status), duration})
+	}
+	ui.RenderTableWithFallback(nodeData, true)
+
+	if stale := plan.StaleImageNodes(); len(stale) > 0 {
+		fmt.Println()
+		pterm.Warning.Printf("%d node container(s) still reference %s; they run k3s from %s\n",
+			len(stale), stale[0].ContainerImage, plan.Image)
+		pterm.Printf("  docker and k3d keep showing the original image until the cluster is recreated\n")
+	}
+}
+
+// upgradeStatusText colors a node upgrade state
+func upgradeStatusText(status string) string {
+	switch status {
+	case models.NodeUpgradeUpgraded:
+		return pterm.Green(status)
+	case models.NodeUpgradeFailed:
+		return pterm.Red(status)
+	case models.NodeUpgradeSkipped:
+		return pterm.Gray(status)
+	default:
+		return status
+	}
+}
+
+// extractK3sBinary copies the k3s binary out of the target image into the staging directory
+func (s *ClusterService) extractK3sBinary(ctx context.Context, image string, staging string) (string, error) {
+	spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("Pulling %s...", image))
+
+	result, err := s.executor.Execute(ctx, "docker", "create", image)
+	if err != nil {
+		spinner.Fail(fmt.Sprintf("Failed to pull %s", image))
+		return "", fmt.Errorf("failed to pull %s: %w", image, err)
+	}
+	container := strings.TrimSpace(result.Stdout)
+	defer s.executor.Execute(ctx, "docker", "rm", container)
+
+	binary := filepath.Join(staging, "k3s")
+	if _, err := s.executor.Execute(ctx, "docker", "cp", container+":"+k3sBinaryPath, binary); err != nil {
+		spinner.Fail(fmt.Sprintf("Failed to extract k3s from %s", image))
+		return "", fmt.Errorf("failed to extract k3s from %s: %w", image, err)
+	}
+
+	spinner.Success(fmt.Sprintf("Pulled %s", image))
+	return binary, nil
+}
+
+// containerImage returns the image a container was created from, empty when it cannot be inspected
+func (s *ClusterService) containerImage(ctx context.Context, container string) string {
+	result, err := s.executor.Execute(ctx, "docker", "inspect", "--format", "{{.Config.Image}}", container)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(result.Stdout)
+}
+
+// upgradeNode swaps the k3s binary of a stopped node container and waits for the node to rejoin
+// A node that cannot take the new binary is started again on its old version
+func (s *ClusterService) upgradeNode(ctx context.Context, info models.ClusterInfo, node string, binary string, kubeletVersion string, deadline time.Time) error {
+	if _, err := s.executor.Execute(ctx, "docker", "stop", node); err != nil {
+		return fmt.Errorf("failed to stop node: %w", err)
+	}
+
+	if _, err := s.executor.Execute(ctx, "docker", "cp", binary, node+":"+k3sBinaryPath); err != nil {
+		s.executor.Execute(ctx, "docker", "start", node)
+		return fmt.Errorf("failed to replace the k3s binary: %w", err)
+	}
+
+	if _, err := s.executor.Execute(ctx, "docker", "start", node); err != nil {
+		return fmt.Errorf("failed to start node: %w", err)
+	}
+
+	return s.waitForNodeVersion(ctx, info, node, kubeletVersion, deadline)
+}
+
+// waitForNodeVersion polls the API server until a node is Ready and reports the expected kubelet version
+// Connection errors are expected while a server node restarts and are retried
+func (s *ClusterService) waitForNodeVersion(ctx context.Context, info models.ClusterInfo, node string, kubeletVersion string, deadline time.Time) error {
+	for {
+		result, err := s.executor.Execute(ctx, "kubectl", "--context", kubeContextName(info), "get", "node", node, "--no-headers")
+		current := ""
+		if err == nil {
+			ready, _ := parseNodeReadiness(result.Stdout)
+			if fields := strings.Fields(result.Stdout); len(fields) >= 5 {
+				current = fields[4]
+			}
+			if ready == 1 && current == kubeletVersion {
+				return nil
+			}
+		}
+
+		if !time.Now().Before(deadline) {
+			if err != nil {
+				return fmt.Errorf("timed out waiting for node to become Ready: %w", err)
+			}
+			return fmt.Errorf("timed out waiting for node to become Ready on %s (running %s)", kubeletVersion, valueOrDash(current))
+		}
+		time.Sleep(readinessPollInterval)
+	}
+}
+
+// nodeVersions returns the kubelet version of every registered node
+func (s *ClusterService) nodeVersions(ctx context.Context, info models.ClusterInfo) (map[string]string, error) {
+	result, err := s.executor.Execute(ctx, "kubectl", "--context", kubeContextName(info), "get", "nodes", "--no-headers")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read node versions of cluster %s: %w", info.Name, err)
+	}
+
+	versions := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(result.Stdout), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 5 {
+			versions[fields[0]] = fields[4]
+		}
+	}
+	return versions, nil
+}
+
+// chartCompatibility checks the kubeVersion constraint of every deployed helm chart against the target version
+// Helm keeps the full chart metadata in its release secrets, so no chart repository is needed
+func (s *ClusterService) chartCompatibility(ctx context.Context, info models.ClusterInfo, target version.Version) ([]models.ChartCompatibility, error) {
+	result, err := s.executor.Execute(ctx, "kubectl", "--context", kubeContextName(info),
+		"get", "secrets", "--all-namespaces", "-l", "owner=helm,status=deployed", "-o", "json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployed helm releases: %w", err)
+	}
+
+	var secrets struct {
+		Items []struct {
+			Data struct {
+				Release string `json:"release"`
+			} `json:"data"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal([]byte(result.Stdout), &secrets); err != nil {
+		return nil, fmt.Errorf("failed to parse helm release secrets: %w", err)
+	}
+
+	var charts []models.ChartCompatibility
+	for _, item := range secrets.Items {
+		release, err := decodeHelmRelease(item.Data.Release)
+		if err != nil {
+			pterm.Warning.Printf("Skipping unreadable helm release: %v\n", err)
+			continue
+		}
+
+		metadata := release.Chart.Metadata
+		chart := models.ChartCompatibility{
+			Release:     release.Name,
+			Namespace:   release.Namespace,
+			Chart:       metadata.Name + "-" + metadata.Version,
+			KubeVersion: metadata.KubeVersion,
+			Compatible:  true,
+		}
+		if metadata.KubeVersion != "" {
+			constraint, err := version.ParseConstraint(metadata.KubeVersion)
+			if err != nil {
+				pterm.Warning.Printf("Cannot check chart %s: %v\n", chart.Chart, err)
+			} else {
+				chart.Compatible = constraint.Check(target)
+			}
+		}
+		charts = append(charts, chart)
+	}
+
+	sort.Slice(charts, func(i, j int) bool { return charts[i].Release < charts[j].Release })
+	return charts, nil
+}
+
+// decodeHelmRelease decodes the release field of a helm release secret
+// The Secret API base64-encodes the data, which helm stored as base64 of gzipped JSON
+func decodeHelmRelease(data string) (*helmReleaseSecret, error) {
+	encoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, err
+	}
+	compressed, err := base64.StdEncoding.DecodeString(string(encoded))
+	if err != nil {
+		return nil, err
+	}
+
+	raw := compressed
+	if bytes.HasPrefix(compressed, []byte{0x1f, 0x8b}) {
+		reader, err := gzip.NewReader(bytes.NewReader(compressed))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		if raw, err = io.ReadAll(reader); err != nil {
+			return nil, err
+		}
+	}
+
+	var release helmReleaseSecret
+	if err := json.Unmarshal(raw, &release); err != nil {
+		return nil, err
+	}
+	return &release, nil
+}
+
+// checkVersionSkew rejects downgrades and upgrades that skip a minor version
+func checkVersionSkew(current, target version.Version) error {
+	if target.LessThan(current) {
+		return fmt.Errorf("downgrades are not supported")
+	}
+	if target.Major != current.Major || target.Minor > current.Minor+1 {
+		return fmt.Errorf("upgrades must go one minor version at a time, upgrade to %d.%d first", current.Major, current.Minor+1)
+	}
+	return nil
+}
+
+// upgradeOrder sorts nodes so servers are upgraded before agents, as the control plane must never be
+// older than the kubelets
+func upgradeOrder(nodes []models.NodeInfo) []models.NodeInfo {
+	ordered := append([]models.NodeInfo(nil), nodes...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return models.IsServerRole(ordered[i].Role) && !models.IsServerRole(ordered[j].Role)
+	})
+	return ordered
+}
+
+// recordClusterVersion remembers the k3s version of an upgraded cluster and the image its nodes run k3s from
+func (s *ClusterService) recordClusterVersion(name string, k8sVersion string, nodeImage string) {
+	store := s.stateStore()
+	if store == nil {
+		return
+	}
+	err := store.Update(name, func(record *state.ClusterRecord) {
+		record.Type = string(models.ClusterTypeK3d)
+		record.K8sVersion = k8sVersion
+		record.NodeImage = nodeImage
+	})
+	if err != nil {
+		pterm.Warning.Printf("Failed to save state of cluster %s: %v\n", name, err)
+	}
+}
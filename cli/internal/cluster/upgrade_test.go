@@ -0,0 +1,219 @@
+package cluster
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/state"
+	"github.com/flamingo/openframe/internal/shared/version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// helmReleaseSecrets builds `kubectl get secrets -o json` output for helm releases with the given
+// chart kubeVersion constraints, encoded the way helm and the Secret API store them
+func helmReleaseSecrets(t *testing.T, kubeVersions map[string]string) string {
+	t.Helper()
+
+	var items []string
+	for name, kubeVersion := range kubeVersions {
+		release := fmt.Sprintf(`{"name":%q,"namespace":"argocd","chart":{"metadata":{"name":%q,"version":"1.0.0","kubeVersion":%q}}}`, name, name, kubeVersion)
+		var compressed bytes.Buffer
+		writer := gzip.NewWriter(&compressed)
+		_, err := writer.Write([]byte(release))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		helmEncoded := base64.StdEncoding.EncodeToString(compressed.Bytes())
+		items = append(items, fmt.Sprintf(`{"data":{"release":%q}}`, base64.StdEncoding.EncodeToString([]byte(helmEncoded))))
+	}
+	return `{"items":[` + strings.Join(items, ",") + `]}`
+}
+
+// newUpgradeTestService returns a running k3d cluster dev whose nodes run v1.31.5+k3s1
+// and report v1.32.2+k3s1 once restarted
+func newUpgradeTestService(t *testing.T) (*ClusterService, *executor.MockCommandExecutor, *state.Store) {
+	t.Helper()
+	useFastReadinessPolling(t)
+
+	service, _, mockExec := newSnapshotTestService(models.ClusterStateRunning)
+	store := state.NewStore(t.TempDir())
+	service.SetStateStore(store)
+
+	mockExec.SetResponse("get nodes --no-headers", &executor.CommandResult{Stdout: testReadyNodesOutput})
+	mockExec.SetResponse("get node k3d-dev-server-0", &executor.CommandResult{Stdout: "k3d-dev-server-0   Ready   control-plane,master   3d   v1.32.2+k3s1"})
+	mockExec.SetResponse("get node k3d-dev-agent-0", &executor.CommandResult{Stdout: "k3d-dev-agent-0   Ready   <none>   3d   v1.32.2+k3s1"})
+	mockExec.SetResponse("get secrets", &executor.CommandResult{Stdout: helmReleaseSecrets(t, map[string]string{"argo-cd": ">=1.25.0-0"})})
+	mockExec.SetResponse("docker create", &executor.CommandResult{Stdout: "3f2a9c1d\n"})
+	mockExec.SetResponse("{{.Config.Image}}", &executor.CommandResult{Stdout: "rancher/k3s:v1.31.5-k3s1\n"})
+	return service, mockExec, store
+}
+
+func TestClusterService_PlanClusterUpgrade(t *testing.T) {
+	t.Run("plans servers before agents and checks charts", func(t *testing.T) {
+		service, _, _ := newUpgradeTestService(t)
+
+		plan, err := service.PlanClusterUpgrade("dev", "v1.32.2-k3s1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "v1.31.5+k3s1", plan.FromVersion)
+		assert.Equal(t, "rancher/k3s:v1.32.2-k3s1", plan.Image)
+		require.Len(t, plan.Nodes, 2)
+		assert.Equal(t, "k3d-dev-server-0", plan.Nodes[0].Name)
+		assert.Equal(t, "rancher/k3s:v1.31.5-k3s1", plan.Nodes[0].ContainerImage)
+		assert.Equal(t, models.NodeUpgradePending, plan.Nodes[1].Status)
+		require.Len(t, plan.Charts, 1)
+		assert.Equal(t, "argo-cd-1.0.0", plan.Charts[0].Chart)
+		assert.True(t, plan.Charts[0].Compatible)
+	})
+
+	t.Run("nodes already on the target version are skipped", func(t *testing.T) {
+		service, _, _ := newUpgradeTestService(t)
+
+		plan, err := service.PlanClusterUpgrade("dev", "v1.31.5-k3s1")
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, plan.PendingNodes())
+		assert.Equal(t, models.NodeUpgradeSkipped, plan.Nodes[0].Status)
+	})
+
+	t.Run("rejects downgrades and skipped minor versions", func(t *testing.T) {
+		service, _, _ := newUpgradeTestService(t)
+
+		_, err := service.PlanClusterUpgrade("dev", "v1.30.9-k3s1")
+		assert.ErrorContains(t, err, "downgrades are not supported")
+
+		_, err = service.PlanClusterUpgrade("dev", "v1.33.0-k3s1")
+		assert.ErrorContains(t, err, "upgrade to 1.32 first")
+	})
+
+	t.Run("kind and stopped clusters are not supported", func(t *testing.T) {
+		service, _, _ := newFakeRegistryService()
+		_, err := service.PlanClusterUpgrade("beta", "v1.32.2-k3s1")
+		assert.ErrorContains(t, err, "upgrades are only supported for k3d clusters")
+
+		stopped, _, _ := newSnapshotTestService(models.ClusterStateStopped)
+		_, err = stopped.PlanClusterUpgrade("dev", "v1.32.2-k3s1")
+		assert.EqualError(t, err, "cluster dev is stopped; start it before upgrading")
+	})
+}
+
+func TestClusterService_UpgradeCluster(t *testing.T) {
+	t.Run("rolls each node onto the new k3s binary", func(t *testing.T) {
+		service, mockExec, store := newUpgradeTestService(t)
+
+		plan, err := service.UpgradeCluster("dev", UpgradeOptions{Version: "v1.32.2-k3s1", Timeout: time.Second})
+
+		require.NoError(t, err)
+		for _, node := range plan.Nodes {
+			assert.Equal(t, models.NodeUpgradeUpgraded, node.Status, node.Name)
+		}
+		assert.True(t, mockExec.WasCommandExecuted("docker create rancher/k3s:v1.32.2-k3s1"))
+		assert.True(t, mockExec.WasCommandExecuted("docker cp 3f2a9c1d:/bin/k3s"))
+		assert.True(t, mockExec.WasCommandExecuted("docker rm 3f2a9c1d"))
+		assert.True(t, mockExec.WasCommandExecuted("k3d-dev-agent-0:/bin/k3s"))
+		assert.False(t, mockExec.WasCommandExecuted("docker stop k3d-dev-serverlb"))
+
+		commands := mockExec.GetExecutedCommands()
+		assert.Less(t, commandIndex(commands, "docker start k3d-dev-server-0"), commandIndex(commands, "docker stop k3d-dev-agent-0"),
+			"the agent is only stopped once the server is back")
+
+		assert.Len(t, plan.StaleImageNodes(), 2, "the containers keep their original image")
+
+		record, err := store.Load("dev")
+		require.NoError(t, err)
+		assert.Equal(t, "v1.32.2-k3s1", record.K8sVersion)
+		assert.Equal(t, "rancher/k3s:v1.32.2-k3s1", record.NodeImage)
+	})
+
+	t.Run("incompatible charts block the upgrade unless forced", func(t *testing.T) {
+		service, mockExec, _ := newUpgradeTestService(t)
+		mockExec.SetResponse("get secrets", &executor.CommandResult{Stdout: helmReleaseSecrets(t, map[string]string{"legacy": "<1.32.0-0"})})
+
+		plan, err := service.UpgradeCluster("dev", UpgradeOptions{Version: "v1.32.2-k3s1", Timeout: time.Second})
+
+		assert.ErrorContains(t, err, "1 deployed chart(s) do not support Kubernetes v1.32.2-k3s1")
+		require.NotNil(t, plan)
+		assert.Equal(t, "<1.32.0-0", plan.IncompatibleCharts()[0].KubeVersion)
+		assert.False(t, mockExec.WasCommandExecuted("docker stop"))
+
+		_, err = service.UpgradeCluster("dev", UpgradeOptions{Version: "v1.32.2-k3s1", Timeout: time.Second, Force: true})
+		assert.NoError(t, err)
+	})
+
+	t.Run("dry runs change nothing", func(t *testing.T) {
+		service, mockExec, _ := newUpgradeTestService(t)
+
+		plan, err := service.UpgradeCluster("dev", UpgradeOptions{Version: "v1.32.2-k3s1", DryRun: true, Timeout: time.Second})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, plan.PendingNodes())
+		assert.False(t, mockExec.WasCommandExecuted("docker create"))
+		assert.False(t, mockExec.WasCommandExecuted("docker stop"))
+	})
+
+	t.Run("a node that does not come back stops the roll", func(t *testing.T) {
+		service, mockExec, store := newUpgradeTestService(t)
+		mockExec.SetResponse("get node k3d-dev-server-0", &executor.CommandResult{Stdout: "k3d-dev-server-0   NotReady   control-plane,master   3d   v1.32.2+k3s1"})
+
+		plan, err := service.UpgradeCluster("dev", UpgradeOptions{Version: "v1.32.2-k3s1", Timeout: 10 * time.Millisecond})
+
+		assert.ErrorContains(t, err, "failed to upgrade node k3d-dev-server-0: timed out waiting for node to become Ready")
+		require.NotNil(t, plan)
+		assert.Equal(t, models.NodeUpgradeFailed, plan.Nodes[0].Status)
+		assert.NotEmpty(t, plan.Nodes[0].Error)
+		assert.Equal(t, models.NodeUpgradePending, plan.Nodes[1].Status)
+		assert.False(t, mockExec.WasCommandExecuted("docker stop k3d-dev-agent-0"))
+		_, err = store.Load("dev")
+		assert.ErrorIs(t, err, state.ErrRecordNotFound)
+	})
+
+	t.Run("a failed binary swap restarts the node on its old version", func(t *testing.T) {
+		service, mockExec, _ := newUpgradeTestService(t)
+		mockExec.SetResponse("k3d-dev-server-0:/bin/k3s", &executor.CommandResult{ExitCode: 1, Stderr: "no space left on device"})
+
+		_, err := service.UpgradeCluster("dev", UpgradeOptions{Version: "v1.32.2-k3s1", Timeout: time.Second})
+
+		assert.ErrorContains(t, err, "failed to replace the k3s binary")
+		assert.True(t, mockExec.WasCommandExecuted("docker start k3d-dev-server-0"))
+	})
+}
+
+func TestDecodeHelmRelease(t *testing.T) {
+	// Releases written without compression are plain base64 JSON
+	data := base64.StdEncoding.EncodeToString([]byte(base64.StdEncoding.EncodeToString([]byte(`{"name":"argo-cd","namespace":"argocd"}`))))
+
+	release, err := decodeHelmRelease(data)
+
+	require.NoError(t, err)
+	assert.Equal(t, "argo-cd", release.Name)
+
+	_, err = decodeHelmRelease("not base64!")
+	assert.Error(t, err)
+}
+
+func TestCheckVersionSkew(t *testing.T) {
+	current := version.MustParse("v1.31.5+k3s1")
+
+	assert.NoError(t, checkVersionSkew(current, version.MustParse("1.31.6")))
+	assert.NoError(t, checkVersionSkew(current, version.MustParse("1.32.0")))
+	assert.EqualError(t, checkVersionSkew(current, version.MustParse("1.31.4")), "downgrades are not supported")
+	assert.Error(t, checkVersionSkew(current, version.MustParse("2.0.0")))
+}
+
+// commandIndex returns the position of the first command containing a pattern, or -1
+func commandIndex(commands []string, pattern string) int {
+	for i, command := range commands {
+		if strings.Contains(command, pattern) {
+			return i
+		}
+	}
+	return -1
+}
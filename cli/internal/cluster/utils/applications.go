@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/flamingo/openframe/internal/chart/providers/argocd"
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/shared/executor"
+)
+
+// ArgoCDApplicationLister lists cluster applications using the ArgoCD manager
+type ArgoCDApplicationLister struct {
+	manager *argocd.Manager
+}
+
+// NewArgoCDApplicationLister creates an application lister backed by ArgoCD
+func NewArgoCDApplicationLister(exec executor.CommandExecutor) *ArgoCDApplicationLister {
+	return &ArgoCDApplicationLister{manager: argocd.NewManager(exec)}
+}
+
+// ListApplications returns the ArgoCD applications of a cluster sorted by sync wave
+func (l *ArgoCDApplicationLister) ListApplications(ctx context.Context, kubeContext string) ([]models.ApplicationStatus, error) {
+	apps, err := l.manager.ListApplications(ctx, kubeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]models.ApplicationStatus, 0, len(apps))
+	for _, app := range apps {
+		statuses = append(statuses, models.ApplicationStatus{
+			Name:     app.Name,
+			Sync:     app.Sync,
+			Health:   app.Health,
+			Revision: app.Revision,
+			SyncWave: app.SyncWave,
+			Message:  app.OperationMessage,
+		})
+	}
+
+	return statuses, nil
+}
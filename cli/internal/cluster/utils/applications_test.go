@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArgoCDApplicationLister(t *testing.T) {
+	t.Run("implements ApplicationLister", func(t *testing.T) {
+		var _ models.ApplicationLister = NewArgoCDApplicationLister(executor.NewMockCommandExecutor())
+	})
+
+	t.Run("converts ArgoCD applications", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetResponse("get applications.argoproj.io -o json", &executor.CommandResult{Stdout: `{"items": [
+			{
+				"metadata": {"name": "kafka", "annotations": {"argocd.argoproj.io/sync-wave": "1"}},
+				"status": {
+					"health": {"status": "Degraded"},
+					"sync": {"status": "Synced", "revision": "main"},
+					"operationState": {"message": "sync failed"}
+				}
+			}
+		]}`})
+
+		apps, err := NewArgoCDApplicationLister(mockExec).ListApplications(context.Background(), "k3d-dev")
+
+		require.NoError(t, err)
+		assert.Equal(t, []models.ApplicationStatus{
+			{Name: "kafka", Sync: "Synced", Health: "Degraded", Revision: "main", SyncWave: 1, Message: "sync failed"},
+		}, apps)
+		assert.True(t, apps[0].IsDegraded())
+	})
+
+	t.Run("returns lister errors", func(t *testing.T) {
+		mockExec := executor.NewMockCommandExecutor()
+		mockExec.SetShouldFail(true, "connection refused")
+
+		_, err := NewArgoCDApplicationLister(mockExec).ListApplications(context.Background(), "k3d-dev")
+
+		assert.Error(t, err)
+	})
+}
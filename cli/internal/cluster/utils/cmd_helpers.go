@@ -28,30 +28,30 @@ func InitGlobalFlags() {
 
 // GetCommandService creates a command service for business logic operations
 func GetCommandService() *cluster.ClusterService {
-	// Use injected executor if available (for testing)
-	if globalFlags != nil && globalFlags.Executor != nil {
-		return cluster.NewClusterService(globalFlags.Executor)
-	}
-
-	// Create real executor with current flags
-	dryRun := globalFlags != nil && globalFlags.Global != nil && globalFlags.Global.DryRun
-	verbose := globalFlags != nil && globalFlags.Global != nil && globalFlags.Global.Verbose
-	exec := executor.NewRealCommandExecutor(dryRun, verbose)
-	return cluster.NewClusterService(exec)
+	exec := getCommandExecutor()
+	service := cluster.NewClusterService(exec)
+	service.SetApplicationLister(NewArgoCDApplicationLister(exec))
+	return service
 }
 
 // GetSuppressedCommandService creates a command service with UI suppression for automation
 func GetSuppressedCommandService() *cluster.ClusterService {
-	// Use injected executor if available (for testing)
+	exec := getCommandExecutor()
+	service := cluster.NewClusterServiceSuppressed(exec)
+	service.SetApplicationLister(NewArgoCDApplicationLister(exec))
+	return service
+}
+
+// getCommandExecutor returns the injected executor if available (for testing)
+// or a real executor configured from the current flags
+func getCommandExecutor() executor.CommandExecutor {
 	if globalFlags != nil && globalFlags.Executor != nil {
-		return cluster.NewClusterServiceSuppressed(globalFlags.Executor)
+		return globalFlags.Executor
 	}
 
-	// Create real executor with current flags
 	dryRun := globalFlags != nil && globalFlags.Global != nil && globalFlags.Global.DryRun
 	verbose := globalFlags != nil && globalFlags.Global != nil && globalFlags.Global.Verbose
-	exec := executor.NewRealCommandExecutor(dryRun, verbose)
-	return cluster.NewClusterServiceSuppressed(exec)
+	return executor.NewRealCommandExecutor(dryRun, verbose)
 }
 
 // WrapCommandWithCommonSetup wraps a command function with common CLI setup and error handling
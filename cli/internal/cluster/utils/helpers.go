@@ -30,6 +30,8 @@ func ParseClusterType(typeStr string) models.ClusterType {
 	switch strings.ToLower(typeStr) {
 	case "k3d":
 		return models.ClusterTypeK3d
+	case "kind":
+		return models.ClusterTypeKind
 	case "gke":
 		return models.ClusterTypeGKE
 	default:
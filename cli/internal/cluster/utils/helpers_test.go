@@ -60,6 +60,11 @@ func TestParseClusterType(t *testing.T) {
 		assert.Equal(t, models.ClusterTypeK3d, clusterType)
 	})
 	
+	t.Run("parses kind cluster type", func(t *testing.T) {
+		clusterType := ParseClusterType("kind")
+		assert.Equal(t, models.ClusterTypeKind, clusterType)
+	})
+	
 	t.Run("parses gke cluster type", func(t *testing.T) {
 		clusterType := ParseClusterType("gke")
 		assert.Equal(t, models.ClusterTypeGKE, clusterType)
@@ -6,6 +6,7 @@ import (
 	"github.com/flamingo/openframe/internal/dev/prerequisites/jq"
 	"github.com/flamingo/openframe/internal/dev/prerequisites/scaffold"
 	"github.com/flamingo/openframe/internal/dev/prerequisites/telepresence"
+	"github.com/flamingo/openframe/internal/shared/version"
 )
 
 type PrerequisiteChecker struct {
@@ -17,6 +18,7 @@ type Requirement struct {
 	Command     string
 	IsInstalled func() bool
 	InstallHelp func() string
+	Status      func() version.ToolStatus // Version check of the tool; nil for requirements that are not tools
 }
 
 func NewPrerequisiteChecker() *PrerequisiteChecker {
@@ -25,20 +27,23 @@ func NewPrerequisiteChecker() *PrerequisiteChecker {
 			{
 				Name:        "Telepresence",
 				Command:     "telepresence",
-				IsInstalled: func() bool { return telepresence.NewTelepresenceInstaller().IsInstalled() },
+				IsInstalled: func() bool { return telepresence.NewTelepresenceInstaller().IsSupported() },
 				InstallHelp: func() string { return telepresence.NewTelepresenceInstaller().GetInstallHelp() },
+				Status:      func() version.ToolStatus { return telepresence.NewTelepresenceInstaller().Status() },
 			},
 			{
 				Name:        "jq",
 				Command:     "jq",
-				IsInstalled: func() bool { return jq.NewJqInstaller().IsInstalled() },
+				IsInstalled: func() bool { return jq.NewJqInstaller().IsSupported() },
 				InstallHelp: func() string { return jq.NewJqInstaller().GetInstallHelp() },
+				Status:      func() version.ToolStatus { return jq.NewJqInstaller().Status() },
 			},
 			{
 				Name:        "Skaffold",
 				Command:     "skaffold",
-				IsInstalled: func() bool { return scaffold.NewScaffoldInstaller().IsInstalled() },
+				IsInstalled: func() bool { return scaffold.NewScaffoldInstaller().IsSupported() },
 				InstallHelp: func() string { return scaffold.NewScaffoldInstaller().GetInstallHelp() },
+				Status:      func() version.ToolStatus { return scaffold.NewScaffoldInstaller().Status() },
 			},
 		},
 	}
@@ -73,6 +78,23 @@ func (pc *PrerequisiteChecker) GetInstallInstructions(missingTools []string) []s
 	return instructions
 }
 
+// Requirements returns the prerequisites checked by CheckAll, in check order
+func (pc *PrerequisiteChecker) Requirements() []Requirement {
+	return pc.requirements
+}
+
+// Outdated reports whether a tool returned by CheckAll is installed but too old or too new,
+// so that callers can offer to replace it with the pinned version instead of an install
+func (pc *PrerequisiteChecker) Outdated(tool string) (version.ToolStatus, bool) {
+	for _, req := range pc.requirements {
+		if strings.EqualFold(req.Name, tool) && req.Status != nil {
+			status := req.Status()
+			return status, status.Unsupported()
+		}
+	}
+	return version.ToolStatus{}, false
+}
+
 func CheckPrerequisites() error {
 	installer := NewInstaller()
 	return installer.CheckAndInstall()
@@ -23,6 +23,16 @@ func TestNewPrerequisiteChecker(t *testing.T) {
 	assert.Contains(t, toolNames, "Skaffold")
 }
 
+func TestPrerequisiteChecker_Requirements(t *testing.T) {
+	requirements := NewPrerequisiteChecker().Requirements()
+
+	names := []string{}
+	for _, req := range requirements {
+		names = append(names, req.Name)
+	}
+	assert.Equal(t, []string{"Telepresence", "jq", "Skaffold"}, names)
+}
+
 func TestPrerequisiteChecker_CheckAll(t *testing.T) {
 	checker := NewPrerequisiteChecker()
 	
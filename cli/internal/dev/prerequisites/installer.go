@@ -10,6 +10,7 @@ import (
 	"github.com/flamingo/openframe/internal/dev/prerequisites/scaffold"
 	"github.com/flamingo/openframe/internal/dev/prerequisites/telepresence"
 	"github.com/flamingo/openframe/internal/shared/ui"
+	"github.com/flamingo/openframe/internal/shared/version"
 	"github.com/pterm/pterm"
 )
 
@@ -19,6 +20,8 @@ type Installer struct {
 
 type ToolInstaller interface {
 	IsInstalled() bool
+	IsSupported() bool
+	Status() version.ToolStatus
 	GetInstallHelp() string
 	Install() error
 }
@@ -93,14 +96,14 @@ func (i *Installer) CheckSpecificTools(tools []string) error {
 	
 	for _, tool := range tools {
 		if installer, exists := installers[strings.ToLower(tool)]; exists {
-			if !installer.IsInstalled() {
+			if !installer.IsSupported() {
 				missing = append(missing, tool)
 			}
 		}
 	}
 	
 	if len(missing) > 0 {
-		pterm.Warning.Printf("Missing tools: %s\n", strings.Join(missing, ", "))
+		showMissingAndOutdated(missing, installers)
 		i.showInstallationInstructions(missing)
 		return fmt.Errorf("required tools are not installed: %s", strings.Join(missing, ", "))
 	}
@@ -125,7 +128,7 @@ func (i *Installer) CheckAndInstallSpecificTools(tools []string) error {
 	
 	for _, tool := range tools {
 		if installer, exists := installers[strings.ToLower(tool)]; exists {
-			if !installer.IsInstalled() {
+			if !installer.IsSupported() {
 				missing = append(missing, tool)
 			}
 		}
@@ -136,10 +139,10 @@ func (i *Installer) CheckAndInstallSpecificTools(tools []string) error {
 		return nil
 	}
 
-	pterm.Warning.Printf("Missing Prerequisites: %s\n", strings.Join(missing, ", "))
+	prompt := showMissingAndOutdated(missing, installers)
 	
 	// Ask user if they want to auto-install
-	confirmed, err := ui.ConfirmActionInteractive("Would you like me to install them automatically?", true)
+	confirmed, err := ui.ConfirmActionInteractive(prompt, true)
 	if err != nil {
 		return fmt.Errorf("failed to get user confirmation: %w", err)
 	}
@@ -173,10 +176,16 @@ func (i *Installer) CheckAndInstall() error {
 		return nil
 	}
 
-	pterm.Warning.Printf("Missing Prerequisites: %s\n", strings.Join(missing, ", "))
+	var installers = map[string]ToolInstaller{
+		"telepresence": telepresence.NewTelepresenceInstaller(),
+		"jq":           jq.NewJqInstaller(),
+		"skaffold":     scaffold.NewScaffoldInstaller(),
+	}
+
+	prompt := showMissingAndOutdated(missing, installers)
 	
 	// Ask user if they want to auto-install
-	confirmed, err := ui.ConfirmActionInteractive("Would you like me to install them automatically?", true)
+	confirmed, err := ui.ConfirmActionInteractive(prompt, true)
 	if err != nil {
 		return fmt.Errorf("failed to get user confirmation: %w", err)
 	}
@@ -213,7 +222,7 @@ func checkAndInstallInterceptTools() error {
 	tools := []string{"telepresence", "jq"}
 	for _, tool := range tools {
 		if installer, exists := installers[strings.ToLower(tool)]; exists {
-			if !installer.IsInstalled() {
+			if !installer.IsSupported() {
 				missing = append(missing, tool)
 			}
 		}
@@ -224,10 +233,10 @@ func checkAndInstallInterceptTools() error {
 		return nil
 	}
 
-	pterm.Warning.Printf("Missing Prerequisites: %s\n", strings.Join(missing, ", "))
+	prompt := showMissingAndOutdated(missing, installers)
 	
 	// Ask user if they want to auto-install
-	confirmed, err := ui.ConfirmActionInteractive("Would you like me to install them automatically?", true)
+	confirmed, err := ui.ConfirmActionInteractive(prompt, true)
 	if err != nil {
 		return fmt.Errorf("failed to get user confirmation: %w", err)
 	}
@@ -322,7 +331,7 @@ func checkAndInstallSkaffold() error {
 	tools := []string{"skaffold"}
 	for _, tool := range tools {
 		if installer, exists := installers[strings.ToLower(tool)]; exists {
-			if !installer.IsInstalled() {
+			if !installer.IsSupported() {
 				missing = append(missing, tool)
 			}
 		}
@@ -333,10 +342,10 @@ func checkAndInstallSkaffold() error {
 		return nil
 	}
 
-	pterm.Warning.Printf("Missing Prerequisites: %s\n", strings.Join(missing, ", "))
+	prompt := showMissingAndOutdated(missing, installers)
 	
 	// Ask user if they want to auto-install
-	confirmed, err := ui.ConfirmActionInteractive("Would you like me to install them automatically?", true)
+	confirmed, err := ui.ConfirmActionInteractive(prompt, true)
 	if err != nil {
 		return fmt.Errorf("failed to get user confirmation: %w", err)
 	}
@@ -393,6 +402,34 @@ func showScaffoldInstallationInstructions(missing []string, installers map[strin
 	pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
 }
 
+// showMissingAndOutdated lists tools that are not installed separately from tools in unsupported
+// versions, and returns the prompt that offers to install or upgrade them
+func showMissingAndOutdated(tools []string, installers map[string]ToolInstaller) string {
+	var missing []string
+	var outdated bool
+	for _, tool := range tools {
+		installer, exists := installers[strings.ToLower(tool)]
+		if !exists {
+			missing = append(missing, tool)
+			continue
+		}
+		if status := installer.Status(); status.Unsupported() {
+			pterm.Warning.Printf("Unsupported Prerequisite: %s %s\n", tool, status)
+			outdated = true
+		} else {
+			missing = append(missing, tool)
+		}
+	}
+	if len(missing) > 0 {
+		pterm.Warning.Printf("Missing Prerequisites: %s\n", strings.Join(missing, ", "))
+	}
+
+	if outdated {
+		return "Would you like me to install or upgrade them automatically?"
+	}
+	return "Would you like me to install them automatically?"
+}
+
 // checkClusterAvailability checks if clusters exist for intercept (similar to chart install)
 func checkClusterAvailability() error {
 	clusterService := clusterUtils.GetCommandService()
@@ -6,9 +6,25 @@ import (
 	"os/exec"
 	"runtime"
 	"strings"
+
+	"github.com/flamingo/openframe/internal/shared/localbin"
+	"github.com/flamingo/openframe/internal/shared/version"
 )
 
-type JqInstaller struct{}
+type JqInstaller struct {
+	version.Prerequisite
+}
+
+// tool declares the supported jq versions
+var tool = version.Tool{Command: "jq", VersionArgs: []string{"--version"}, Supported: ">= 1.6.0", Pinned: "1.7.1"}
+
+// localBinary is the pinned jq release installed with --local-tools
+var localBinary = localbin.Binary{
+	Name:    "jq",
+	Version: tool.Pinned,
+	URL:     "https://github.com/jqlang/jq/releases/download/jq-{version}/jq-{os}-{arch}",
+	OSNames: map[string]string{"darwin": "macos"},
+}
 
 func commandExists(cmd string) bool {
 	_, err := exec.LookPath(cmd)
@@ -43,7 +59,7 @@ func jqInstallHelp() string {
 }
 
 func NewJqInstaller() *JqInstaller {
-	return &JqInstaller{}
+	return &JqInstaller{Prerequisite: version.NewPrerequisite(tool, isJqInstalled)}
 }
 
 func (j *JqInstaller) IsInstalled() bool {
@@ -55,6 +71,10 @@ func (j *JqInstaller) GetInstallHelp() string {
 }
 
 func (j *JqInstaller) Install() error {
+	if localbin.Enabled() {
+		return localbin.Install(localBinary)
+	}
+
 	switch runtime.GOOS {
 	case "darwin":
 		return j.installMacOS()
@@ -73,7 +93,7 @@ func (j *JqInstaller) installMacOS() error {
 	}
 
 	fmt.Println("Installing jq via Homebrew...")
-	cmd := exec.Command("brew", "install", "jq")
+	cmd := version.BrewInstallOrUpgrade("jq", "jq")
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	
@@ -167,7 +187,7 @@ func (j *JqInstaller) installLinuxDirect() error {
 		arch = "arm64"
 	}
 	
-	downloadCmd := fmt.Sprintf("sudo curl -L https://github.com/stedolan/jq/releases/latest/download/jq-linux-%s -o /usr/local/bin/jq", arch)
+	downloadCmd := fmt.Sprintf("sudo curl -L https://github.com/jqlang/jq/releases/download/jq-%s/jq-linux-%s -o /usr/local/bin/jq", tool.Pinned, arch)
 	if err := j.runShellCommand(downloadCmd); err != nil {
 		return fmt.Errorf("failed to download jq: %w", err)
 	}
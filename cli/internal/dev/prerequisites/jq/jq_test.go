@@ -3,7 +3,9 @@ package jq
 import (
 	"testing"
 
+	"github.com/flamingo/openframe/internal/shared/version"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewJqInstaller(t *testing.T) {
@@ -105,4 +107,18 @@ func TestJqInstaller_Install(t *testing.T) {
 	// We'll test this by checking it doesn't panic when called, but won't actually install
 	// err := installer.Install()
 	// This would require system changes, so we skip actual execution in tests
-}
\ No newline at end of file
+}
+
+func TestJqInstaller_Tool(t *testing.T) {
+	tool := NewJqInstaller().Tool()
+	assert.Equal(t, "jq", tool.Command)
+
+	constraint, err := version.ParseConstraint(tool.Supported)
+	require.NoError(t, err)
+	assert.True(t, constraint.Check(version.MustParse(tool.Pinned)), "pinned version must be within the supported range")
+}
+
+func TestLocalBinary(t *testing.T) {
+	assert.Equal(t, tool.Pinned, localBinary.Version)
+	assert.NoError(t, localBinary.Validate())
+}
@@ -5,9 +5,24 @@ import (
 	"os/exec"
 	"runtime"
 	"strings"
+
+	"github.com/flamingo/openframe/internal/shared/localbin"
+	"github.com/flamingo/openframe/internal/shared/version"
 )
 
-type ScaffoldInstaller struct{}
+type ScaffoldInstaller struct {
+	version.Prerequisite
+}
+
+// tool declares the supported Skaffold versions
+var tool = version.Tool{Command: "skaffold", VersionArgs: []string{"version"}, Supported: ">= 2.0.0", Pinned: "2.13.2"}
+
+// localBinary is the pinned Skaffold release installed with --local-tools
+var localBinary = localbin.Binary{
+	Name:    "skaffold",
+	Version: tool.Pinned,
+	URL:     "https://storage.googleapis.com/skaffold/releases/v{version}/skaffold-{os}-{arch}",
+}
 
 func commandExists(cmd string) bool {
 	_, err := exec.LookPath(cmd)
@@ -42,7 +57,7 @@ func scaffoldInstallHelp() string {
 }
 
 func NewScaffoldInstaller() *ScaffoldInstaller {
-	return &ScaffoldInstaller{}
+	return &ScaffoldInstaller{Prerequisite: version.NewPrerequisite(tool, isScaffoldInstalled)}
 }
 
 func (s *ScaffoldInstaller) IsInstalled() bool {
@@ -54,6 +69,10 @@ func (s *ScaffoldInstaller) GetInstallHelp() string {
 }
 
 func (s *ScaffoldInstaller) Install() error {
+	if localbin.Enabled() {
+		return localbin.Install(localBinary)
+	}
+
 	switch runtime.GOOS {
 	case "darwin":
 		return s.installMacOS()
@@ -71,7 +90,7 @@ func (s *ScaffoldInstaller) installMacOS() error {
 		return fmt.Errorf("Homebrew is required for automatic Skaffold installation on macOS. Please install brew first: https://brew.sh")
 	}
 
-	cmd := exec.Command("brew", "install", "skaffold")
+	cmd := version.BrewInstallOrUpgrade("skaffold", "skaffold")
 	// Suppress verbose output - only show on error
 	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("failed to install Skaffold: %w", err)
@@ -92,7 +111,7 @@ func (s *ScaffoldInstaller) installLinux() error {
 
 func (s *ScaffoldInstaller) installLinuxCurl() error {
 	// Download and install skaffold using the correct method
-	downloadCmd := `curl -Lo skaffold https://storage.googleapis.com/skaffold/releases/latest/skaffold-linux-amd64 && sudo install skaffold /usr/local/bin/`
+	downloadCmd := fmt.Sprintf(`curl -Lo skaffold https://storage.googleapis.com/skaffold/releases/%s/skaffold-linux-amd64 && sudo install skaffold /usr/local/bin/`, tool.PinnedTag())
 	if err := s.runShellCommand(downloadCmd); err != nil {
 		return fmt.Errorf("failed to download and install Skaffold: %w", err)
 	}
@@ -101,7 +120,7 @@ func (s *ScaffoldInstaller) installLinuxCurl() error {
 
 func (s *ScaffoldInstaller) installLinuxWget() error {
 	// Download and install skaffold using wget with the correct method
-	downloadCmd := `wget -O skaffold https://storage.googleapis.com/skaffold/releases/latest/skaffold-linux-amd64 && sudo install skaffold /usr/local/bin/`
+	downloadCmd := fmt.Sprintf(`wget -O skaffold https://storage.googleapis.com/skaffold/releases/%s/skaffold-linux-amd64 && sudo install skaffold /usr/local/bin/`, tool.PinnedTag())
 	if err := s.runShellCommand(downloadCmd); err != nil {
 		return fmt.Errorf("failed to download and install Skaffold: %w", err)
 	}
@@ -4,7 +4,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/flamingo/openframe/internal/shared/version"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewScaffoldInstaller(t *testing.T) {
@@ -92,4 +94,18 @@ func TestScaffoldInstaller_Install(t *testing.T) {
 	// We'll test this by checking it doesn't panic when called, but won't actually install
 	// err := installer.Install()
 	// This would require system changes, so we skip actual execution in tests
-}
\ No newline at end of file
+}
+
+func TestScaffoldInstaller_Tool(t *testing.T) {
+	tool := NewScaffoldInstaller().Tool()
+	assert.Equal(t, "skaffold", tool.Command)
+
+	constraint, err := version.ParseConstraint(tool.Supported)
+	require.NoError(t, err)
+	assert.True(t, constraint.Check(version.MustParse(tool.Pinned)), "pinned version must be within the supported range")
+}
+
+func TestLocalBinary(t *testing.T) {
+	assert.Equal(t, tool.Pinned, localBinary.Version)
+	assert.NoError(t, localBinary.Validate())
+}
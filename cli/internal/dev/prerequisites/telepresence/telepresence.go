@@ -6,9 +6,24 @@ import (
 	"os/exec"
 	"runtime"
 	"strings"
+
+	"github.com/flamingo/openframe/internal/shared/localbin"
+	"github.com/flamingo/openframe/internal/shared/version"
 )
 
-type TelepresenceInstaller struct{}
+type TelepresenceInstaller struct {
+	version.Prerequisite
+}
+
+// tool declares the supported Telepresence versions
+var tool = version.Tool{Command: "telepresence", VersionArgs: []string{"version"}, Supported: ">= 2.17.0", Pinned: "2.22.4"}
+
+// localBinary is the pinned Telepresence release installed with --local-tools
+var localBinary = localbin.Binary{
+	Name:    "telepresence",
+	Version: tool.Pinned,
+	URL:     "https://github.com/telepresenceio/telepresence/releases/download/v{version}/telepresence-{os}-{arch}",
+}
 
 func commandExists(cmd string) bool {
 	_, err := exec.LookPath(cmd)
@@ -49,7 +64,7 @@ func telepresenceInstallHelp() string {
 }
 
 func NewTelepresenceInstaller() *TelepresenceInstaller {
-	return &TelepresenceInstaller{}
+	return &TelepresenceInstaller{Prerequisite: version.NewPrerequisite(tool, isTelepresenceInstalled)}
 }
 
 func (t *TelepresenceInstaller) IsInstalled() bool {
@@ -63,12 +78,14 @@ func (t *TelepresenceInstaller) GetInstallHelp() string {
 func (t *TelepresenceInstaller) Install() error {
 	var err error
 	
-	switch runtime.GOOS {
-	case "darwin":
+	switch {
+	case localbin.Enabled():
+		err = localbin.Install(localBinary)
+	case runtime.GOOS == "darwin":
 		err = t.installMacOS()
-	case "linux":
+	case runtime.GOOS == "linux":
 		err = t.installLinux()
-	case "windows":
+	case runtime.GOOS == "windows":
 		return fmt.Errorf("automatic Telepresence installation on Windows not supported. Please install from https://www.telepresence.io/docs/latest/install/")
 	default:
 		return fmt.Errorf("automatic Telepresence installation not supported on %s", runtime.GOOS)
@@ -92,7 +109,7 @@ func (t *TelepresenceInstaller) installMacOS() error {
 	}
 
 	// Install silently without showing homebrew output
-	cmd := exec.Command("brew", "install", "telepresenceio/telepresence/telepresence-oss")
+	cmd := version.BrewInstallOrUpgrade("telepresence", "telepresenceio/telepresence/telepresence-oss")
 	// Don't show stdout/stderr - let the spinner handle progress indication
 	
 	if err := cmd.Run(); err != nil {
@@ -117,7 +134,7 @@ func (t *TelepresenceInstaller) installLinux() error {
 
 func (t *TelepresenceInstaller) installLinuxCurl() error {
 	// Download and install telepresence using the correct URL and method
-	downloadCmd := `curl -fsSL https://github.com/telepresenceio/telepresence/releases/download/v2.22.4/telepresence-linux-amd64 -o /usr/local/bin/telepresence && sudo chmod a+x /usr/local/bin/telepresence`
+	downloadCmd := fmt.Sprintf(`curl -fsSL https://github.com/telepresenceio/telepresence/releases/download/%s/telepresence-linux-amd64 -o /usr/local/bin/telepresence && sudo chmod a+x /usr/local/bin/telepresence`, tool.PinnedTag())
 	if err := t.runShellCommand(downloadCmd); err != nil {
 		return fmt.Errorf("failed to download and install Telepresence: %w", err)
 	}
@@ -128,7 +145,7 @@ func (t *TelepresenceInstaller) installLinuxCurl() error {
 
 func (t *TelepresenceInstaller) installLinuxWget() error {
 	// Download and install telepresence using wget with the correct URL
-	downloadCmd := `wget -O /usr/local/bin/telepresence https://github.com/telepresenceio/telepresence/releases/download/v2.22.4/telepresence-linux-amd64 && sudo chmod a+x /usr/local/bin/telepresence`
+	downloadCmd := fmt.Sprintf(`wget -O /usr/local/bin/telepresence https://github.com/telepresenceio/telepresence/releases/download/%s/telepresence-linux-amd64 && sudo chmod a+x /usr/local/bin/telepresence`, tool.PinnedTag())
 	if err := t.runShellCommand(downloadCmd); err != nil {
 		return fmt.Errorf("failed to download and install Telepresence: %w", err)
 	}
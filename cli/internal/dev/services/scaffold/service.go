@@ -12,6 +12,7 @@ import (
 	"syscall"
 	"time"
 
+	clusterModels "github.com/flamingo/openframe/internal/cluster/models"
 	clusterUI "github.com/flamingo/openframe/internal/cluster/ui"
 	clusterUtils "github.com/flamingo/openframe/internal/cluster/utils"
 	"github.com/flamingo/openframe/internal/dev/models"
@@ -20,6 +21,7 @@ import (
 	"github.com/flamingo/openframe/internal/dev/providers/kubectl"
 	"github.com/flamingo/openframe/internal/dev/ui"
 	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/state"
 	"github.com/pterm/pterm"
 )
 
@@ -30,6 +32,8 @@ type Service struct {
 	verbose         bool
 	signalChan      chan os.Signal
 	isRunning       bool
+	defaultRepo     string // Registry skaffold pushes images to, empty to use the skaffold.yaml repos
+	state           *state.Store
 }
 
 // NewService creates a new scaffold service
@@ -43,6 +47,23 @@ func NewService(executor executor.CommandExecutor, verbose bool) *Service {
 	}
 }
 
+// SetStateStore overrides the cluster state store, used by tests
+func (s *Service) SetStateStore(store *state.Store) {
+	s.state = store
+}
+
+// stateStore returns the configured store, falling back to ~/.config/openframe/clusters
+func (s *Service) stateStore() *state.Store {
+	if s.state != nil {
+		return s.state
+	}
+	store, err := state.NewDefaultStore()
+	if err != nil {
+		return nil
+	}
+	return store
+}
+
 // RunScaffoldWorkflow runs the complete scaffold workflow
 func (s *Service) RunScaffoldWorkflow(ctx context.Context, args []string, flags *models.ScaffoldFlags) error {
 	// Prerequisites are checked in PersistentPreRunE, so we can proceed directly
@@ -109,7 +130,7 @@ func (s *Service) RunScaffoldWorkflow(ctx context.Context, args []string, flags
 	}
 
 	// Step 4: Run Skaffold development workflow
-	if err := s.runSkaffoldDev(ctx, selectedService, flags); err != nil {
+	if err := s.runSkaffoldDev(ctx, clusterName, selectedService, flags); err != nil {
 		return fmt.Errorf("skaffold dev failed: %w", err)
 	}
 
@@ -234,7 +255,7 @@ func (s *Service) installCharts(clusterName string, flags *models.ScaffoldFlags)
 }
 
 // runSkaffoldDev runs the Skaffold development workflow with retry logic
-func (s *Service) runSkaffoldDev(ctx context.Context, selectedService *ui.ServiceSelection, flags *models.ScaffoldFlags) error {
+func (s *Service) runSkaffoldDev(ctx context.Context, clusterName string, selectedService *ui.ServiceSelection, flags *models.ScaffoldFlags) error {
 	// Set up signal handling for graceful shutdown
 	s.setupSignalHandler()
 
@@ -258,8 +279,17 @@ func (s *Service) runSkaffoldDev(ctx context.Context, selectedService *ui.Servic
 	s.isRunning = true
 	defer func() { s.isRunning = false }()
 
+	// Push to the shared local registry when clusters were created with --registry
+	s.defaultRepo = s.detectLocalRegistry(ctx, clusterName)
+	if s.defaultRepo != "" {
+		pterm.Info.Printf("Pushing images to local registry %s\n", s.defaultRepo)
+	}
+
 	// Build the full command to run in a shell
 	skaffoldCmd := fmt.Sprintf("cd %s && skaffold dev --cache-artifacts=false -n %s", absDir, namespace)
+	if s.defaultRepo != "" {
+		skaffoldCmd += " --default-repo " + s.defaultRepo
+	}
 
 	// Add verbose flag if enabled
 	if s.verbose {
@@ -324,6 +354,10 @@ func (s *Service) buildSkaffoldArgs(selectedService *ui.ServiceSelection, namesp
 
 	args = append(args, "-n", targetNamespace)
 
+	if s.defaultRepo != "" {
+		args = append(args, "--default-repo", s.defaultRepo)
+	}
+
 	// Add verbose flag if enabled
 	if s.verbose {
 		args = append(args, "--verbosity", "info")
@@ -332,6 +366,24 @@ func (s *Service) buildSkaffoldArgs(selectedService *ui.ServiceSelection, namesp
 	return args
 }
 
+// detectLocalRegistry returns the local registry address when the cluster was created with --registry
+// and the registry container is running. Other clusters cannot pull from the registry.
+func (s *Service) detectLocalRegistry(ctx context.Context, clusterName string) string {
+	store := s.stateStore()
+	if store == nil {
+		return ""
+	}
+	if record, err := store.Load(clusterName); err != nil || !record.LocalRegistry {
+		return ""
+	}
+
+	result, err := s.executor.Execute(ctx, "docker", "inspect", "--format", "{{.State.Running}}", clusterModels.LocalRegistryContainer)
+	if err != nil || strings.TrimSpace(result.Stdout) != "true" {
+		return ""
+	}
+	return clusterModels.LocalRegistryHost
+}
+
 // setupSignalHandler sets up graceful shutdown on SIGINT/SIGTERM
 func (s *Service) setupSignalHandler() {
 	signal.Notify(s.signalChan, os.Interrupt, syscall.SIGTERM)
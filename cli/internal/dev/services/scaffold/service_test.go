@@ -7,8 +7,10 @@ import (
 	"github.com/flamingo/openframe/internal/dev/models"
 	"github.com/flamingo/openframe/internal/dev/ui"
 	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/state"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // MockExecutor for testing
@@ -185,4 +187,57 @@ func TestService_Stop(t *testing.T) {
 	err = service.Stop()
 	assert.NoError(t, err)
 	assert.False(t, service.isRunning)
-}
\ No newline at end of file
+}
+func TestService_BuildSkaffoldArgs_LocalRegistry(t *testing.T) {
+	service := NewService(&MockExecutor{}, false)
+	service.defaultRepo = "k3d-openframe-registry.localhost:5050"
+
+	mockService := &ui.ServiceSelection{ServiceName: "openframe-api"}
+	result := service.buildSkaffoldArgs(mockService, "openframe-api", &models.ScaffoldFlags{})
+
+	expected := []string{"dev", "--cache-artifacts=false", "-n", "openframe-api", "--default-repo", "k3d-openframe-registry.localhost:5050"}
+	assert.Equal(t, expected, result)
+}
+
+func TestService_DetectLocalRegistry(t *testing.T) {
+	inspectArgs := []interface{}{mock.Anything, "docker", "inspect", "--format", "{{.State.Running}}", "k3d-openframe-registry.localhost"}
+
+	newStore := func(t *testing.T) *state.Store {
+		store := state.NewStore(t.TempDir())
+		require.NoError(t, store.Save(&state.ClusterRecord{Name: "with-registry", LocalRegistry: true}))
+		require.NoError(t, store.Save(&state.ClusterRecord{Name: "without-registry"}))
+		return store
+	}
+
+	t.Run("running registry is used as default repo", func(t *testing.T) {
+		exec := &MockExecutor{}
+		exec.On("Execute", inspectArgs...).Return(&executor.CommandResult{Stdout: "true\n"}, nil)
+
+		service := NewService(exec, false)
+		service.SetStateStore(newStore(t))
+
+		assert.Equal(t, "k3d-openframe-registry.localhost:5050", service.detectLocalRegistry(context.Background(), "with-registry"))
+	})
+
+	t.Run("missing registry keeps skaffold.yaml repos", func(t *testing.T) {
+		exec := &MockExecutor{}
+		exec.On("Execute", inspectArgs...).Return(&executor.CommandResult{}, assert.AnError)
+
+		service := NewService(exec, false)
+		service.SetStateStore(newStore(t))
+
+		assert.Empty(t, service.detectLocalRegistry(context.Background(), "with-registry"))
+	})
+
+	t.Run("clusters created without --registry keep skaffold.yaml repos", func(t *testing.T) {
+		exec := &MockExecutor{}
+		exec.On("Execute", inspectArgs...).Return(&executor.CommandResult{Stdout: "true\n"}, nil)
+
+		service := NewService(exec, false)
+		service.SetStateStore(newStore(t))
+
+		assert.Empty(t, service.detectLocalRegistry(context.Background(), "without-registry"))
+		assert.Empty(t, service.detectLocalRegistry(context.Background(), "unknown"))
+		exec.AssertNotCalled(t, "Execute", inspectArgs...)
+	})
+}
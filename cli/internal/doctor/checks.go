@@ -0,0 +1,353 @@
+package doctor
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/flamingo/openframe/internal/chart/providers/argocd"
+	"github.com/flamingo/openframe/internal/cluster/preflight"
+)
+
+// certificateRenewalWindow is how long before expiry the certificate is reported
+const certificateRenewalWindow = 30 * 24 * time.Hour
+
+// defaultHostPorts are the ports new clusters try first, with the create flag that overrides each
+var defaultHostPorts = []struct {
+	port int
+	flag string
+}{
+	{6550, "--api-port"},
+	{80, "--http-port"},
+	{443, "--https-port"},
+}
+
+// checkDocker reports whether the Docker daemon runs and has enough resources for a cluster
+func (s *Service) checkDocker(ctx context.Context) []Check {
+	if !s.hasTool("docker") {
+		return []Check{{Category: CategoryDocker, Name: "Docker daemon", Status: StatusSkipped, Message: "docker is not installed"}}
+	}
+
+	report := preflight.NewChecker(s.executor).Run(ctx)
+	checks := make([]Check, 0, len(report.Results))
+	for _, result := range report.Results {
+		check := Check{Category: CategoryDocker, Name: result.Name, Status: StatusOK, Message: result.Value, Remediation: result.Remediation}
+		switch result.Status {
+		case preflight.StatusWarn:
+			check.Status = StatusWarning
+		case preflight.StatusFail:
+			check.Status = StatusCritical
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// checkCertificates reports whether the mkcert CA is trusted and the ingress certificate is valid
+func (s *Service) checkCertificates(ctx context.Context) []Check {
+	caCheck, ca := s.checkCA(ctx)
+	return []Check{caCheck, s.checkCertificate(ca)}
+}
+
+// checkCA finds the mkcert root CA and verifies it against the system trust store
+// It returns the CA certificate when it could be read, trusted or not.
+func (s *Service) checkCA(ctx context.Context) (Check, *x509.Certificate) {
+	check := Check{Category: CategoryCertificates, Name: "mkcert CA"}
+
+	if !s.hasTool("mkcert") {
+		check.Status = StatusWarning
+		check.Message = "mkcert is not installed"
+		check.Remediation = "Run `openframe chart install`, which installs mkcert and generates the certificates"
+		return check, nil
+	}
+
+	result, err := s.executor.Execute(ctx, "mkcert", "-CAROOT")
+	if err != nil {
+		check.Status = StatusWarning
+		check.Message = fmt.Sprintf("could not locate the CA: %v", err)
+		check.Remediation = "Run `mkcert -install`"
+		return check, nil
+	}
+	caRoot := strings.TrimSpace(result.Stdout)
+
+	ca, err := readCertificate(filepath.Join(caRoot, "rootCA.pem"))
+	if err != nil {
+		check.Status = StatusWarning
+		check.Message = fmt.Sprintf("no CA in %s", caRoot)
+		check.Remediation = "Run `mkcert -install`"
+		return check, nil
+	}
+
+	roots, err := s.systemRoots()
+	if err != nil {
+		check.Status = StatusWarning
+		check.Message = fmt.Sprintf("could not read the system trust store: %v", err)
+		return check, ca
+	}
+	if _, err := ca.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}}); err != nil {
+		check.Status = StatusWarning
+		check.Message = "not trusted by the system; browsers will reject OpenFrame URLs"
+		check.Remediation = "Run `mkcert -install`"
+		return check, ca
+	}
+
+	check.Status = StatusOK
+	check.Message = "trusted (" + caRoot + ")"
+	return check, ca
+}
+
+// checkCertificate checks the localhost certificate used by the ingress
+func (s *Service) checkCertificate(ca *x509.Certificate) Check {
+	check := Check{Category: CategoryCertificates, Name: "Certificate"}
+	certFile := filepath.Join(s.certDir, "localhost.pem")
+	regenerate := fmt.Sprintf("Delete %s and run `openframe chart install` to regenerate it", s.certDir)
+
+	if _, err := os.Stat(certFile); err != nil {
+		check.Status = StatusWarning
+		check.Message = "not generated"
+		check.Remediation = "Run `openframe chart install` to generate it"
+		return check
+	}
+	if _, err := os.Stat(filepath.Join(s.certDir, "localhost-key.pem")); err != nil {
+		check.Status = StatusWarning
+		check.Message = "private key is missing"
+		check.Remediation = regenerate
+		return check
+	}
+
+	cert, err := readCertificate(certFile)
+	if err != nil {
+		check.Status = StatusWarning
+		check.Message = err.Error()
+		check.Remediation = regenerate
+		return check
+	}
+
+	expiry := cert.NotAfter.Format("2006-01-02")
+	now := s.now()
+	switch {
+	case now.After(cert.NotAfter):
+		check.Status = StatusCritical
+		check.Message = "expired on " + expiry
+		check.Remediation = regenerate
+	case cert.NotAfter.Sub(now) < certificateRenewalWindow:
+		check.Status = StatusWarning
+		check.Message = "expires on " + expiry
+		check.Remediation = regenerate
+	case cert.VerifyHostname("localhost") != nil:
+		check.Status = StatusWarning
+		check.Message = "not valid for localhost"
+		check.Remediation = regenerate
+	case ca != nil && cert.CheckSignatureFrom(ca) != nil:
+		check.Status = StatusWarning
+		check.Message = "not issued by the local mkcert CA"
+		check.Remediation = regenerate
+	default:
+		check.Status = StatusOK
+		check.Message = "valid until " + expiry
+	}
+	return check
+}
+
+// readCertificate parses the first certificate of a PEM file
+func readCertificate(path string) (*x509.Certificate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("%s does not contain a certificate", filepath.Base(path))
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filepath.Base(path), err)
+	}
+	return cert, nil
+}
+
+// checkCluster checks the current kube context and, when it is reachable, ArgoCD and telepresence
+func (s *Service) checkCluster(ctx context.Context) []Check {
+	contextCheck := Check{Category: CategoryCluster, Name: "Kube context"}
+	skip := func(reason string) []Check {
+		return []Check{
+			contextCheck,
+			{Category: CategoryCluster, Name: "ArgoCD", Status: StatusSkipped, Message: reason},
+			{Category: CategoryCluster, Name: "Telepresence", Status: StatusSkipped, Message: reason},
+		}
+	}
+
+	if !s.hasTool("kubectl") {
+		contextCheck.Status = StatusSkipped
+		contextCheck.Message = "kubectl is not installed"
+		return skip(contextCheck.Message)
+	}
+
+	result, err := s.executor.Execute(ctx, "kubectl", "config", "current-context")
+	kubeContext := ""
+	if err == nil {
+		kubeContext = firstLine(result.Stdout)
+	}
+	if kubeContext == "" {
+		contextCheck.Status = StatusWarning
+		contextCheck.Message = "no current context"
+		contextCheck.Remediation = "Create a cluster with `openframe cluster create` or select one with `kubectl config use-context`"
+		return skip("no current kube context")
+	}
+
+	if _, err := s.executor.Execute(ctx, "kubectl", "--context", kubeContext, "get", "--raw", "/readyz", "--request-timeout=5s"); err != nil {
+		contextCheck.Status = StatusCritical
+		contextCheck.Message = kubeContext + " is not reachable"
+		if name := strings.TrimPrefix(kubeContext, "k3d-"); name != kubeContext {
+			contextCheck.Remediation = fmt.Sprintf("Start the cluster with `openframe cluster start %s` or switch context with `kubectl config use-context`", name)
+		} else {
+			contextCheck.Remediation = "Check that the cluster is running or switch context with `kubectl config use-context`"
+		}
+		return skip("cluster is not reachable")
+	}
+
+	contextCheck.Status = StatusOK
+	contextCheck.Message = kubeContext + " is reachable"
+	return []Check{contextCheck, s.checkArgoCD(ctx, kubeContext), s.checkTrafficManager(ctx, kubeContext)}
+}
+
+// checkArgoCD reports the health and sync state of the ArgoCD applications
+func (s *Service) checkArgoCD(ctx context.Context, kubeContext string) Check {
+	check := Check{Category: CategoryCluster, Name: "ArgoCD"}
+	install := "Run `openframe chart install`"
+
+	if _, err := s.executor.Execute(ctx, "kubectl", "--context", kubeContext, "get", "namespace", "argocd"); err != nil {
+		check.Status = StatusWarning
+		check.Message = "not installed"
+		check.Remediation = install
+		return check
+	}
+
+	apps, err := argocd.NewManager(s.executor).ListApplications(ctx, kubeContext)
+	if err != nil {
+		check.Status = StatusWarning
+		check.Message = err.Error()
+		return check
+	}
+	if len(apps) == 0 {
+		check.Status = StatusWarning
+		check.Message = "no applications"
+		check.Remediation = install
+		return check
+	}
+
+	var degraded, pending []string
+	for _, app := range apps {
+		switch {
+		case app.Health == "Degraded" || app.Health == "Missing":
+			degraded = append(degraded, app.Name)
+		case app.Health != "Healthy" || app.Sync != "Synced":
+			pending = append(pending, app.Name)
+		}
+	}
+
+	healthy := len(apps) - len(degraded) - len(pending)
+	check.Message = fmt.Sprintf("%d/%d applications healthy and synced", healthy, len(apps))
+	if len(degraded) > 0 {
+		check.Message += "; degraded: " + strings.Join(degraded, ", ")
+	}
+	if len(pending) > 0 {
+		check.Message += "; not ready: " + strings.Join(pending, ", ")
+	}
+
+	switch {
+	case len(degraded) > 0:
+		check.Status = StatusCritical
+		check.Remediation = "Inspect the applications with `openframe cluster status` or `kubectl -n argocd get applications`"
+	case len(pending) > 0:
+		check.Status = StatusWarning
+		check.Remediation = "Applications may still be syncing; run `openframe doctor` again in a few minutes"
+	default:
+		check.Status = StatusOK
+	}
+	return check
+}
+
+// checkTrafficManager reports whether the telepresence traffic-manager needed by `openframe dev intercept` runs
+func (s *Service) checkTrafficManager(ctx context.Context, kubeContext string) Check {
+	check := Check{Category: CategoryCluster, Name: "Telepresence"}
+
+	result, err := s.executor.Execute(ctx, "kubectl", "--context", kubeContext, "get", "deployments",
+		"--all-namespaces", "-l", "app=traffic-manager",
+		"-o", `jsonpath={range .items[*]}{.metadata.namespace}{"\t"}{.status.readyReplicas}{"\n"}{end}`)
+	if err != nil {
+		check.Status = StatusWarning
+		check.Message = fmt.Sprintf("could not be checked: %v", err)
+		return check
+	}
+
+	fields := strings.Fields(firstLine(result.Stdout))
+	switch {
+	case len(fields) == 0:
+		check.Status = StatusWarning
+		check.Message = "traffic-manager is not installed"
+		check.Remediation = "Run `telepresence helm install`; it is needed for `openframe dev intercept`"
+	case len(fields) == 1 || fields[1] == "0":
+		check.Status = StatusWarning
+		check.Message = "traffic-manager is not ready in namespace " + fields[0]
+		check.Remediation = fmt.Sprintf("Check its pods with `kubectl -n %s get pods -l app=traffic-manager`", fields[0])
+	default:
+		check.Status = StatusOK
+		check.Message = "traffic-manager is running in namespace " + fields[0]
+	}
+	return check
+}
+
+// checkPorts reports host ports that keep stopped clusters from starting or push new clusters to alternates
+func (s *Service) checkPorts() []Check {
+	clusters, err := s.listClusters()
+	if err != nil {
+		return []Check{{Category: CategoryPorts, Name: "Host ports", Status: StatusSkipped, Message: err.Error()}}
+	}
+
+	var checks []Check
+	owned := make(map[int]bool)
+	for _, info := range clusters {
+		for _, mapping := range info.Ports.Mappings() {
+			if mapping.HostPort == 0 {
+				continue
+			}
+			owned[mapping.HostPort] = true
+
+			// A running cluster holds its own ports
+			if !info.IsStopped() || !s.portInUse(mapping.HostPort) {
+				continue
+			}
+			checks = append(checks, Check{
+				Category:    CategoryPorts,
+				Name:        fmt.Sprintf("Port %d", mapping.HostPort),
+				Status:      StatusCritical,
+				Message:     fmt.Sprintf("%s port of stopped cluster %s is in use by another process", mapping.Name, info.Name),
+				Remediation: fmt.Sprintf("Stop the process using port %d before `openframe cluster start %s`", mapping.HostPort, info.Name),
+			})
+		}
+	}
+
+	for _, defaultPort := range defaultHostPorts {
+		if owned[defaultPort.port] || !s.portInUse(defaultPort.port) {
+			continue
+		}
+		checks = append(checks, Check{
+			Category:    CategoryPorts,
+			Name:        fmt.Sprintf("Port %d", defaultPort.port),
+			Status:      StatusWarning,
+			Message:     "in use by another process; new clusters will use an alternate port",
+			Remediation: fmt.Sprintf("Free the port, or choose one with `openframe cluster create %s`", defaultPort.flag),
+		})
+	}
+
+	if len(checks) == 0 {
+		checks = append(checks, Check{Category: CategoryPorts, Name: "Host ports", Status: StatusOK, Message: "no conflicts"})
+	}
+	return checks
+}
@@ -0,0 +1,139 @@
+package doctor
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/flamingo/openframe/internal/shared/output"
+	"github.com/flamingo/openframe/internal/shared/ui"
+	"github.com/pterm/pterm"
+)
+
+// ReportKind is the document kind emitted by `openframe doctor --output json|yaml`
+const ReportKind = "DoctorReport"
+
+// Status is the outcome of a single diagnostic check
+type Status string
+
+const (
+	StatusOK       Status = "ok"
+	StatusWarning  Status = "warning"  // Something works poorly or a feature is unavailable
+	StatusCritical Status = "critical" // Clusters or charts cannot be created or used
+	StatusSkipped  Status = "skipped"  // An earlier check failed, so this one could not run
+)
+
+// Categories group the checks in the report
+const (
+	CategoryPrerequisites = "Prerequisites"
+	CategoryDocker        = "Docker"
+	CategoryCertificates  = "Certificates"
+	CategoryCluster       = "Cluster"
+	CategoryPorts         = "Ports"
+)
+
+// Check is the outcome of a single diagnostic check
+type Check struct {
+	Category    string `json:"category"`
+	Name        string `json:"name"`
+	Status      Status `json:"status"`
+	Message     string `json:"message"`
+	Remediation string `json:"remediation,omitempty"`
+}
+
+// Summary counts the checks per status
+type Summary struct {
+	OK       int `json:"ok"`
+	Warnings int `json:"warnings"`
+	Critical int `json:"critical"`
+	Skipped  int `json:"skipped"`
+}
+
+// Report is the result of all diagnostic checks and the machine-readable document of `openframe doctor`
+type Report struct {
+	APIVersion string  `json:"apiVersion"`
+	Kind       string  `json:"kind"`
+	Checks     []Check `json:"checks"`
+	Summary    Summary `json:"summary"`
+}
+
+// NewReport creates an empty report
+func NewReport() *Report {
+	return &Report{APIVersion: output.DocumentAPIVersion, Kind: ReportKind, Checks: []Check{}}
+}
+
+// Add appends checks and updates the summary
+func (r *Report) Add(checks ...Check) {
+	for _, check := range checks {
+		r.Checks = append(r.Checks, check)
+		switch check.Status {
+		case StatusOK:
+			r.Summary.OK++
+		case StatusWarning:
+			r.Summary.Warnings++
+		case StatusCritical:
+			r.Summary.Critical++
+		default:
+			r.Summary.Skipped++
+		}
+	}
+}
+
+// Err returns an error when any check is critical, so that the command exits non-zero
+func (r *Report) Err() error {
+	if r.Summary.Critical == 0 {
+		return nil
+	}
+	return fmt.Errorf("doctor found %d critical problem(s)", r.Summary.Critical)
+}
+
+// ShowReport prints the checks as a table followed by the remediation of every problem
+func ShowReport(report *Report) {
+	tableData := pterm.TableData{{"CATEGORY", "CHECK", "STATUS", "DETAILS"}}
+	for _, check := range report.Checks {
+		tableData = append(tableData, []string{check.Category, check.Name, statusText(check.Status), check.Message})
+	}
+	ui.RenderTableWithFallback(tableData, true)
+
+	var fixes []pterm.BulletListItem
+	for _, check := range report.Checks {
+		if check.Remediation != "" && (check.Status == StatusWarning || check.Status == StatusCritical) {
+			fixes = append(fixes, pterm.BulletListItem{Level: 0, Text: fmt.Sprintf("%s: %s", check.Name, check.Remediation)})
+		}
+	}
+	if len(fixes) > 0 {
+		fmt.Println()
+		pterm.Info.Println("How to fix:")
+		pterm.DefaultBulletList.WithItems(fixes).Render()
+	}
+
+	fmt.Println()
+	summary := fmt.Sprintf("%d ok, %d warning(s), %d critical, %d skipped",
+		report.Summary.OK, report.Summary.Warnings, report.Summary.Critical, report.Summary.Skipped)
+	switch {
+	case report.Summary.Critical > 0:
+		pterm.Error.Println(summary)
+	case report.Summary.Warnings > 0:
+		pterm.Warning.Println(summary)
+	default:
+		pterm.Success.Println(summary)
+	}
+}
+
+// WriteReport writes the report as a machine-readable document
+func WriteReport(w io.Writer, format output.Format, report *Report) error {
+	return output.Write(w, format, report)
+}
+
+// statusText colours a check status for the table
+func statusText(status Status) string {
+	switch status {
+	case StatusOK:
+		return pterm.Green(string(status))
+	case StatusWarning:
+		return pterm.Yellow(string(status))
+	case StatusCritical:
+		return pterm.Red(string(status))
+	default:
+		return pterm.Gray(string(status))
+	}
+}
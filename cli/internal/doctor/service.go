@@ -0,0 +1,178 @@
+package doctor
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	chartPrerequisites "github.com/flamingo/openframe/internal/chart/prerequisites"
+	"github.com/flamingo/openframe/internal/chart/prerequisites/memory"
+	"github.com/flamingo/openframe/internal/cluster"
+	"github.com/flamingo/openframe/internal/cluster/models"
+	clusterPrerequisites "github.com/flamingo/openframe/internal/cluster/prerequisites"
+	devPrerequisites "github.com/flamingo/openframe/internal/dev/prerequisites"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/version"
+)
+
+// Requirement is a prerequisite from the cluster, chart or dev checkers
+type Requirement struct {
+	Name        string
+	Command     string
+	Critical    bool // Cluster and chart prerequisites are critical, dev tools are only needed for `openframe dev`
+	IsInstalled func() bool
+	InstallHelp func() string
+	Status      func() version.ToolStatus // nil for requirements that are not tools
+}
+
+// Service runs the diagnostic checks of `openframe doctor`
+// The host-dependent parts are fields so that tests can replace them.
+type Service struct {
+	executor     executor.CommandExecutor
+	requirements []Requirement
+	lookPath     func(file string) (string, error)
+	portInUse    func(port int) bool
+	systemRoots  func() (*x509.CertPool, error)
+	certDir      string
+	listClusters func() ([]models.ClusterInfo, error)
+	now          func() time.Time
+}
+
+// NewService creates a doctor service that checks the real environment
+func NewService(commandExecutor executor.CommandExecutor) *Service {
+	certDir := ""
+	if home, err := os.UserHomeDir(); err == nil {
+		certDir = filepath.Join(home, ".config", "openframe", "certs")
+	}
+
+	return &Service{
+		executor:     commandExecutor,
+		requirements: DefaultRequirements(),
+		lookPath:     exec.LookPath,
+		portInUse:    hostPortInUse,
+		systemRoots:  x509.SystemCertPool,
+		certDir:      certDir,
+		listClusters: cluster.NewClusterServiceSuppressed(commandExecutor).ListClusters,
+		now:          time.Now,
+	}
+}
+
+// DefaultRequirements collects the prerequisites of the cluster, chart and dev command groups
+func DefaultRequirements() []Requirement {
+	var requirements []Requirement
+	for _, req := range clusterPrerequisites.NewPrerequisiteChecker().Requirements() {
+		requirements = append(requirements, Requirement{req.Name, req.Command, true, req.IsInstalled, req.InstallHelp, req.Status})
+	}
+	for _, req := range chartPrerequisites.NewPrerequisiteChecker().Requirements() {
+		// Host memory is only a recommendation; the memory that limits the cluster is checked with Docker
+		critical := req.Command != "memory"
+		requirements = append(requirements, Requirement{req.Name, req.Command, critical, req.IsInstalled, req.InstallHelp, req.Status})
+	}
+	for _, req := range devPrerequisites.NewPrerequisiteChecker().Requirements() {
+		requirements = append(requirements, Requirement{req.Name, req.Command, false, req.IsInstalled, req.InstallHelp, req.Status})
+	}
+	return requirements
+}
+
+// Run runs every check and returns the report; failing checks never abort the run
+func (s *Service) Run(ctx context.Context) *Report {
+	report := NewReport()
+	report.Add(s.checkPrerequisites(ctx)...)
+	report.Add(s.checkDocker(ctx)...)
+	report.Add(s.checkCertificates(ctx)...)
+	report.Add(s.checkCluster(ctx)...)
+	report.Add(s.checkPorts()...)
+	return report
+}
+
+// checkPrerequisites reports every required tool with its version
+func (s *Service) checkPrerequisites(ctx context.Context) []Check {
+	checks := make([]Check, 0, len(s.requirements))
+	for _, req := range s.requirements {
+		check := Check{Category: CategoryPrerequisites, Name: req.Name, Status: StatusOK}
+
+		if !req.IsInstalled() {
+			check.Status = StatusWarning
+			if req.Critical {
+				check.Status = StatusCritical
+			}
+			var status version.ToolStatus
+			if req.Status != nil {
+				status = req.Status()
+			}
+			// The install help of the checkers repeats the name, which the report already shows
+			check.Remediation = strings.TrimPrefix(req.InstallHelp(), req.Name+": ")
+			switch {
+			case req.Command == "memory":
+				check.Message = "insufficient"
+			case status.State == version.ToolTooOld:
+				check.Message = status.String()
+				check.Remediation = fmt.Sprintf("Upgrade to a version %s, or let openframe upgrade it when prompted. %s", status.Supported, check.Remediation)
+			case status.State == version.ToolTooNew:
+				check.Message = status.String()
+				check.Remediation = fmt.Sprintf("Install a version %s, or let openframe install the pinned version when prompted. %s", status.Supported, check.Remediation)
+			case req.Command == "docker" && s.hasTool("docker"):
+				check.Message = "installed but not running"
+			case s.hasTool(req.Command):
+				check.Message = "installed but not usable"
+			default:
+				check.Message = "missing"
+			}
+			checks = append(checks, check)
+			continue
+		}
+
+		switch {
+		case req.Command == "memory":
+			current, recommended, _ := memory.NewMemoryChecker().GetMemoryInfo()
+			check.Message = fmt.Sprintf("%d MB (%d MB recommended)", current, recommended)
+		case req.Status != nil:
+			// An installed tool that did not report a version is shown as "installed"
+			check.Message = req.Status().String()
+		default:
+			check.Message = "installed"
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// hasTool reports whether a command is on the PATH
+func (s *Service) hasTool(command string) bool {
+	_, err := s.lookPath(command)
+	return err == nil
+}
+
+// hostPortInUse reports whether another process is bound to a TCP port on the host
+func hostPortInUse(port int) bool {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return isAddrInUse(err)
+	}
+	listener.Close()
+	return false
+}
+
+// isAddrInUse tells a port conflict apart from other bind errors, such as the permission
+// error non-root users get for ports below 1024 that Docker can still publish
+func isAddrInUse(err error) bool {
+	return errors.Is(err, syscall.EADDRINUSE)
+}
+
+// firstLine returns the first non-empty line of command output
+func firstLine(s string) string {
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
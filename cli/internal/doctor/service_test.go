@@ -0,0 +1,415 @@
+package doctor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/flamingo/openframe/internal/cluster/models"
+	"github.com/flamingo/openframe/internal/shared/executor"
+	"github.com/flamingo/openframe/internal/shared/version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+const (
+	testDockerInfo = `{"MemTotal":16729960448,"NCPU":8,"DockerRootDir":"/var/lib/docker","OperatingSystem":"Docker Desktop"}`
+	testProbe      = "overlay 61255492 10485760 52428800 17% /\n8192\n1048576\n1048576\n"
+	testApps       = `{"items":[
+		{"metadata":{"name":"api"},"status":{"health":{"status":"Healthy"},"sync":{"status":"Synced"}}},
+		{"metadata":{"name":"kafka"},"status":{"health":{"status":"Degraded"},"sync":{"status":"Synced"}}},
+		{"metadata":{"name":"ui"},"status":{"health":{"status":"Progressing"},"sync":{"status":"OutOfSync"}}}
+	]}`
+)
+
+// newTestService returns a service where every tool is installed and no port is busy
+func newTestService(t *testing.T, exec *executor.MockCommandExecutor) *Service {
+	return &Service{
+		executor:     exec,
+		requirements: nil,
+		lookPath:     func(file string) (string, error) { return "/usr/local/bin/" + file, nil },
+		portInUse:    func(port int) bool { return false },
+		systemRoots:  func() (*x509.CertPool, error) { return x509.NewCertPool(), nil },
+		certDir:      t.TempDir(),
+		listClusters: func() ([]models.ClusterInfo, error) { return nil, nil },
+		now:          func() time.Time { return testNow },
+	}
+}
+
+// checksByName indexes checks by name
+func checksByName(checks []Check) map[string]Check {
+	byName := make(map[string]Check)
+	for _, check := range checks {
+		byName[check.Name] = check
+	}
+	return byName
+}
+
+// testCA is a self-signed CA and a localhost certificate issued by it
+type testCA struct {
+	caRoot string
+	ca     *x509.Certificate
+	key    *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "mkcert test CA"},
+		NotBefore:             testNow.Add(-24 * time.Hour),
+		NotAfter:              testNow.Add(10 * 365 * 24 * time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	caRoot := t.TempDir()
+	writePEM(t, filepath.Join(caRoot, "rootCA.pem"), der)
+	return &testCA{caRoot: caRoot, ca: ca, key: key}
+}
+
+// issue writes a localhost certificate and key valid until notAfter to dir
+func (c *testCA) issue(t *testing.T, dir string, notAfter time.Time) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    testNow.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, c.ca, &key.PublicKey, c.key)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, "localhost.pem"), der)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "localhost-key.pem"), []byte("key"), 0600))
+}
+
+func writePEM(t *testing.T, path string, der []byte) {
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644))
+}
+
+func TestReport(t *testing.T) {
+	report := NewReport()
+	assert.NoError(t, report.Err())
+
+	report.Add(
+		Check{Name: "a", Status: StatusOK},
+		Check{Name: "b", Status: StatusWarning},
+		Check{Name: "c", Status: StatusCritical},
+		Check{Name: "d", Status: StatusSkipped},
+		Check{Name: "e", Status: StatusCritical},
+	)
+
+	assert.Equal(t, Summary{OK: 1, Warnings: 1, Critical: 2, Skipped: 1}, report.Summary)
+	assert.EqualError(t, report.Err(), "doctor found 2 critical problem(s)")
+	assert.Equal(t, ReportKind, report.Kind)
+}
+
+func TestService_CheckPrerequisites(t *testing.T) {
+	service := newTestService(t, executor.NewMockCommandExecutor())
+	service.lookPath = func(file string) (string, error) {
+		if file == "kubectl" {
+			return "/usr/local/bin/kubectl", nil
+		}
+		return "", errors.New("not found")
+	}
+	service.requirements = []Requirement{
+		{Name: "kubectl", Command: "kubectl", Critical: true, IsInstalled: func() bool { return true }, InstallHelp: func() string { return "" },
+			Status: func() version.ToolStatus {
+				return version.ToolStatus{State: version.ToolSupported, Version: version.MustParse("1.31.2"), Supported: ">= 1.27.0"}
+			}},
+		{Name: "k3d", Command: "k3d", Critical: true, IsInstalled: func() bool { return false }, InstallHelp: func() string { return "k3d: install k3d" }},
+		{Name: "kind", Command: "kind", Critical: true, IsInstalled: func() bool { return false }, InstallHelp: func() string { return "kind: install kind" },
+			Status: func() version.ToolStatus {
+				return version.ToolStatus{State: version.ToolTooNew, Version: version.MustParse("1.0.0"), Supported: ">= 0.20.0 < 1.0.0"}
+			}},
+		{Name: "jq", Command: "jq", Critical: false, IsInstalled: func() bool { return false }, InstallHelp: func() string { return "install jq" }},
+		{Name: "Helm", Command: "helm", Critical: true, IsInstalled: func() bool { return false }, InstallHelp: func() string { return "Helm: install helm" },
+			Status: func() version.ToolStatus {
+				return version.ToolStatus{State: version.ToolTooOld, Version: version.MustParse("3.8.1"), Supported: ">= 3.12.0"}
+			}},
+		{Name: "Certificates", Command: "certificates", Critical: true, IsInstalled: func() bool { return true }, InstallHelp: func() string { return "" }},
+	}
+
+	checks := checksByName(service.checkPrerequisites(context.Background()))
+
+	assert.Equal(t, Check{Category: CategoryPrerequisites, Name: "kubectl", Status: StatusOK, Message: "v1.31.2"}, checks["kubectl"])
+	assert.Equal(t, Check{Category: CategoryPrerequisites, Name: "k3d", Status: StatusCritical, Message: "missing", Remediation: "install k3d"}, checks["k3d"])
+	assert.Equal(t, StatusWarning, checks["jq"].Status, "dev tools are not critical")
+	assert.Equal(t, Check{Category: CategoryPrerequisites, Name: "Helm", Status: StatusCritical,
+		Message:     "v3.8.1 installed but too old, requires >= 3.12.0",
+		Remediation: "Upgrade to a version >= 3.12.0, or let openframe upgrade it when prompted. install helm"}, checks["Helm"])
+	assert.Equal(t, Check{Category: CategoryPrerequisites, Name: "kind", Status: StatusCritical,
+		Message:     "v1.0.0 installed but too new, requires >= 0.20.0 < 1.0.0",
+		Remediation: "Install a version >= 0.20.0 < 1.0.0, or let openframe install the pinned version when prompted. install kind"}, checks["kind"])
+	assert.Equal(t, "installed", checks["Certificates"].Message)
+}
+
+func TestService_CheckDocker(t *testing.T) {
+	t.Run("maps preflight results", func(t *testing.T) {
+		exec := executor.NewMockCommandExecutor()
+		exec.SetResponse("docker info", &executor.CommandResult{Stdout: `{"MemTotal":4294967296,"NCPU":8}`})
+		exec.SetResponse("docker run", &executor.CommandResult{Stdout: testProbe})
+
+		checks := checksByName(newTestService(t, exec).checkDocker(context.Background()))
+
+		assert.Equal(t, StatusCritical, checks["Docker memory"].Status)
+		assert.Equal(t, StatusOK, checks["Docker CPUs"].Status)
+		assert.Equal(t, CategoryDocker, checks["Docker disk"].Category)
+	})
+
+	t.Run("skips without docker", func(t *testing.T) {
+		exec := executor.NewMockCommandExecutor()
+		service := newTestService(t, exec)
+		service.lookPath = func(file string) (string, error) { return "", errors.New("not found") }
+
+		checks := service.checkDocker(context.Background())
+
+		require.Len(t, checks, 1)
+		assert.Equal(t, StatusSkipped, checks[0].Status)
+		assert.False(t, exec.WasCommandExecuted("docker info"))
+	})
+}
+
+func TestService_CheckCertificates(t *testing.T) {
+	ca := newTestCA(t)
+	trusted := func() (*x509.CertPool, error) {
+		pool := x509.NewCertPool()
+		pool.AddCert(ca.ca)
+		return pool, nil
+	}
+	newService := func(t *testing.T) *Service {
+		exec := executor.NewMockCommandExecutor()
+		exec.SetResponse("mkcert -CAROOT", &executor.CommandResult{Stdout: ca.caRoot + "\n"})
+		service := newTestService(t, exec)
+		service.systemRoots = trusted
+		return service
+	}
+
+	t.Run("trusted CA and valid certificate", func(t *testing.T) {
+		service := newService(t)
+		ca.issue(t, service.certDir, testNow.Add(365*24*time.Hour))
+
+		checks := checksByName(service.checkCertificates(context.Background()))
+
+		assert.Equal(t, StatusOK, checks["mkcert CA"].Status)
+		assert.Equal(t, StatusOK, checks["Certificate"].Status)
+		assert.Equal(t, "valid until 2027-03-01", checks["Certificate"].Message)
+	})
+
+	t.Run("untrusted CA", func(t *testing.T) {
+		service := newService(t)
+		service.systemRoots = func() (*x509.CertPool, error) { return x509.NewCertPool(), nil }
+		ca.issue(t, service.certDir, testNow.Add(365*24*time.Hour))
+
+		checks := checksByName(service.checkCertificates(context.Background()))
+
+		assert.Equal(t, StatusWarning, checks["mkcert CA"].Status)
+		assert.Equal(t, "Run `mkcert -install`", checks["mkcert CA"].Remediation)
+		assert.Equal(t, StatusOK, checks["Certificate"].Status, "the certificate is still issued by the CA")
+	})
+
+	t.Run("expired certificate", func(t *testing.T) {
+		service := newService(t)
+		ca.issue(t, service.certDir, testNow.Add(-time.Hour))
+
+		check := checksByName(service.checkCertificates(context.Background()))["Certificate"]
+
+		assert.Equal(t, StatusCritical, check.Status)
+		assert.Equal(t, "expired on 2026-03-01", check.Message)
+		assert.Contains(t, check.Remediation, "openframe chart install")
+	})
+
+	t.Run("certificate about to expire", func(t *testing.T) {
+		service := newService(t)
+		ca.issue(t, service.certDir, testNow.Add(7*24*time.Hour))
+
+		check := checksByName(service.checkCertificates(context.Background()))["Certificate"]
+
+		assert.Equal(t, StatusWarning, check.Status)
+		assert.Equal(t, "expires on 2026-03-08", check.Message)
+	})
+
+	t.Run("certificate from another CA", func(t *testing.T) {
+		service := newService(t)
+		newTestCA(t).issue(t, service.certDir, testNow.Add(365*24*time.Hour))
+
+		check := checksByName(service.checkCertificates(context.Background()))["Certificate"]
+
+		assert.Equal(t, StatusWarning, check.Status)
+		assert.Equal(t, "not issued by the local mkcert CA", check.Message)
+	})
+
+	t.Run("nothing generated", func(t *testing.T) {
+		exec := executor.NewMockCommandExecutor()
+		service := newTestService(t, exec)
+		service.lookPath = func(file string) (string, error) { return "", errors.New("not found") }
+
+		checks := checksByName(service.checkCertificates(context.Background()))
+
+		assert.Equal(t, "mkcert is not installed", checks["mkcert CA"].Message)
+		assert.Equal(t, "not generated", checks["Certificate"].Message)
+		assert.Equal(t, StatusWarning, checks["Certificate"].Status)
+	})
+}
+
+func TestService_CheckCluster(t *testing.T) {
+	t.Run("reachable cluster", func(t *testing.T) {
+		exec := executor.NewMockCommandExecutor()
+		exec.SetResponse("config current-context", &executor.CommandResult{Stdout: "k3d-dev\n"})
+		exec.SetResponse("get applications.argoproj.io", &executor.CommandResult{Stdout: testApps})
+		exec.SetResponse("app=traffic-manager", &executor.CommandResult{Stdout: "ambassador\t1\n"})
+
+		checks := checksByName(newTestService(t, exec).checkCluster(context.Background()))
+
+		assert.Equal(t, Check{Category: CategoryCluster, Name: "Kube context", Status: StatusOK, Message: "k3d-dev is reachable"}, checks["Kube context"])
+		assert.Equal(t, StatusCritical, checks["ArgoCD"].Status)
+		assert.Equal(t, "1/3 applications healthy and synced; degraded: kafka; not ready: ui", checks["ArgoCD"].Message)
+		assert.Equal(t, StatusOK, checks["Telepresence"].Status)
+		assert.Equal(t, "traffic-manager is running in namespace ambassador", checks["Telepresence"].Message)
+		assert.True(t, exec.WasCommandExecuted("kubectl --context k3d-dev get --raw /readyz"))
+	})
+
+	t.Run("without ArgoCD and telepresence", func(t *testing.T) {
+		exec := executor.NewMockCommandExecutor()
+		exec.SetResponse("config current-context", &executor.CommandResult{Stdout: "k3d-dev\n"})
+		exec.SetResponse("get namespace argocd", &executor.CommandResult{ExitCode: 1})
+		exec.SetResponse("app=traffic-manager", &executor.CommandResult{Stdout: ""})
+
+		checks := checksByName(newTestService(t, exec).checkCluster(context.Background()))
+
+		assert.Equal(t, StatusWarning, checks["ArgoCD"].Status)
+		assert.Equal(t, "not installed", checks["ArgoCD"].Message)
+		assert.Equal(t, StatusWarning, checks["Telepresence"].Status)
+		assert.Contains(t, checks["Telepresence"].Remediation, "telepresence helm install")
+	})
+
+	t.Run("unreachable cluster", func(t *testing.T) {
+		exec := executor.NewMockCommandExecutor()
+		exec.SetResponse("config current-context", &executor.CommandResult{Stdout: "k3d-dev\n"})
+		exec.SetResponse("/readyz", &executor.CommandResult{ExitCode: 1})
+
+		checks := checksByName(newTestService(t, exec).checkCluster(context.Background()))
+
+		assert.Equal(t, StatusCritical, checks["Kube context"].Status)
+		assert.Contains(t, checks["Kube context"].Remediation, "openframe cluster start dev")
+		assert.Equal(t, StatusSkipped, checks["ArgoCD"].Status)
+		assert.Equal(t, StatusSkipped, checks["Telepresence"].Status)
+	})
+
+	t.Run("no current context", func(t *testing.T) {
+		exec := executor.NewMockCommandExecutor()
+		exec.SetResponse("config current-context", &executor.CommandResult{ExitCode: 1})
+
+		checks := checksByName(newTestService(t, exec).checkCluster(context.Background()))
+
+		assert.Equal(t, StatusWarning, checks["Kube context"].Status)
+		assert.Equal(t, "no current kube context", checks["ArgoCD"].Message)
+		assert.False(t, exec.WasCommandExecuted("/readyz"))
+	})
+}
+
+func TestService_CheckPorts(t *testing.T) {
+	clusters := []models.ClusterInfo{
+		{Name: "dev", State: models.ClusterStateRunning, Ports: models.ClusterPorts{API: 6550, HTTP: 80, HTTPS: 443}},
+		{Name: "old", State: models.ClusterStateStopped, Ports: models.ClusterPorts{API: 6551, HTTP: 8080, HTTPS: 8443}},
+	}
+
+	t.Run("reports busy ports", func(t *testing.T) {
+		service := newTestService(t, executor.NewMockCommandExecutor())
+		service.listClusters = func() ([]models.ClusterInfo, error) { return clusters[1:], nil }
+		service.portInUse = func(port int) bool { return port == 8080 || port == 80 }
+
+		checks := checksByName(service.checkPorts())
+
+		require.Len(t, checks, 2)
+		assert.Equal(t, StatusCritical, checks["Port 8080"].Status)
+		assert.Equal(t, "http port of stopped cluster old is in use by another process", checks["Port 8080"].Message)
+		assert.Equal(t, StatusWarning, checks["Port 80"].Status)
+		assert.Contains(t, checks["Port 80"].Remediation, "--http-port")
+	})
+
+	t.Run("ports of running clusters are not conflicts", func(t *testing.T) {
+		service := newTestService(t, executor.NewMockCommandExecutor())
+		service.listClusters = func() ([]models.ClusterInfo, error) { return clusters, nil }
+		service.portInUse = func(port int) bool { return port == 80 || port == 443 || port == 6550 }
+
+		checks := service.checkPorts()
+
+		assert.Equal(t, []Check{{Category: CategoryPorts, Name: "Host ports", Status: StatusOK, Message: "no conflicts"}}, checks)
+	})
+
+	t.Run("skips when clusters cannot be listed", func(t *testing.T) {
+		service := newTestService(t, executor.NewMockCommandExecutor())
+		service.listClusters = func() ([]models.ClusterInfo, error) { return nil, errors.New("docker is not running") }
+
+		checks := service.checkPorts()
+
+		require.Len(t, checks, 1)
+		assert.Equal(t, StatusSkipped, checks[0].Status)
+	})
+}
+
+func TestHostPortInUse(t *testing.T) {
+	listener, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	assert.True(t, hostPortInUse(listener.Addr().(*net.TCPAddr).Port))
+}
+
+func TestIsAddrInUse(t *testing.T) {
+	bindError := func(errno syscall.Errno) error {
+		return &net.OpError{Op: "listen", Net: "tcp", Err: os.NewSyscallError("bind", errno)}
+	}
+
+	assert.True(t, isAddrInUse(bindError(syscall.EADDRINUSE)))
+	// Non-root users cannot bind ports below 1024, which says nothing about other processes
+	assert.False(t, isAddrInUse(bindError(syscall.EACCES)))
+	assert.False(t, isAddrInUse(errors.New("listen failed")))
+}
+
+func TestService_Run(t *testing.T) {
+	exec := executor.NewMockCommandExecutor()
+	exec.SetResponse("docker info", &executor.CommandResult{Stdout: testDockerInfo})
+	exec.SetResponse("docker run", &executor.CommandResult{Stdout: testProbe})
+	exec.SetResponse("config current-context", &executor.CommandResult{Stdout: "k3d-dev\n"})
+	exec.SetResponse("/readyz", &executor.CommandResult{ExitCode: 1})
+	exec.SetResponse("mkcert -CAROOT", &executor.CommandResult{ExitCode: 1})
+	service := newTestService(t, exec)
+
+	report := service.Run(context.Background())
+
+	categories := []string{}
+	for _, check := range report.Checks {
+		if len(categories) == 0 || categories[len(categories)-1] != check.Category {
+			categories = append(categories, check.Category)
+		}
+	}
+	assert.Equal(t, []string{CategoryDocker, CategoryCertificates, CategoryCluster, CategoryPorts}, categories)
+	assert.Equal(t, 1, report.Summary.Critical)
+	assert.EqualError(t, report.Err(), "doctor found 1 critical problem(s)")
+}
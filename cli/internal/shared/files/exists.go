@@ -0,0 +1,12 @@
+package files
+
+import "os"
+
+// Exists reports whether a path names an existing regular file
+func Exists(path string) bool {
+	if path == "" {
+		return false
+	}
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
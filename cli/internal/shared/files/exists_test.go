@@ -0,0 +1,21 @@
+package files
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExists(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "localhost.pem")
+	require.NoError(t, os.WriteFile(file, []byte("CERT"), 0600))
+
+	assert.True(t, Exists(file))
+	assert.False(t, Exists(""))
+	assert.False(t, Exists(dir), "directories are not files")
+	assert.False(t, Exists(filepath.Join(dir, "missing.pem")))
+}
@@ -0,0 +1,293 @@
+package localbin
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FlagName is the global flag that switches the installers to user-local binaries
+	FlagName = "local-tools"
+
+	// EnvVar enables user-local installs without passing the flag on every command
+	EnvVar = "OPENFRAME_LOCAL_TOOLS"
+)
+
+// enabled is bound to the --local-tools flag
+var enabled bool
+
+// AddFlag registers the --local-tools flag on the root command
+func AddFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().BoolVar(&enabled, FlagName, false,
+		"Install missing tools as checksum-verified binaries into ~/.config/openframe/bin instead of system paths")
+}
+
+// Enabled reports whether tools should be installed into the user-local bin directory
+func Enabled() bool {
+	if enabled {
+		return true
+	}
+	switch strings.ToLower(os.Getenv(EnvVar)) {
+	case "1", "true", "yes":
+		return true
+	}
+	return false
+}
+
+// SetEnabled switches user-local installs on or off, for callers that do not parse the flag
+func SetEnabled(value bool) {
+	enabled = value
+}
+
+// Dir returns the user-local bin directory, ~/.config/openframe/bin
+func Dir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "openframe", "bin"), nil
+}
+
+// ActivatePath prepends the bin directory to PATH, so that the CommandExecutor and the
+// prerequisite checks find user-local tools before the ones installed on the host
+func ActivatePath() error {
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+	os.Setenv("PATH", prependPath(dir, os.Getenv("PATH")))
+	return nil
+}
+
+// prependPath puts dir first in a PATH value, removing any later occurrence
+func prependPath(dir, pathList string) string {
+	entries := []string{dir}
+	for _, entry := range filepath.SplitList(pathList) {
+		if entry != "" && entry != dir {
+			entries = append(entries, entry)
+		}
+	}
+	return strings.Join(entries, string(os.PathListSeparator))
+}
+
+// Binary is a pinned tool release that can be installed into the bin directory
+// URL and ArchivePath may contain {version}, {os} and {arch}.
+// SHA256 is pinned here rather than fetched next to the download, so a compromised
+// release host cannot replace both; it has to be updated whenever Version changes.
+type Binary struct {
+	Name        string
+	Version     string
+	URL         string
+	SHA256      map[string]string // SHA-256 of the download by "os/arch", e.g. "linux/amd64"
+	ArchivePath string            // Path of the binary inside a .tar.gz download; empty for plain binaries
+	OSNames     map[string]string // Release names of operating systems that differ from GOOS
+}
+
+// Installer downloads binaries into a directory after verifying their checksums
+// The platform and endpoints are fields so that tests can replace them.
+type Installer struct {
+	dir    string
+	client *http.Client
+	goos   string
+	goarch string
+}
+
+// NewInstaller creates an installer for the user-local bin directory and the host platform
+func NewInstaller() (*Installer, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+	return NewInstallerFor(dir, runtime.GOOS, runtime.GOARCH), nil
+}
+
+// NewInstallerFor creates an installer for another directory and platform, e.g. to collect
+// the binaries of an offline bundle for the machine it will be installed on
+func NewInstallerFor(dir, goos, goarch string) *Installer {
+	return &Installer{
+		dir:    dir,
+		client: &http.Client{Timeout: 5 * time.Minute},
+		goos:   goos,
+		goarch: goarch,
+	}
+}
+
+// Install installs a binary into the user-local bin directory
+func Install(binary Binary) error {
+	installer, err := NewInstaller()
+	if err != nil {
+		return err
+	}
+	_, err = installer.Install(binary)
+	return err
+}
+
+// Install downloads the binary, verifies it against its pinned SHA-256 and moves it into the bin directory
+// It returns the path of the installed binary. Nothing is installed when verification fails.
+func (i *Installer) Install(binary Binary) (string, error) {
+	if i.goos != "linux" && i.goos != "darwin" {
+		return "", fmt.Errorf("local installation of %s is not supported on %s", binary.Name, i.goos)
+	}
+	if i.goarch != "amd64" && i.goarch != "arm64" {
+		return "", fmt.Errorf("local installation of %s is not supported on %s", binary.Name, i.goarch)
+	}
+	expected, err := i.pinnedChecksum(binary)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(i.dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", i.dir, err)
+	}
+
+	download, err := os.CreateTemp(i.dir, "."+binary.Name+"-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create download file: %w", err)
+	}
+	defer os.Remove(download.Name())
+	defer download.Close()
+
+	hash := sha256.New()
+	if err := i.fetch(i.expand(binary, binary.URL), io.MultiWriter(download, hash)); err != nil {
+		return "", fmt.Errorf("failed to download %s v%s: %w", binary.Name, binary.Version, err)
+	}
+	if actual := hex.EncodeToString(hash.Sum(nil)); actual != expected {
+		return "", fmt.Errorf("checksum mismatch for %s v%s: expected %s, got %s", binary.Name, binary.Version, expected, actual)
+	}
+
+	target := filepath.Join(i.dir, binary.Name)
+	if binary.ArchivePath != "" {
+		if _, err := download.Seek(0, io.SeekStart); err != nil {
+			return "", err
+		}
+		if err := extractFile(download, i.expand(binary, binary.ArchivePath), download.Name()+".bin"); err != nil {
+			return "", fmt.Errorf("failed to extract %s: %w", binary.Name, err)
+		}
+		defer os.Remove(download.Name() + ".bin")
+		if err := os.Rename(download.Name()+".bin", target); err != nil {
+			return "", fmt.Errorf("failed to install %s: %w", binary.Name, err)
+		}
+	} else {
+		download.Close()
+		if err := os.Rename(download.Name(), target); err != nil {
+			return "", fmt.Errorf("failed to install %s: %w", binary.Name, err)
+		}
+	}
+
+	if err := os.Chmod(target, 0755); err != nil {
+		return "", fmt.Errorf("failed to make %s executable: %w", binary.Name, err)
+	}
+	return target, nil
+}
+
+// expand replaces the {version}, {os} and {arch} placeholders
+func (i *Installer) expand(binary Binary, template string) string {
+	osName := i.goos
+	if name, ok := binary.OSNames[i.goos]; ok {
+		osName = name
+	}
+	return strings.NewReplacer("{version}", binary.Version, "{os}", osName, "{arch}", i.goarch).Replace(template)
+}
+
+// Platforms are the "os/arch" pairs local installs and offline bundles support
+var Platforms = []string{"linux/amd64", "linux/arm64", "darwin/amd64", "darwin/arm64"}
+
+// Validate checks that a well-formed SHA-256 is pinned for every supported platform and
+// that no other platform is pinned
+func (b Binary) Validate() error {
+	for platform := range b.SHA256 {
+		if !slices.Contains(Platforms, platform) {
+			return fmt.Errorf("%s pins a SHA-256 for unsupported platform %q", b.Name, platform)
+		}
+	}
+	for _, platform := range Platforms {
+		checksum, ok := b.SHA256[platform]
+		if !ok {
+			return fmt.Errorf("no SHA-256 is pinned for %s v%s on %s", b.Name, b.Version, platform)
+		}
+		if !isSHA256(checksum) {
+			return fmt.Errorf("invalid SHA-256 %q pinned for %s v%s on %s", checksum, b.Name, b.Version, platform)
+		}
+	}
+	return nil
+}
+
+// isSHA256 reports whether a string is a hex-encoded SHA-256
+func isSHA256(checksum string) bool {
+	_, err := hex.DecodeString(checksum)
+	return err == nil && len(checksum) == sha256.Size*2
+}
+
+// pinnedChecksum returns the SHA-256 pinned for the download on the installer's platform
+func (i *Installer) pinnedChecksum(binary Binary) (string, error) {
+	platform := i.goos + "/" + i.goarch
+	checksum, ok := binary.SHA256[platform]
+	if !ok {
+		return "", fmt.Errorf("no SHA-256 is pinned for %s v%s on %s", binary.Name, binary.Version, platform)
+	}
+	if !isSHA256(checksum) {
+		return "", fmt.Errorf("invalid SHA-256 %q pinned for %s v%s on %s", checksum, binary.Name, binary.Version, platform)
+	}
+	return strings.ToLower(checksum), nil
+}
+
+// fetch downloads a URL into w
+func (i *Installer) fetch(url string, w io.Writer) error {
+	resp, err := i.client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// extractFile writes one file of a .tar.gz archive to target
+func extractFile(archive io.Reader, name, target string) error {
+	gz, err := gzip.NewReader(archive)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return fmt.Errorf("%s not found in archive", name)
+		}
+		if err != nil {
+			return err
+		}
+		if path.Clean(header.Name) != path.Clean(name) || header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		out, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0755)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(out, tr); err != nil {
+			out.Close()
+			return err
+		}
+		return out.Close()
+	}
+}
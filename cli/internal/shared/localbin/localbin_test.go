@@ -0,0 +1,216 @@
+package localbin
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var toolContent = []byte("#!/bin/sh\necho tool v1.2.3\n")
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func tarGz(t *testing.T, name string, content []byte) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "README.md", Mode: 0644, Size: 2, Typeflag: tar.TypeReg}))
+	_, err := tw.Write([]byte("hi"))
+	require.NoError(t, err)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0755, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+	_, err = tw.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+// newTestInstaller serves files by path and installs into a temporary directory as linux/amd64
+func newTestInstaller(t *testing.T, files map[string][]byte) (*Installer, string) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(content)
+	}))
+	t.Cleanup(server.Close)
+
+	return &Installer{
+		dir:    filepath.Join(t.TempDir(), "bin"),
+		client: server.Client(),
+		goos:   "linux",
+		goarch: "amd64",
+	}, server.URL
+}
+
+func TestInstaller_Install(t *testing.T) {
+	t.Run("installs a binary verified against its pinned checksum", func(t *testing.T) {
+		installer, url := newTestInstaller(t, map[string][]byte{"/v1.2.3/linux/amd64/tool": toolContent})
+
+		target, err := installer.Install(Binary{
+			Name:    "tool",
+			Version: "1.2.3",
+			URL:     url + "/v{version}/{os}/{arch}/tool",
+			SHA256:  map[string]string{"linux/amd64": sha256Hex(toolContent)},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, filepath.Join(installer.dir, "tool"), target)
+		content, err := os.ReadFile(target)
+		require.NoError(t, err)
+		assert.Equal(t, toolContent, content)
+		info, err := os.Stat(target)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+
+		entries, err := os.ReadDir(installer.dir)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1, "temporary downloads are removed")
+	})
+
+	t.Run("refuses a download that does not match the checksum", func(t *testing.T) {
+		installer, url := newTestInstaller(t, map[string][]byte{"/tool": []byte("tampered")})
+
+		_, err := installer.Install(Binary{
+			Name:    "tool",
+			Version: "1.2.3",
+			URL:     url + "/tool",
+			SHA256:  map[string]string{"linux/amd64": sha256Hex(toolContent)},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "checksum mismatch for tool v1.2.3")
+
+		_, statErr := os.Stat(filepath.Join(installer.dir, "tool"))
+		assert.True(t, os.IsNotExist(statErr))
+	})
+
+	t.Run("uses the checksum of the installer platform", func(t *testing.T) {
+		installer, url := newTestInstaller(t, map[string][]byte{"/download/jq-1.7.1/jq-macos-arm64": toolContent})
+		installer.goos, installer.goarch = "darwin", "arm64"
+
+		_, err := installer.Install(Binary{
+			Name:    "jq",
+			Version: "1.7.1",
+			URL:     url + "/download/jq-{version}/jq-{os}-{arch}",
+			SHA256: map[string]string{
+				"linux/amd64":  strings.Repeat("0", 64),
+				"darwin/arm64": sha256Hex(toolContent),
+			},
+			OSNames: map[string]string{"darwin": "macos"},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("extracts the binary from a verified archive", func(t *testing.T) {
+		archive := tarGz(t, "linux-amd64/helm", toolContent)
+		installer, url := newTestInstaller(t, map[string][]byte{"/helm-v3.16.2-linux-amd64.tar.gz": archive})
+
+		target, err := installer.Install(Binary{
+			Name:        "helm",
+			Version:     "3.16.2",
+			URL:         url + "/helm-v{version}-{os}-{arch}.tar.gz",
+			SHA256:      map[string]string{"linux/amd64": sha256Hex(archive)},
+			ArchivePath: "{os}-{arch}/helm",
+		})
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(target)
+		require.NoError(t, err)
+		assert.Equal(t, toolContent, content)
+	})
+
+	t.Run("fails without a checksum for the platform", func(t *testing.T) {
+		installer, url := newTestInstaller(t, map[string][]byte{"/tool": toolContent})
+
+		_, err := installer.Install(Binary{
+			Name:    "tool",
+			Version: "1.2.3",
+			URL:     url + "/tool",
+			SHA256:  map[string]string{"darwin/arm64": sha256Hex(toolContent)},
+		})
+		assert.EqualError(t, err, "no SHA-256 is pinned for tool v1.2.3 on linux/amd64")
+
+		_, statErr := os.Stat(installer.dir)
+		assert.True(t, os.IsNotExist(statErr), "nothing is downloaded")
+	})
+
+	t.Run("rejects a malformed checksum", func(t *testing.T) {
+		installer, url := newTestInstaller(t, map[string][]byte{"/tool": toolContent})
+
+		_, err := installer.Install(Binary{Name: "tool", Version: "1.2.3", URL: url + "/tool", SHA256: map[string]string{"linux/amd64": "abc"}})
+		assert.EqualError(t, err, `invalid SHA-256 "abc" pinned for tool v1.2.3 on linux/amd64`)
+	})
+
+	t.Run("rejects unsupported platforms", func(t *testing.T) {
+		installer, _ := newTestInstaller(t, nil)
+		installer.goos = "windows"
+
+		_, err := installer.Install(Binary{Name: "tool", Version: "1.2.3"})
+		assert.EqualError(t, err, "local installation of tool is not supported on windows")
+	})
+}
+
+func TestPrependPath(t *testing.T) {
+	sep := string(os.PathListSeparator)
+
+	assert.Equal(t, "/home/me/bin"+sep+"/usr/bin"+sep+"/bin",
+		prependPath("/home/me/bin", "/usr/bin"+sep+"/home/me/bin"+sep+"/bin"))
+	assert.Equal(t, "/home/me/bin", prependPath("/home/me/bin", ""))
+}
+
+func TestEnabled(t *testing.T) {
+	SetEnabled(false)
+	t.Setenv(EnvVar, "")
+	assert.False(t, Enabled())
+
+	t.Setenv(EnvVar, "true")
+	assert.True(t, Enabled())
+
+	t.Setenv(EnvVar, "")
+	SetEnabled(true)
+	defer SetEnabled(false)
+	assert.True(t, Enabled())
+}
+
+func TestBinary_Validate(t *testing.T) {
+	pinned := func(overrides map[string]string) map[string]string {
+		checksums := make(map[string]string)
+		for _, platform := range Platforms {
+			checksums[platform] = sha256Hex(toolContent)
+		}
+		for platform, checksum := range overrides {
+			if checksum == "" {
+				delete(checksums, platform)
+			} else {
+				checksums[platform] = checksum
+			}
+		}
+		return checksums
+	}
+
+	assert.NoError(t, Binary{Name: "tool", SHA256: pinned(nil)}.Validate())
+	assert.EqualError(t, Binary{Name: "tool", SHA256: pinned(map[string]string{"windows/amd64": sha256Hex(toolContent)})}.Validate(),
+		`tool pins a SHA-256 for unsupported platform "windows/amd64"`)
+	assert.EqualError(t, Binary{Name: "tool", Version: "1.2.3", SHA256: pinned(map[string]string{"linux/amd64": "sha256:abc"})}.Validate(),
+		`invalid SHA-256 "sha256:abc" pinned for tool v1.2.3 on linux/amd64`)
+	assert.EqualError(t, Binary{Name: "tool", Version: "1.2.3", SHA256: pinned(map[string]string{"darwin/arm64": ""})}.Validate(),
+		"no SHA-256 is pinned for tool v1.2.3 on darwin/arm64")
+	assert.EqualError(t, Binary{Name: "tool", Version: "1.2.3"}.Validate(),
+		"no SHA-256 is pinned for tool v1.2.3 on linux/amd64")
+}
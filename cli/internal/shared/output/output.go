@@ -0,0 +1,141 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	sharedErrors "github.com/flamingo/openframe/internal/shared/errors"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// Format is the output format selected with the global --output flag
+type Format string
+
+const (
+	FormatText Format = "text"
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// FlagName is the name of the global output flag
+const FlagName = "output"
+
+// DocumentAPIVersion is the apiVersion stamped on every machine-readable document
+const DocumentAPIVersion = "openframe.io/v1alpha1"
+
+// AddOutputFlag adds the --output/-o flag as a persistent flag of a command
+func AddOutputFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringP(FlagName, "o", string(FormatText), "Output format: text, json or yaml")
+}
+
+// ParseFormat parses an output format, treating an empty value as text
+func ParseFormat(value string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(value))) {
+	case "", FormatText:
+		return FormatText, nil
+	case FormatJSON:
+		return FormatJSON, nil
+	case FormatYAML, "yml":
+		return FormatYAML, nil
+	default:
+		return "", fmt.Errorf("invalid output format %q: must be text, json or yaml", value)
+	}
+}
+
+// FormatFromCommand returns the output format selected for a command
+// Commands without the flag use text output
+func FormatFromCommand(cmd *cobra.Command) (Format, error) {
+	flag := cmd.Flags().Lookup(FlagName)
+	if flag == nil {
+		return FormatText, nil
+	}
+	return ParseFormat(flag.Value.String())
+}
+
+// IsStructured reports whether the format is machine-readable
+func (f Format) IsStructured() bool {
+	return f == FormatJSON || f == FormatYAML
+}
+
+// Write encodes a document in the given format
+// YAML documents use the same field names and order as their JSON encoding
+func Write(w io.Writer, format Format, document interface{}) error {
+	data, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode output: %w", err)
+	}
+
+	switch format {
+	case FormatJSON:
+		_, err = fmt.Fprintf(w, "%s\n", data)
+		return err
+	case FormatYAML:
+		yamlData, err := jsonToYAML(data)
+		if err != nil {
+			return fmt.Errorf("failed to encode output: %w", err)
+		}
+		_, err = w.Write(yamlData)
+		return err
+	default:
+		return fmt.Errorf("output format %q is not machine-readable", format)
+	}
+}
+
+// jsonToYAML converts a JSON document to block-style YAML, preserving key order
+func jsonToYAML(data []byte) ([]byte, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	resetStyle(&node)
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// resetStyle drops the flow and quoting styles inherited from JSON so the encoder picks YAML defaults
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
+
+// PassthroughError marks an error from a machine-readable run as already handled
+// so that it is reported once on stderr with a non-zero exit code instead of an error box on stdout
+func PassthroughError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, handled := err.(*sharedErrors.AlreadyHandledError); handled {
+		return err
+	}
+	return &sharedErrors.AlreadyHandledError{OriginalError: err}
+}
+
+// RedirectHumanOutput sends pretty terminal output to stderr so that stdout only carries the document
+// It returns the original stdout for the document and a function that restores the previous state
+func RedirectHumanOutput() (*os.File, func()) {
+	stdout := os.Stdout
+	os.Stdout = os.Stderr
+	pterm.SetDefaultOutput(os.Stderr)
+
+	return stdout, func() {
+		os.Stdout = stdout
+		pterm.SetDefaultOutput(stdout)
+	}
+}
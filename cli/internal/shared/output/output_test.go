@@ -0,0 +1,120 @@
+package output
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	sharedErrors "github.com/flamingo/openframe/internal/shared/errors"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testDocument struct {
+	Name     string   `json:"name"`
+	Version  string   `json:"version"`
+	Count    int      `json:"count"`
+	Enabled  bool     `json:"enabled"`
+	Items    []string `json:"items"`
+	Optional string   `json:"optional,omitempty"`
+}
+
+var testDoc = testDocument{Name: "dev", Version: "1234", Count: 3, Enabled: true, Items: []string{"a", "true"}}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		value string
+		want  Format
+	}{
+		{"", FormatText},
+		{"text", FormatText},
+		{"json", FormatJSON},
+		{"JSON", FormatJSON},
+		{"yaml", FormatYAML},
+		{"yml", FormatYAML},
+	}
+	for _, tt := range tests {
+		format, err := ParseFormat(tt.value)
+		require.NoError(t, err, tt.value)
+		assert.Equal(t, tt.want, format, tt.value)
+	}
+
+	_, err := ParseFormat("table")
+	assert.ErrorContains(t, err, "invalid output format")
+}
+
+func TestFormat_IsStructured(t *testing.T) {
+	assert.False(t, FormatText.IsStructured())
+	assert.True(t, FormatJSON.IsStructured())
+	assert.True(t, FormatYAML.IsStructured())
+}
+
+func TestFormatFromCommand(t *testing.T) {
+	t.Run("defaults to text without the flag", func(t *testing.T) {
+		format, err := FormatFromCommand(&cobra.Command{})
+		require.NoError(t, err)
+		assert.Equal(t, FormatText, format)
+	})
+
+	t.Run("reads the inherited flag", func(t *testing.T) {
+		root := &cobra.Command{Use: "root"}
+		AddOutputFlag(root)
+		child := &cobra.Command{Use: "child", Run: func(cmd *cobra.Command, args []string) {}}
+		root.AddCommand(child)
+		root.SetArgs([]string{"child", "-o", "yaml"})
+		require.NoError(t, root.Execute())
+
+		format, err := FormatFromCommand(child)
+		require.NoError(t, err)
+		assert.Equal(t, FormatYAML, format)
+	})
+}
+
+func TestWrite(t *testing.T) {
+	t.Run("writes indented JSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, Write(&buf, FormatJSON, testDoc))
+
+		assert.Equal(t, `{
+  "name": "dev",
+  "version": "1234",
+  "count": 3,
+  "enabled": true,
+  "items": [
+    "a",
+    "true"
+  ]
+}
+`, buf.String())
+	})
+
+	t.Run("writes YAML with JSON field names and order", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, Write(&buf, FormatYAML, testDoc))
+
+		assert.Equal(t, `name: dev
+version: "1234"
+count: 3
+enabled: true
+items:
+  - a
+  - "true"
+`, buf.String())
+	})
+
+	t.Run("rejects text format", func(t *testing.T) {
+		assert.Error(t, Write(&bytes.Buffer{}, FormatText, testDoc))
+	})
+}
+
+func TestPassthroughError(t *testing.T) {
+	assert.NoError(t, PassthroughError(nil))
+
+	err := PassthroughError(errors.New("boom"))
+	var handled *sharedErrors.AlreadyHandledError
+	require.ErrorAs(t, err, &handled)
+	assert.EqualError(t, err, "boom")
+
+	assert.Same(t, handled, PassthroughError(handled))
+}
@@ -0,0 +1,237 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// storeLockFile serializes record writes and port leases across processes
+	storeLockFile = ".lock"
+
+	// portScanRange is how far past the alternate port the allocator looks for a free port
+	portScanRange = 1000
+
+	// reservationTimeout is how long ports stay leased to a cluster whose creation never finished
+	reservationTimeout = 30 * time.Minute
+)
+
+// lockTimeout and staleLockAge are variables so tests can shorten them
+// The lock is only held while records are read and written, so it goes stale well before a
+// waiter gives up, and a command started right after a crash takes the lock over.
+var (
+	lockTimeout  = 30 * time.Second
+	staleLockAge = 10 * time.Second
+)
+
+// Preferred host ports, tried in order before scanning upwards from the alternate
+var (
+	defaultPorts   = Ports{API: 6550, HTTP: 80, HTTPS: 443}
+	alternatePorts = Ports{API: 6551, HTTP: 8080, HTTPS: 8443}
+)
+
+// PortConflictError is returned when a requested port is leased to another cluster or busy on the host
+type PortConflictError struct {
+	Port  int
+	Owner string // Cluster holding the lease, empty when the port is used outside the CLI
+}
+
+func (e PortConflictError) Error() string {
+	if e.Owner != "" {
+		return fmt.Sprintf("port %d is already leased to cluster %s", e.Port, e.Owner)
+	}
+	return fmt.Sprintf("port %d is already in use", e.Port)
+}
+
+// reservationExpired reports whether the record is a port lease that outlived reservationTimeout
+func (r ClusterRecord) reservationExpired(now time.Time) bool {
+	return r.Reserved && now.Sub(r.UpdatedAt) > reservationTimeout
+}
+
+// IsStaleReservation reports whether the record is a lease left behind by a create that never finished
+func (r ClusterRecord) IsStaleReservation() bool {
+	return r.reservationExpired(time.Now())
+}
+
+// LeasePorts picks the API, HTTP and HTTPS host ports of a new cluster and records them
+// as a reservation, so concurrent creates never pick the same ports.
+// Non-zero requested ports are used as given; the others are the lowest free ports
+// in the sequence default, alternate, alternate+1...  The available callback reports
+// whether a port is free outside the CLI (not bound on the host, not used by other clusters).
+func (s *Store) LeasePorts(name, clusterType string, requested Ports, available func(port int) bool) (Ports, error) {
+	if _, err := s.recordPath(name); err != nil {
+		return Ports{}, err
+	}
+
+	unlock, err := s.lock()
+	if err != nil {
+		return Ports{}, err
+	}
+	defer unlock()
+
+	owners, err := s.leasedPorts(name)
+	if err != nil {
+		return Ports{}, err
+	}
+
+	taken := func(port int) bool {
+		_, leased := owners[port]
+		return leased || !available(port)
+	}
+
+	var leased Ports
+	fields := []struct {
+		requested, preferred, alternate int
+		result                          *int
+	}{
+		{requested.API, defaultPorts.API, alternatePorts.API, &leased.API},
+		{requested.HTTP, defaultPorts.HTTP, alternatePorts.HTTP, &leased.HTTP},
+		{requested.HTTPS, defaultPorts.HTTPS, alternatePorts.HTTPS, &leased.HTTPS},
+	}
+
+	// Requested ports first, so automatic picks never take them
+	for _, field := range fields {
+		if field.requested == 0 {
+			continue
+		}
+		if owner, ok := owners[field.requested]; ok {
+			return Ports{}, PortConflictError{Port: field.requested, Owner: owner}
+		}
+		if !available(field.requested) {
+			return Ports{}, PortConflictError{Port: field.requested}
+		}
+		*field.result = field.requested
+		owners[field.requested] = name
+	}
+
+	for _, field := range fields {
+		if field.requested != 0 {
+			continue
+		}
+		port, err := pickPort(field.preferred, field.alternate, taken)
+		if err != nil {
+			return Ports{}, err
+		}
+		*field.result = port
+		owners[port] = name
+	}
+
+	record := &ClusterRecord{
+		Name:     name,
+		Type:     clusterType,
+		Ports:    leased,
+		Reserved: true,
+	}
+	if err := s.save(record); err != nil {
+		return Ports{}, err
+	}
+	return leased, nil
+}
+
+// ReleasePorts drops the lease of a cluster whose creation failed
+// Records of created clusters are left alone
+func (s *Store) ReleasePorts(name string) error {
+	unlock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	record, err := s.Load(name)
+	if errors.Is(err, ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !record.Reserved {
+		return nil
+	}
+	return s.delete(name)
+}
+
+// leasedPorts maps the ports recorded for every other cluster to the cluster name
+// Reservations of creates that never finished are ignored once they expire
+func (s *Store) leasedPorts(name string) (map[int]string, error) {
+	records, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	owners := make(map[int]string)
+	for _, record := range records {
+		if record.Name == name || record.reservationExpired(now) {
+			continue
+		}
+		for _, port := range []int{record.Ports.API, record.Ports.HTTP, record.Ports.HTTPS} {
+			if port != 0 {
+				owners[port] = record.Name
+			}
+		}
+	}
+	return owners, nil
+}
+
+// pickPort returns the first port that is not taken: preferred, alternate, then upwards from alternate
+func pickPort(preferred, alternate int, taken func(port int) bool) (int, error) {
+	if !taken(preferred) {
+		return preferred, nil
+	}
+	for port := alternate; port <= alternate+portScanRange; port++ {
+		if !taken(port) {
+			return port, nil
+		}
+	}
+	return 0, fmt.Errorf("could not find an available port between %d and %d", alternate, alternate+portScanRange)
+}
+
+// lock takes the store-wide lock, waiting for other processes to release it
+// A lock file older than staleLockAge is left over from a crashed process and is broken
+func (s *Store) lock() (func(), error) {
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create state directory %s: %w", s.dir, err)
+	}
+
+	path := filepath.Join(s.dir, storeLockFile)
+	deadline := time.Now().Add(lockTimeout)
+	for {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			fmt.Fprintf(file, "%d\n", os.Getpid())
+			file.Close()
+			return func() { os.Remove(path) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("failed to lock %s: %w", path, err)
+		}
+
+		if info, statErr := os.Stat(path); statErr == nil && time.Since(info.ModTime()) > staleLockAge {
+			breakStaleLock(path, info)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for state lock %s; remove it if no other openframe command is running", path)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// breakStaleLock removes the stale lock file, leaving alone a lock that replaced it in the meantime
+// The lock is renamed aside before it is checked, so when several processes find the same
+// stale lock only one of them removes it and the others cannot remove the lock taken after it.
+func breakStaleLock(path string, stale os.FileInfo) {
+	aside := fmt.Sprintf("%s.%d.stale", path, os.Getpid())
+	if err := os.Rename(path, aside); err != nil {
+		return
+	}
+	// The modification time tells locks apart when the file system reuses the inode of the stale lock
+	if info, err := os.Stat(aside); err == nil && (!os.SameFile(stale, info) || !info.ModTime().Equal(stale.ModTime())) {
+		// Another process broke the stale lock and locked again before the rename, give its lock back
+		os.Link(aside, path)
+	}
+	os.Remove(aside)
+}
@@ -0,0 +1,183 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func allPortsFree(port int) bool { return true }
+
+func TestStore_LeasePorts(t *testing.T) {
+	t.Run("defaults first", func(t *testing.T) {
+		store := NewStore(t.TempDir())
+
+		ports, err := store.LeasePorts("dev", "k3d", Ports{}, allPortsFree)
+
+		require.NoError(t, err)
+		assert.Equal(t, Ports{API: 6550, HTTP: 80, HTTPS: 443}, ports)
+		record, err := store.Load("dev")
+		require.NoError(t, err)
+		assert.True(t, record.Reserved)
+		assert.Equal(t, "k3d", record.Type)
+		assert.Equal(t, ports, record.Ports)
+	})
+
+	t.Run("leases of other clusters and busy ports are skipped", func(t *testing.T) {
+		store := NewStore(t.TempDir())
+		_, err := store.LeasePorts("first", "k3d", Ports{}, allPortsFree)
+		require.NoError(t, err)
+
+		ports, err := store.LeasePorts("second", "k3d", Ports{}, func(port int) bool { return port != 6551 })
+
+		require.NoError(t, err)
+		assert.Equal(t, Ports{API: 6552, HTTP: 8080, HTTPS: 8443}, ports)
+	})
+
+	t.Run("requested ports are used as given", func(t *testing.T) {
+		store := NewStore(t.TempDir())
+
+		ports, err := store.LeasePorts("dev", "kind", Ports{HTTP: 6550}, allPortsFree)
+
+		require.NoError(t, err)
+		assert.Equal(t, Ports{API: 6551, HTTP: 6550, HTTPS: 443}, ports, "automatic picks avoid requested ports")
+	})
+
+	t.Run("requested port conflicts", func(t *testing.T) {
+		store := NewStore(t.TempDir())
+		require.NoError(t, store.Save(&ClusterRecord{Name: "other", Ports: Ports{API: 7000}}))
+
+		_, err := store.LeasePorts("dev", "k3d", Ports{API: 7000}, allPortsFree)
+		assert.Equal(t, PortConflictError{Port: 7000, Owner: "other"}, err)
+
+		_, err = store.LeasePorts("dev", "k3d", Ports{HTTPS: 9443}, func(port int) bool { return port != 9443 })
+		assert.EqualError(t, err, "port 9443 is already in use")
+	})
+
+	t.Run("expired reservations are ignored", func(t *testing.T) {
+		store := NewStore(t.TempDir())
+		// Save always stamps the current time, so the stale record is written directly
+		updatedAt := time.Now().Add(-2 * reservationTimeout).Format(time.RFC3339)
+		data := []byte(`{"name":"crashed","reserved":true,"updatedAt":"` + updatedAt + `","ports":{"api":6550,"http":80,"https":443}}`)
+		require.NoError(t, os.WriteFile(filepath.Join(store.Directory(), "crashed.json"), data, 0600))
+
+		ports, err := store.LeasePorts("dev", "k3d", Ports{}, allPortsFree)
+
+		require.NoError(t, err)
+		assert.Equal(t, Ports{API: 6550, HTTP: 80, HTTPS: 443}, ports)
+	})
+
+	t.Run("concurrent leases never overlap", func(t *testing.T) {
+		store := NewStore(t.TempDir())
+		names := []string{"one", "two", "three", "four", "five"}
+		results := make([]Ports, len(names))
+
+		var wg sync.WaitGroup
+		for i, name := range names {
+			wg.Add(1)
+			go func(i int, name string) {
+				defer wg.Done()
+				ports, err := store.LeasePorts(name, "k3d", Ports{}, allPortsFree)
+				assert.NoError(t, err)
+				results[i] = ports
+			}(i, name)
+		}
+		wg.Wait()
+
+		seen := make(map[int]bool)
+		for _, ports := range results {
+			for _, port := range []int{ports.API, ports.HTTP, ports.HTTPS} {
+				assert.False(t, seen[port], "port %d leased twice", port)
+				seen[port] = true
+			}
+		}
+		assert.NoFileExists(t, filepath.Join(store.Directory(), storeLockFile))
+	})
+}
+
+func TestStore_ReleasePorts(t *testing.T) {
+	store := NewStore(t.TempDir())
+	_, err := store.LeasePorts("dev", "k3d", Ports{}, allPortsFree)
+	require.NoError(t, err)
+	require.NoError(t, store.Save(&ClusterRecord{Name: "created", Ports: Ports{API: 7000}}))
+
+	require.NoError(t, store.ReleasePorts("dev"))
+	require.NoError(t, store.ReleasePorts("created"))
+	require.NoError(t, store.ReleasePorts("missing"))
+
+	_, err = store.Load("dev")
+	assert.ErrorIs(t, err, ErrRecordNotFound)
+	_, err = store.Load("created")
+	assert.NoError(t, err, "records of created clusters are kept")
+}
+
+func TestStore_Lock(t *testing.T) {
+	defer func(timeout, age time.Duration) { lockTimeout, staleLockAge = timeout, age }(lockTimeout, staleLockAge)
+	lockTimeout = 100 * time.Millisecond
+	staleLockAge = time.Hour
+
+	store := NewStore(t.TempDir())
+	unlock, err := store.lock()
+	require.NoError(t, err)
+
+	_, err = store.lock()
+	assert.ErrorContains(t, err, "timed out waiting for state lock")
+
+	// A lock left behind by a crashed process is taken over
+	staleLockAge = 0
+	unlockAgain, err := store.lock()
+	require.NoError(t, err)
+	unlockAgain()
+	unlock()
+}
+
+func TestStore_LockTakesOverCrashedHolder(t *testing.T) {
+	assert.Less(t, staleLockAge, lockTimeout, "a waiter must outlast the lock of a crashed process")
+
+	store := NewStore(t.TempDir())
+	path := filepath.Join(store.Directory(), storeLockFile)
+	require.NoError(t, os.WriteFile(path, []byte("1\n"), 0600))
+	crashed := time.Now().Add(-staleLockAge - time.Second)
+	require.NoError(t, os.Chtimes(path, crashed, crashed))
+
+	unlock, err := store.lock()
+	require.NoError(t, err)
+	unlock()
+}
+
+func TestBreakStaleLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), storeLockFile)
+	require.NoError(t, os.WriteFile(path, []byte("1\n"), 0600))
+	staleTime := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(path, staleTime, staleTime))
+	stale, err := os.Stat(path)
+	require.NoError(t, err)
+
+	t.Run("a lock taken after the stale one is kept", func(t *testing.T) {
+		require.NoError(t, os.Remove(path))
+		require.NoError(t, os.WriteFile(path, []byte("2\n"), 0600))
+
+		breakStaleLock(path, stale)
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "2\n", string(data))
+	})
+
+	t.Run("the stale lock is removed", func(t *testing.T) {
+		current, err := os.Stat(path)
+		require.NoError(t, err)
+
+		breakStaleLock(path, current)
+
+		assert.NoFileExists(t, path)
+		entries, err := os.ReadDir(filepath.Dir(path))
+		require.NoError(t, err)
+		assert.Empty(t, entries, "the renamed lock is cleaned up")
+	})
+}
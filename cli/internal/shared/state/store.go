@@ -0,0 +1,301 @@
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// RecordAPIVersion is stamped on every cluster record so the format can evolve
+const RecordAPIVersion = "openframe.io/v1alpha1"
+
+const (
+	recordExtension = ".json"
+	valuesExtension = ".values.yaml"
+)
+
+// ErrRecordNotFound is returned when no record exists for a cluster
+var ErrRecordNotFound = errors.New("cluster record not found")
+
+// ClusterRecord is what the CLI remembers about a cluster between runs
+type ClusterRecord struct {
+	APIVersion     string         `json:"apiVersion"`
+	Name           string         `json:"name"`
+	Type           string         `json:"type"`
+	CreatedAt      time.Time      `json:"createdAt"`
+	UpdatedAt      time.Time      `json:"updatedAt"`
+	Servers        int            `json:"servers,omitempty"`
+	Agents         int            `json:"agents,omitempty"`
+	K8sVersion     string         `json:"k8sVersion,omitempty"`
+	NodeImage      string         `json:"nodeImage,omitempty"` // Image an in-place upgrade took the k3s binary from; containers still reference the original
+	LocalRegistry  bool           `json:"localRegistry,omitempty"`
+	Ports          Ports          `json:"ports"`
+	Reserved       bool           `json:"reserved,omitempty"` // Ports are leased but the cluster is still being created
+	Install        *InstallRecord `json:"install,omitempty"`
+	BootstrappedAt *time.Time     `json:"bootstrappedAt,omitempty"`
+}
+
+// Ports are the host ports published for the API server and ingress
+type Ports struct {
+	API   int `json:"api,omitempty"`
+	HTTP  int `json:"http,omitempty"`
+	HTTPS int `json:"https,omitempty"`
+}
+
+// InstallRecord describes the last chart installation on a cluster
+type InstallRecord struct {
+	Repository  string    `json:"repository,omitempty"`
+	Branch      string    `json:"branch,omitempty"`
+	IngressMode string    `json:"ingressMode,omitempty"`
+	ValuesFile  string    `json:"valuesFile,omitempty"` // Copy of the helm values used for the install
+	InstalledAt time.Time `json:"installedAt"`
+}
+
+// Store keeps one JSON record per cluster in a directory
+type Store struct {
+	dir string
+}
+
+// NewStore creates a store rooted at a directory
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// DefaultDirectory returns ~/.config/openframe/clusters
+func DefaultDirectory() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".config", "openframe", "clusters"), nil
+}
+
+// NewDefaultStore creates a store in the default directory
+func NewDefaultStore() (*Store, error) {
+	dir, err := DefaultDirectory()
+	if err != nil {
+		return nil, err
+	}
+	return NewStore(dir), nil
+}
+
+// Directory returns the directory records are stored in
+func (s *Store) Directory() string {
+	return s.dir
+}
+
+// recordPath returns the path of the record file for a cluster
+func (s *Store) recordPath(name string) (string, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+		return "", fmt.Errorf("invalid cluster name for state record: %q", name)
+	}
+	return filepath.Join(s.dir, name+recordExtension), nil
+}
+
+// Load reads the record of a cluster, returning ErrRecordNotFound when there is none
+func (s *Store) Load(name string) (*ClusterRecord, error) {
+	path, err := s.recordPath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("failed to read state of cluster %s: %w", name, err)
+	}
+
+	var record ClusterRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, fmt.Errorf("failed to parse state of cluster %s: %w", name, err)
+	}
+	return &record, nil
+}
+
+// Save writes a record atomically, stamping the API version and update time
+func (s *Store) Save(record *ClusterRecord) error {
+	if _, err := s.recordPath(record.Name); err != nil {
+		return err
+	}
+
+	unlock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return s.save(record)
+}
+
+// save writes a record; the caller holds the store lock
+func (s *Store) save(record *ClusterRecord) error {
+	path, err := s.recordPath(record.Name)
+	if err != nil {
+		return err
+	}
+
+	record.APIVersion = RecordAPIVersion
+	record.UpdatedAt = time.Now().UTC()
+	if record.CreatedAt.IsZero() {
+		record.CreatedAt = record.UpdatedAt
+	}
+
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode state of cluster %s: %w", record.Name, err)
+	}
+	return s.writeFile(path, append(data, '\n'))
+}
+
+// Update applies a change to the record of a cluster, creating the record if needed
+// The store lock is held from the read to the write, so concurrent updates are not lost.
+func (s *Store) Update(name string, update func(record *ClusterRecord)) error {
+	if _, err := s.recordPath(name); err != nil {
+		return err
+	}
+
+	unlock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	record, err := s.Load(name)
+	if errors.Is(err, ErrRecordNotFound) {
+		record = &ClusterRecord{Name: name}
+	} else if err != nil {
+		return err
+	}
+
+	update(record)
+	record.Name = name
+	return s.save(record)
+}
+
+// SaveValues keeps a copy of the helm values used for a cluster and returns its path
+func (s *Store) SaveValues(name string, data []byte) (string, error) {
+	if _, err := s.recordPath(name); err != nil {
+		return "", err
+	}
+	path := filepath.Join(s.dir, name+valuesExtension)
+	if err := s.writeFile(path, data); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// Delete removes the record and saved values of a cluster; missing files are ignored
+func (s *Store) Delete(name string) error {
+	if _, err := s.recordPath(name); err != nil {
+		return err
+	}
+
+	unlock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return s.delete(name)
+}
+
+// delete removes the files of a cluster; the caller holds the store lock
+func (s *Store) delete(name string) error {
+	path, err := s.recordPath(name)
+	if err != nil {
+		return err
+	}
+	for _, file := range []string{path, filepath.Join(s.dir, name+valuesExtension)} {
+		if err := os.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove state of cluster %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Prune deletes the records that keep rejects and returns their cluster names
+// The store lock is held from the listing to the deletes, so a record saved concurrently
+// is never removed based on an older listing.
+func (s *Store) Prune(keep func(record ClusterRecord) bool) ([]string, error) {
+	unlock, err := s.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	records, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+
+	pruned := []string{}
+	for _, record := range records {
+		if keep(record) {
+			continue
+		}
+		if err := s.delete(record.Name); err != nil {
+			return pruned, err
+		}
+		pruned = append(pruned, record.Name)
+	}
+	return pruned, nil
+}
+
+// List returns all records sorted by cluster name; unreadable records are skipped
+func (s *Store) List() ([]ClusterRecord, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []ClusterRecord{}, nil
+		}
+		return nil, fmt.Errorf("failed to read state directory %s: %w", s.dir, err)
+	}
+
+	records := []ClusterRecord{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, recordExtension) {
+			continue
+		}
+		record, err := s.Load(strings.TrimSuffix(name, recordExtension))
+		if err != nil {
+			continue
+		}
+		records = append(records, *record)
+	}
+
+	sort.Slice(records, func(i, j int) bool { return records[i].Name < records[j].Name })
+	return records, nil
+}
+
+// writeFile writes through a temporary file so a crash never leaves a truncated record
+func (s *Store) writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return fmt.Errorf("failed to create state directory %s: %w", s.dir, err)
+	}
+
+	tmpFile, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := os.Rename(tmpFile.Name(), path); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_SaveAndLoad(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "clusters"))
+	createdAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	require.NoError(t, store.Save(&ClusterRecord{
+		Name:      "dev",
+		Type:      "k3d",
+		CreatedAt: createdAt,
+		Servers:   3,
+		Agents:    2,
+		Ports:     Ports{API: 6551, HTTP: 20080, HTTPS: 20443},
+	}))
+
+	record, err := store.Load("dev")
+	require.NoError(t, err)
+	assert.Equal(t, RecordAPIVersion, record.APIVersion)
+	assert.Equal(t, createdAt, record.CreatedAt)
+	assert.False(t, record.UpdatedAt.IsZero())
+	assert.Equal(t, 3, record.Servers)
+	assert.Equal(t, Ports{API: 6551, HTTP: 20080, HTTPS: 20443}, record.Ports)
+	assert.FileExists(t, filepath.Join(store.Directory(), "dev.json"))
+}
+
+func TestStore_Load(t *testing.T) {
+	store := NewStore(t.TempDir())
+
+	_, err := store.Load("missing")
+	assert.ErrorIs(t, err, ErrRecordNotFound)
+
+	_, err = store.Load("../escape")
+	assert.ErrorContains(t, err, "invalid cluster name")
+
+	require.NoError(t, os.WriteFile(filepath.Join(store.Directory(), "broken.json"), []byte("{"), 0600))
+	_, err = store.Load("broken")
+	assert.ErrorContains(t, err, "failed to parse state of cluster broken")
+}
+
+func TestStore_Update(t *testing.T) {
+	store := NewStore(t.TempDir())
+
+	require.NoError(t, store.Update("dev", func(record *ClusterRecord) {
+		record.Install = &InstallRecord{Branch: "develop"}
+	}))
+	require.NoError(t, store.Update("dev", func(record *ClusterRecord) {
+		record.Type = "k3d"
+	}))
+
+	record, err := store.Load("dev")
+	require.NoError(t, err)
+	assert.Equal(t, "dev", record.Name)
+	assert.Equal(t, "k3d", record.Type)
+	require.NotNil(t, record.Install)
+	assert.Equal(t, "develop", record.Install.Branch)
+	assert.False(t, record.CreatedAt.IsZero())
+}
+
+func TestStore_UpdateConcurrent(t *testing.T) {
+	store := NewStore(t.TempDir())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, store.Update("dev", func(record *ClusterRecord) {
+				record.Agents++
+			}))
+		}()
+	}
+	wg.Wait()
+
+	record, err := store.Load("dev")
+	require.NoError(t, err)
+	assert.Equal(t, 10, record.Agents)
+	assert.NoFileExists(t, filepath.Join(store.Directory(), storeLockFile))
+}
+
+func TestStore_SaveValuesAndDelete(t *testing.T) {
+	store := NewStore(t.TempDir())
+	require.NoError(t, store.Save(&ClusterRecord{Name: "dev"}))
+
+	path, err := store.SaveValues("dev", []byte("global:\n  repoBranch: main\n"))
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(store.Directory(), "dev.values.yaml"), path)
+	assert.FileExists(t, path)
+
+	require.NoError(t, store.Delete("dev"))
+	assert.NoFileExists(t, path)
+	_, err = store.Load("dev")
+	assert.ErrorIs(t, err, ErrRecordNotFound)
+
+	// Deleting again is not an error
+	assert.NoError(t, store.Delete("dev"))
+	assert.NoFileExists(t, filepath.Join(store.Directory(), storeLockFile))
+}
+
+func TestStore_DeleteWaitsForLock(t *testing.T) {
+	store := NewStore(t.TempDir())
+	require.NoError(t, store.Save(&ClusterRecord{Name: "dev"}))
+
+	unlock, err := store.lock()
+	require.NoError(t, err)
+	done := make(chan error)
+	go func() { done <- store.Delete("dev") }()
+
+	select {
+	case <-done:
+		t.Fatal("Delete should wait for the store lock")
+	case <-time.After(200 * time.Millisecond):
+	}
+	_, err = store.Load("dev")
+	assert.NoError(t, err)
+
+	unlock()
+	require.NoError(t, <-done)
+	_, err = store.Load("dev")
+	assert.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestStore_Prune(t *testing.T) {
+	store := NewStore(t.TempDir())
+	for _, name := range []string{"alpha", "beta", "gamma"} {
+		require.NoError(t, store.Save(&ClusterRecord{Name: name}))
+	}
+	_, err := store.SaveValues("beta", []byte("global: {}\n"))
+	require.NoError(t, err)
+
+	pruned, err := store.Prune(func(record ClusterRecord) bool { return record.Name == "alpha" })
+	require.NoError(t, err)
+	assert.Equal(t, []string{"beta", "gamma"}, pruned)
+
+	records, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "alpha", records[0].Name)
+	assert.NoFileExists(t, filepath.Join(store.Directory(), "beta.values.yaml"))
+	assert.NoFileExists(t, filepath.Join(store.Directory(), storeLockFile))
+}
+
+func TestStore_List(t *testing.T) {
+	t.Run("missing directory is empty", func(t *testing.T) {
+		records, err := NewStore(filepath.Join(t.TempDir(), "missing")).List()
+		require.NoError(t, err)
+		assert.Empty(t, records)
+	})
+
+	t.Run("records are sorted and unreadable files skipped", func(t *testing.T) {
+		store := NewStore(t.TempDir())
+		require.NoError(t, store.Save(&ClusterRecord{Name: "zeta"}))
+		require.NoError(t, store.Save(&ClusterRecord{Name: "alpha"}))
+		_, err := store.SaveValues("alpha", []byte("{}"))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(store.Directory(), "broken.json"), []byte("not json"), 0600))
+
+		records, err := store.List()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, "alpha", records[0].Name)
+		assert.Equal(t, "zeta", records[1].Name)
+	})
+}
+
+func TestDefaultDirectory(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	dir, err := DefaultDirectory()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, ".config", "openframe", "clusters"), dir)
+}
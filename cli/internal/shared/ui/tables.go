@@ -62,7 +62,7 @@ func GetStatusColor(status string) func(string) string {
 	switch strings.ToLower(status) {
 	case "running", "ready":
 		return func(s string) string { return pterm.Green(s) }
-	case "stopped", "not ready", "pending":
+	case "stopped", "partial", "not ready", "pending":
 		return func(s string) string { return pterm.Yellow(s) }
 	case "error", "failed", "unhealthy":
 		return func(s string) string { return pterm.Red(s) }
@@ -0,0 +1,139 @@
+package version
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+// ToolState is how an installed command line tool compares to the versions openframe supports
+type ToolState string
+
+const (
+	ToolMissing   ToolState = "missing"
+	ToolTooOld    ToolState = "too old"
+	ToolTooNew    ToolState = "too new"
+	ToolSupported ToolState = "supported"
+)
+
+// Tool declares the supported versions of a prerequisite tool
+type Tool struct {
+	Command     string
+	VersionArgs []string // Arguments that make the tool print its version
+	Supported   string   // Constraint the installed version must satisfy, e.g. ">= 5.6.0 < 6.0.0"
+	Pinned      string   // Version auto-install installs or upgrades to; empty when the package manager decides
+}
+
+// ToolStatus is the result of checking a tool against its supported versions
+type ToolStatus struct {
+	State     ToolState
+	Version   Version // Zero when the tool is missing or did not report a version
+	Supported string
+}
+
+// Check looks the tool up on the PATH and compares the version it reports to the supported range
+func (t Tool) Check() ToolStatus {
+	if _, err := exec.LookPath(t.Command); err != nil {
+		return ToolStatus{State: ToolMissing, Supported: t.Supported}
+	}
+	output, err := exec.Command(t.Command, t.VersionArgs...).CombinedOutput()
+	if err != nil {
+		// Whether the tool works at all is up to its installer; only a known unsupported version is rejected here
+		return ToolStatus{State: ToolSupported, Supported: t.Supported}
+	}
+	return t.Evaluate(string(output))
+}
+
+// Evaluate compares the version output of the tool to the supported range
+// Output without a version number is accepted, since an unknown version is not known to be unsupported.
+func (t Tool) Evaluate(output string) ToolStatus {
+	status := ToolStatus{State: ToolSupported, Supported: t.Supported}
+	v, err := Parse(output)
+	if err != nil {
+		return status
+	}
+	status.Version = v
+
+	constraint, err := ParseConstraint(t.Supported)
+	switch {
+	case err != nil || constraint.Check(v):
+	case constraint.Exceeds(v):
+		status.State = ToolTooNew
+	default:
+		status.State = ToolTooOld
+	}
+	return status
+}
+
+// IsSupported reports whether the tool is on the PATH and not known to be unsupported
+func (t Tool) IsSupported() bool {
+	return t.Check().State == ToolSupported
+}
+
+// PinnedTag returns the pinned version as a release tag such as "v5.7.4"
+func (t Tool) PinnedTag() string {
+	return "v" + t.Pinned
+}
+
+// Prerequisite implements the Tool, Status and IsSupported methods prerequisite installers share
+// Installers embed it, created with the tool they check and their own test that the tool works.
+type Prerequisite struct {
+	tool      Tool
+	installed func() bool
+}
+
+// NewPrerequisite creates the version checks of a prerequisite
+// A nil installed function leaves the check to the PATH lookup of the tool.
+func NewPrerequisite(tool Tool, installed func() bool) Prerequisite {
+	return Prerequisite{tool: tool, installed: installed}
+}
+
+// Tool returns the supported versions of the tool
+func (p Prerequisite) Tool() Tool {
+	return p.tool
+}
+
+// Status reports whether the tool is missing, too old, too new or in a supported version
+func (p Prerequisite) Status() ToolStatus {
+	return p.tool.Check()
+}
+
+// IsSupported reports whether the tool is installed in a supported version
+func (p Prerequisite) IsSupported() bool {
+	if p.installed != nil && !p.installed() {
+		return false
+	}
+	return p.tool.IsSupported()
+}
+
+// BrewInstallOrUpgrade returns the brew command that installs a formula, or upgrades it when
+// the tool is already on the PATH. Homebrew cannot pin versions, but upgrading brings an old
+// install into the supported range.
+func BrewInstallOrUpgrade(command string, formula ...string) *exec.Cmd {
+	action := "install"
+	if _, err := exec.LookPath(command); err == nil {
+		action = "upgrade"
+	}
+	return exec.Command("brew", append([]string{action}, formula...)...)
+}
+
+// Unsupported reports whether the tool is installed in a version outside the supported range
+// Installing the pinned version again brings it back into the range.
+func (s ToolStatus) Unsupported() bool {
+	return s.State == ToolTooOld || s.State == ToolTooNew
+}
+
+// String describes the status for prerequisite messages
+func (s ToolStatus) String() string {
+	switch {
+	case s.State == ToolMissing:
+		return "missing"
+	case s.State == ToolTooOld:
+		return fmt.Sprintf("v%s installed but too old, requires %s", s.Version, s.Supported)
+	case s.State == ToolTooNew:
+		return fmt.Sprintf("v%s installed but too new, requires %s", s.Version, s.Supported)
+	case s.Version == Version{}:
+		return "installed"
+	default:
+		return "v" + s.Version.String()
+	}
+}
@@ -0,0 +1,77 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTool_Evaluate(t *testing.T) {
+	tool := Tool{Command: "k3d", VersionArgs: []string{"version"}, Supported: ">= 5.6.0", Pinned: "5.7.4"}
+
+	status := tool.Evaluate("k3d version v5.7.4\nk3s version v1.30.4-k3s1 (default)\n")
+	assert.Equal(t, ToolSupported, status.State)
+	assert.Equal(t, Version{5, 7, 4}, status.Version)
+	assert.Equal(t, "v5.7.4", status.String())
+
+	status = tool.Evaluate("k3d version v5.4.6\n")
+	assert.Equal(t, ToolTooOld, status.State)
+	assert.Equal(t, "v5.4.6 installed but too old, requires >= 5.6.0", status.String())
+
+	status = tool.Evaluate("k3d version unknown")
+	assert.Equal(t, ToolSupported, status.State)
+	assert.Equal(t, "installed", status.String())
+
+	assert.Equal(t, "v5.7.4", tool.PinnedTag())
+}
+
+func TestTool_EvaluateUpperBound(t *testing.T) {
+	tool := Tool{Command: "k3d", VersionArgs: []string{"version"}, Supported: ">= 5.6.0 < 6.0.0", Pinned: "5.7.4"}
+
+	status := tool.Evaluate("k3d version v6.0.1\n")
+	assert.Equal(t, ToolTooNew, status.State)
+	assert.True(t, status.Unsupported())
+	assert.Equal(t, "v6.0.1 installed but too new, requires >= 5.6.0 < 6.0.0", status.String())
+
+	status = tool.Evaluate("k3d version v5.4.6\n")
+	assert.Equal(t, ToolTooOld, status.State)
+	assert.True(t, status.Unsupported())
+
+	status = tool.Evaluate("k3d version v5.8.3\n")
+	assert.Equal(t, ToolSupported, status.State)
+	assert.False(t, status.Unsupported())
+}
+
+func TestTool_Check(t *testing.T) {
+	status := Tool{Command: "nonexistentcommand12345", Supported: ">= 1.0.0"}.Check()
+	assert.Equal(t, ToolMissing, status.State)
+	assert.Equal(t, "missing", status.String())
+
+	status = Tool{Command: "sh", VersionArgs: []string{"-c", "echo sh v0.9.1"}, Supported: ">= 1.0.0"}.Check()
+	assert.Equal(t, ToolTooOld, status.State)
+	assert.Equal(t, Version{0, 9, 1}, status.Version)
+
+	status = Tool{Command: "sh", VersionArgs: []string{"-c", "exit 3"}, Supported: ">= 1.0.0"}.Check()
+	assert.Equal(t, ToolSupported, status.State)
+}
+
+func TestPrerequisite(t *testing.T) {
+	supported := Tool{Command: "sh", VersionArgs: []string{"-c", "echo sh v1.2.0"}, Supported: ">= 1.0.0"}
+	tooOld := Tool{Command: "sh", VersionArgs: []string{"-c", "echo sh v0.9.1"}, Supported: ">= 1.0.0"}
+	missing := Tool{Command: "nonexistentcommand12345", Supported: ">= 1.0.0"}
+
+	assert.True(t, NewPrerequisite(supported, nil).IsSupported())
+	assert.True(t, NewPrerequisite(supported, func() bool { return true }).IsSupported())
+	assert.False(t, NewPrerequisite(supported, func() bool { return false }).IsSupported(), "the installer's own check must pass")
+	assert.False(t, NewPrerequisite(tooOld, nil).IsSupported())
+	assert.False(t, NewPrerequisite(missing, nil).IsSupported())
+
+	prerequisite := NewPrerequisite(tooOld, nil)
+	assert.Equal(t, tooOld, prerequisite.Tool())
+	assert.Equal(t, ToolTooOld, prerequisite.Status().State)
+}
+
+func TestBrewInstallOrUpgrade(t *testing.T) {
+	assert.Equal(t, []string{"brew", "upgrade", "--cask", "docker-like"}, BrewInstallOrUpgrade("sh", "--cask", "docker-like").Args)
+	assert.Equal(t, []string{"brew", "install", "jq"}, BrewInstallOrUpgrade("nonexistentcommand12345", "jq").Args)
+}
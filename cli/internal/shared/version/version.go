@@ -0,0 +1,258 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// versionPattern finds the first dotted version number in a string such as
+// "v1.31.5+k3s1" or "k3d version v5.7.4"
+var versionPattern = regexp.MustCompile(`(\d+)\.(\d+)(?:\.(\d+))?`)
+
+// Version is a major.minor.patch version; pre-release and build suffixes are ignored
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// Parse extracts the first version number from a string
+// A missing patch number is read as zero
+func Parse(s string) (Version, error) {
+	match := versionPattern.FindStringSubmatch(s)
+	if match == nil {
+		return Version{}, fmt.Errorf("no version number found in %q", s)
+	}
+
+	var v Version
+	v.Major, _ = strconv.Atoi(match[1])
+	v.Minor, _ = strconv.Atoi(match[2])
+	if match[3] != "" {
+		v.Patch, _ = strconv.Atoi(match[3])
+	}
+	return v, nil
+}
+
+// MustParse is like Parse but panics on invalid input, for constants in code and tests
+func MustParse(s string) Version {
+	v, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Compare returns -1, 0 or 1 when v is older than, equal to or newer than other
+func (v Version) Compare(other Version) int {
+	for _, diff := range []int{v.Major - other.Major, v.Minor - other.Minor, v.Patch - other.Patch} {
+		if diff < 0 {
+			return -1
+		}
+		if diff > 0 {
+			return 1
+		}
+	}
+	return 0
+}
+
+// LessThan reports whether v is older than other
+func (v Version) LessThan(other Version) bool {
+	return v.Compare(other) < 0
+}
+
+// String formats the version as major.minor.patch without a leading v
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// bound is a single comparison such as ">= 1.23.0"
+type bound struct {
+	op      string
+	version Version
+}
+
+func (b bound) matches(v Version) bool {
+	cmp := v.Compare(b.version)
+	switch b.op {
+	case ">=":
+		return cmp >= 0
+	case ">":
+		return cmp > 0
+	case "<=":
+		return cmp <= 0
+	case "<":
+		return cmp < 0
+	case "!=":
+		return cmp != 0
+	default:
+		return cmp == 0
+	}
+}
+
+// Constraint is a semver range as used by helm chart kubeVersion fields, e.g.
+// ">= 1.23.0-0 < 1.33.0-0", "^1.25", "~1.30.x" or ">=1.20 || 1.19.x"
+type Constraint struct {
+	raw    string
+	groups [][]bound // Any group must match; every bound in a group must match
+}
+
+// constraintTermPattern matches one operator and version, allowing wildcards and
+// pre-release or build suffixes which are ignored
+var constraintTermPattern = regexp.MustCompile(`^(>=|<=|!=|>|<|=|~|\^)?\s*v?(\d+|[xX*])(?:\.(\d+|[xX*]))?(?:\.(\d+|[xX*]))?(?:-[0-9A-Za-z.-]+)?(?:\+[0-9A-Za-z.-]+)?`)
+
+// ParseConstraint parses a semver range; an empty range or "*" matches every version
+func ParseConstraint(s string) (Constraint, error) {
+	constraint := Constraint{raw: strings.TrimSpace(s)}
+	for _, group := range strings.Split(s, "||") {
+		bounds, err := parseConstraintGroup(group)
+		if err != nil {
+			return Constraint{}, fmt.Errorf("invalid version constraint %q: %w", s, err)
+		}
+		constraint.groups = append(constraint.groups, bounds)
+	}
+	return constraint, nil
+}
+
+// Check reports whether a version satisfies the constraint
+func (c Constraint) Check(v Version) bool {
+	for _, group := range c.groups {
+		matched := true
+		for _, b := range group {
+			if !b.matches(v) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// Exceeds reports whether a version is outside the constraint only because it is too new,
+// i.e. every alternative it fails is failed on an upper bound alone
+func (c Constraint) Exceeds(v Version) bool {
+	if c.Check(v) {
+		return false
+	}
+	for _, group := range c.groups {
+		failedUpper := false
+		for _, b := range group {
+			if b.matches(v) {
+				continue
+			}
+			if b.op != "<" && b.op != "<=" {
+				return false
+			}
+			failedUpper = true
+		}
+		if !failedUpper {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the constraint as it was written
+func (c Constraint) String() string {
+	return c.raw
+}
+
+// parseConstraintGroup parses the space or comma separated bounds of one || alternative
+func parseConstraintGroup(group string) ([]bound, error) {
+	group = strings.TrimSpace(strings.ReplaceAll(group, ",", " "))
+
+	// Hyphen ranges: "1.20 - 1.24" means ">= 1.20 <= 1.24"
+	if parts := strings.Split(group, " - "); len(parts) == 2 {
+		return parseConstraintGroup(">=" + strings.TrimSpace(parts[0]) + " <=" + strings.TrimSpace(parts[1]))
+	}
+
+	var bounds []bound
+	for group != "" {
+		match := constraintTermPattern.FindStringSubmatch(group)
+		if match == nil {
+			return nil, fmt.Errorf("unexpected %q", group)
+		}
+		bounds = append(bounds, expandTerm(match[1], match[2:5])...)
+		group = strings.TrimSpace(group[len(match[0]):])
+	}
+	return bounds, nil
+}
+
+// expandTerm turns an operator and a possibly partial or wildcard version into plain bounds
+func expandTerm(op string, parts []string) []bound {
+	// Count the leading numeric parts; anything after a wildcard or missing part is open
+	var numbers []int
+	for _, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			break
+		}
+		numbers = append(numbers, number)
+	}
+	if len(numbers) == 0 {
+		// "*" matches everything, so "<*", ">*" and "!=*" match nothing
+		if op == "<" || op == ">" || op == "!=" {
+			return []bound{{"<", Version{}}}
+		}
+		return nil
+	}
+
+	lower := Version{Major: numbers[0]}
+	if len(numbers) > 1 {
+		lower.Minor = numbers[1]
+	}
+	if len(numbers) > 2 {
+		lower.Patch = numbers[2]
+	}
+
+	// upper is the first version past the partial one: 1.2 -> 1.3.0, 1 -> 2.0.0
+	upper := lower
+	switch len(numbers) {
+	case 1:
+		upper = Version{Major: lower.Major + 1}
+	case 2:
+		upper = Version{Major: lower.Major, Minor: lower.Minor + 1}
+	default:
+		upper.Patch++
+	}
+
+	switch op {
+	case ">=":
+		return []bound{{">=", lower}}
+	case ">":
+		return []bound{{">=", upper}}
+	case "<":
+		return []bound{{"<", lower}}
+	case "<=":
+		return []bound{{"<", upper}}
+	case "!=":
+		// Excluding a whole partial range cannot be expressed as an AND of bounds,
+		// so only its first version is excluded
+		return []bound{{"!=", lower}}
+	case "~":
+		// Patch updates only, or minor updates when only a major version is given
+		if len(numbers) == 1 {
+			return []bound{{">=", lower}, {"<", Version{Major: lower.Major + 1}}}
+		}
+		return []bound{{">=", lower}, {"<", Version{Major: lower.Major, Minor: lower.Minor + 1}}}
+	case "^":
+		// Updates that do not change the first non-zero part
+		switch {
+		case lower.Major > 0 || len(numbers) == 1:
+			return []bound{{">=", lower}, {"<", Version{Major: lower.Major + 1}}}
+		case lower.Minor > 0 || len(numbers) == 2:
+			return []bound{{">=", lower}, {"<", Version{Minor: lower.Minor + 1}}}
+		default:
+			return []bound{{">=", lower}, {"<", Version{Patch: lower.Patch + 1}}}
+		}
+	default:
+		if len(numbers) == 3 {
+			return []bound{{"=", lower}}
+		}
+		return []bound{{">=", lower}, {"<", upper}}
+	}
+}
@@ -0,0 +1,111 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Version
+	}{
+		{"v1.31.5-k3s1", Version{1, 31, 5}},
+		{"v1.32.1+k3s1", Version{1, 32, 1}},
+		{"k3d version v5.7.4", Version{5, 7, 4}},
+		{"Docker version 27.3.1, build ce12230", Version{27, 3, 1}},
+		{"1.30", Version{1, 30, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			v, err := Parse(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, v)
+		})
+	}
+
+	_, err := Parse("latest")
+	assert.ErrorContains(t, err, `no version number found in "latest"`)
+}
+
+func TestVersion_Compare(t *testing.T) {
+	assert.Equal(t, 0, MustParse("1.31.5").Compare(MustParse("v1.31.5+k3s1")))
+	assert.Equal(t, -1, MustParse("1.31.5").Compare(MustParse("1.32.0")))
+	assert.Equal(t, 1, MustParse("2.0.0").Compare(MustParse("1.99.99")))
+	assert.True(t, MustParse("1.9.0").LessThan(MustParse("1.10.0")))
+	assert.Equal(t, "1.31.5", MustParse("v1.31.5-k3s1").String())
+}
+
+func TestConstraint_Check(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		expected   bool
+	}{
+		{"", "1.31.5", true},
+		{"*", "1.31.5", true},
+		{">= 1.23.0-0", "1.31.5", true},
+		{">=1.23.0-0", "1.22.9", false},
+		{">= 1.23.0-0 < 1.32.0-0", "1.31.5", true},
+		{">= 1.23.0-0 < 1.32.0-0", "1.32.0", false},
+		{">=1.25.0-0, <1.33.0-0", "1.32.9", true},
+		{"<=1.31", "1.31.9", true},
+		{"<=1.31", "1.32.0", false},
+		{">1.31", "1.31.9", false},
+		{">1.31", "1.32.0", true},
+		{"~1.30.2", "1.30.9", true},
+		{"~1.30.2", "1.31.0", false},
+		{"^1.25", "1.32.0", true},
+		{"^1.25", "2.0.0", false},
+		{"^0.2.3", "0.3.0", false},
+		{"1.30.x", "1.30.4", true},
+		{"1.30.x", "1.31.0", false},
+		{"=1.31.5", "1.31.5", true},
+		{"!=1.31.5", "1.31.5", false},
+		{"1.20 - 1.24", "1.24.7", true},
+		{"1.20 - 1.24", "1.25.0", false},
+		{"<1.20 || >=1.30", "1.31.0", true},
+		{"<1.20 || >=1.30", "1.25.0", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			constraint, err := ParseConstraint(tt.constraint)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, constraint.Check(MustParse(tt.version)))
+		})
+	}
+}
+
+func TestConstraint_Exceeds(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		expected   bool
+	}{
+		{">= 3.12.0 < 4.0.0", "4.0.0", true},
+		{">= 3.12.0 < 4.0.0", "3.8.1", false},
+		{">= 3.12.0 < 4.0.0", "3.16.2", false},
+		{">= 3.12.0", "3.8.1", false},
+		{"~1.30.0", "1.31.0", true},
+		{"~1.30.0", "1.29.9", false},
+		{"1.19.x || >= 1.20 < 1.25", "1.26.0", true},
+		{"1.19.x || >= 1.20 < 1.25", "1.18.0", false},
+	}
+
+	for _, tt := range tests {
+		constraint, err := ParseConstraint(tt.constraint)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		assert.Equal(t, tt.expected, constraint.Exceeds(MustParse(tt.version)), "%s exceeds %s", tt.version, tt.constraint)
+	}
+}
+
+func TestParseConstraint_Invalid(t *testing.T) {
+	_, err := ParseConstraint(">= one")
+	assert.ErrorContains(t, err, `invalid version constraint ">= one"`)
+}
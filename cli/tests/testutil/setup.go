@@ -37,6 +37,11 @@ func CreateStandardTestFlags() *cluster.FlagContainer {
 		Stderr:   "cluster not found",
 	})
 	
+	mockExecutor.SetResponse("kind get clusters", &executor.CommandResult{
+		ExitCode: 0,
+		Stdout:   "", // No kind clusters
+	})
+	
 	// Inject mock executor for unit tests
 	flags.Executor = mockExecutor
 	